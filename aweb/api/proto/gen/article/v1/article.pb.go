@@ -22,15 +22,245 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ArticleAccess int32
+
+const (
+	ArticleAccess_ARTICLE_ACCESS_PUBLIC ArticleAccess = 0
+	// 关注了作者的人才能看
+	ArticleAccess_ARTICLE_ACCESS_FOLLOWERS ArticleAccess = 1
+	// 订阅了作者的人才能看，订阅通过 GrantSubscription 开通
+	ArticleAccess_ARTICLE_ACCESS_SUBSCRIBERS ArticleAccess = 2
+)
+
+// Enum value maps for ArticleAccess.
+var (
+	ArticleAccess_name = map[int32]string{
+		0: "ARTICLE_ACCESS_PUBLIC",
+		1: "ARTICLE_ACCESS_FOLLOWERS",
+		2: "ARTICLE_ACCESS_SUBSCRIBERS",
+	}
+	ArticleAccess_value = map[string]int32{
+		"ARTICLE_ACCESS_PUBLIC":      0,
+		"ARTICLE_ACCESS_FOLLOWERS":   1,
+		"ARTICLE_ACCESS_SUBSCRIBERS": 2,
+	}
+)
+
+func (x ArticleAccess) Enum() *ArticleAccess {
+	p := new(ArticleAccess)
+	*p = x
+	return p
+}
+
+func (x ArticleAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_v1_article_proto_enumTypes[0].Descriptor()
+}
+
+func (ArticleAccess) Type() protoreflect.EnumType {
+	return &file_article_v1_article_proto_enumTypes[0]
+}
+
+func (x ArticleAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleAccess.Descriptor instead.
+func (ArticleAccess) EnumDescriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{0}
+}
+
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_OP_EQUAL  DiffOp = 0
+	DiffOp_DIFF_OP_INSERT DiffOp = 1
+	DiffOp_DIFF_OP_DELETE DiffOp = 2
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_EQUAL",
+		1: "DIFF_OP_INSERT",
+		2: "DIFF_OP_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_EQUAL":  0,
+		"DIFF_OP_INSERT": 1,
+		"DIFF_OP_DELETE": 2,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_v1_article_proto_enumTypes[1].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_article_v1_article_proto_enumTypes[1]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{1}
+}
+
+type CollaboratorRole int32
+
+const (
+	CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED CollaboratorRole = 0
+	CollaboratorRole_COLLABORATOR_ROLE_VIEWER      CollaboratorRole = 1
+	CollaboratorRole_COLLABORATOR_ROLE_EDITOR      CollaboratorRole = 2
+	// 作者本人，不能被邀请
+	CollaboratorRole_COLLABORATOR_ROLE_OWNER CollaboratorRole = 3
+)
+
+// Enum value maps for CollaboratorRole.
+var (
+	CollaboratorRole_name = map[int32]string{
+		0: "COLLABORATOR_ROLE_UNSPECIFIED",
+		1: "COLLABORATOR_ROLE_VIEWER",
+		2: "COLLABORATOR_ROLE_EDITOR",
+		3: "COLLABORATOR_ROLE_OWNER",
+	}
+	CollaboratorRole_value = map[string]int32{
+		"COLLABORATOR_ROLE_UNSPECIFIED": 0,
+		"COLLABORATOR_ROLE_VIEWER":      1,
+		"COLLABORATOR_ROLE_EDITOR":      2,
+		"COLLABORATOR_ROLE_OWNER":       3,
+	}
+)
+
+func (x CollaboratorRole) Enum() *CollaboratorRole {
+	p := new(CollaboratorRole)
+	*p = x
+	return p
+}
+
+func (x CollaboratorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollaboratorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_v1_article_proto_enumTypes[2].Descriptor()
+}
+
+func (CollaboratorRole) Type() protoreflect.EnumType {
+	return &file_article_v1_article_proto_enumTypes[2]
+}
+
+func (x CollaboratorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollaboratorRole.Descriptor instead.
+func (CollaboratorRole) EnumDescriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{2}
+}
+
+type ReviewStatus int32
+
+const (
+	ReviewStatus_REVIEW_STATUS_UNSPECIFIED ReviewStatus = 0
+	ReviewStatus_REVIEW_STATUS_PENDING     ReviewStatus = 1
+	ReviewStatus_REVIEW_STATUS_APPROVED    ReviewStatus = 2
+	ReviewStatus_REVIEW_STATUS_REJECTED    ReviewStatus = 3
+	// 审核之前作者又修改了文章
+	ReviewStatus_REVIEW_STATUS_CANCELED ReviewStatus = 4
+)
+
+// Enum value maps for ReviewStatus.
+var (
+	ReviewStatus_name = map[int32]string{
+		0: "REVIEW_STATUS_UNSPECIFIED",
+		1: "REVIEW_STATUS_PENDING",
+		2: "REVIEW_STATUS_APPROVED",
+		3: "REVIEW_STATUS_REJECTED",
+		4: "REVIEW_STATUS_CANCELED",
+	}
+	ReviewStatus_value = map[string]int32{
+		"REVIEW_STATUS_UNSPECIFIED": 0,
+		"REVIEW_STATUS_PENDING":     1,
+		"REVIEW_STATUS_APPROVED":    2,
+		"REVIEW_STATUS_REJECTED":    3,
+		"REVIEW_STATUS_CANCELED":    4,
+	}
+)
+
+func (x ReviewStatus) Enum() *ReviewStatus {
+	p := new(ReviewStatus)
+	*p = x
+	return p
+}
+
+func (x ReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_v1_article_proto_enumTypes[3].Descriptor()
+}
+
+func (ReviewStatus) Type() protoreflect.EnumType {
+	return &file_article_v1_article_proto_enumTypes[3]
+}
+
+func (x ReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewStatus.Descriptor instead.
+func (ReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{3}
+}
+
 type Article struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
-	Ctime         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
-	Utime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=utime,proto3" json:"utime,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Status   int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Ctime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=utime,proto3" json:"utime,omitempty"`
+	// 定时发表的时间，不填或者已经过去了就是立刻发表
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// 保存的时候会被规范化：转小写，去重，最多 10 个
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 下面三个是发表的时候渲染出来的，只有线上库的文章才有
+	// 已经过滤过 XSS 的 HTML
+	Html string `protobuf:"bytes,10,opt,name=html,proto3" json:"html,omitempty"`
+	// 目录
+	Toc []*Heading `protobuf:"bytes,11,rep,name=toc,proto3" json:"toc,omitempty"`
+	// 纯文本的摘要
+	Abstract string `protobuf:"bytes,12,opt,name=abstract,proto3" json:"abstract,omitempty"`
+	// 草稿的版本号，每次保存都会加一
+	Version int64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// 放进回收站的时间
+	Dtime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=dtime,proto3" json:"dtime,omitempty"`
+	// 谁可以看全文，别的读者只能看到摘要
+	Access ArticleAccess `protobuf:"varint,15,opt,name=access,proto3,enum=ArticleAccess" json:"access,omitempty"`
+	// 读者没有权限看全文，content、html 和 toc 都是空的，只有摘要
+	Locked bool `protobuf:"varint,16,opt,name=locked,proto3" json:"locked,omitempty"`
+	// 分类，一篇文章只有一个，保存的时候和标签一样规范化
+	Category      string `protobuf:"bytes,17,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,16 +344,150 @@ func (x *Article) GetUtime() *timestamppb.Timestamp {
 	return nil
 }
 
-type SaveRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+func (x *Article) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Article) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Article) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *Article) GetToc() []*Heading {
+	if x != nil {
+		return x.Toc
+	}
+	return nil
+}
+
+func (x *Article) GetAbstract() string {
+	if x != nil {
+		return x.Abstract
+	}
+	return ""
+}
+
+func (x *Article) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Article) GetDtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Dtime
+	}
+	return nil
+}
+
+func (x *Article) GetAccess() ArticleAccess {
+	if x != nil {
+		return x.Access
+	}
+	return ArticleAccess_ARTICLE_ACCESS_PUBLIC
+}
+
+func (x *Article) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Article) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type Heading struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Level int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// HTML 里面标题的锚点
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Heading) Reset() {
+	*x = Heading{}
+	mi := &file_article_v1_article_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heading) ProtoMessage() {}
+
+func (x *Heading) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heading.ProtoReflect.Descriptor instead.
+func (*Heading) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Heading) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Heading) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Heading) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SaveRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// 客户端拿到的草稿版本号，不填就不检查，直接覆盖
+	// 服务端的版本和它不一致的时候返回 ABORTED，details 里面有 VersionConflict
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *SaveRequest) Reset() {
 	*x = SaveRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[1]
+	mi := &file_article_v1_article_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +499,7 @@ func (x *SaveRequest) String() string {
 func (*SaveRequest) ProtoMessage() {}
 
 func (x *SaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[1]
+	mi := &file_article_v1_article_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +512,7 @@ func (x *SaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveRequest.ProtoReflect.Descriptor instead.
 func (*SaveRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{1}
+	return file_article_v1_article_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SaveRequest) GetArticle() *Article {
@@ -158,16 +522,25 @@ func (x *SaveRequest) GetArticle() *Article {
 	return nil
 }
 
+func (x *SaveRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type SaveResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 保存之后的版本号，下次保存的时候作为 expected_version
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SaveResponse) Reset() {
 	*x = SaveResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[2]
+	mi := &file_article_v1_article_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +552,7 @@ func (x *SaveResponse) String() string {
 func (*SaveResponse) ProtoMessage() {}
 
 func (x *SaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[2]
+	mi := &file_article_v1_article_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +565,7 @@ func (x *SaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveResponse.ProtoReflect.Descriptor instead.
 func (*SaveResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{2}
+	return file_article_v1_article_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SaveResponse) GetId() int64 {
@@ -202,6 +575,58 @@ func (x *SaveResponse) GetId() int64 {
 	return 0
 }
 
+func (x *SaveResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// VersionConflict 保存冲突的时候放在错误的 details 里面
+type VersionConflict struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrentVersion int64                  `protobuf:"varint,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VersionConflict) Reset() {
+	*x = VersionConflict{}
+	mi := &file_article_v1_article_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionConflict) ProtoMessage() {}
+
+func (x *VersionConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionConflict.ProtoReflect.Descriptor instead.
+func (*VersionConflict) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VersionConflict) GetCurrentVersion() int64 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
 type PublishRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
@@ -211,7 +636,7 @@ type PublishRequest struct {
 
 func (x *PublishRequest) Reset() {
 	*x = PublishRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[3]
+	mi := &file_article_v1_article_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +648,7 @@ func (x *PublishRequest) String() string {
 func (*PublishRequest) ProtoMessage() {}
 
 func (x *PublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[3]
+	mi := &file_article_v1_article_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +661,7 @@ func (x *PublishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRequest.ProtoReflect.Descriptor instead.
 func (*PublishRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{3}
+	return file_article_v1_article_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PublishRequest) GetArticle() *Article {
@@ -247,15 +672,17 @@ func (x *PublishRequest) GetArticle() *Article {
 }
 
 type PublishResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 发表之后文章的状态，命中敏感词的是待审核，而不是已发表
+	Status        int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PublishResponse) Reset() {
 	*x = PublishResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[4]
+	mi := &file_article_v1_article_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +694,7 @@ func (x *PublishResponse) String() string {
 func (*PublishResponse) ProtoMessage() {}
 
 func (x *PublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[4]
+	mi := &file_article_v1_article_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +707,7 @@ func (x *PublishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{4}
+	return file_article_v1_article_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PublishResponse) GetId() int64 {
@@ -290,9 +717,16 @@ func (x *PublishResponse) GetId() int64 {
 	return 0
 }
 
-type WithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+func (x *PublishResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type WithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -300,7 +734,7 @@ type WithdrawRequest struct {
 
 func (x *WithdrawRequest) Reset() {
 	*x = WithdrawRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[5]
+	mi := &file_article_v1_article_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +746,7 @@ func (x *WithdrawRequest) String() string {
 func (*WithdrawRequest) ProtoMessage() {}
 
 func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[5]
+	mi := &file_article_v1_article_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +759,7 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{5}
+	return file_article_v1_article_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WithdrawRequest) GetUid() int64 {
@@ -342,27 +776,4069 @@ func (x *WithdrawRequest) GetId() int64 {
 	return 0
 }
 
-type WithdrawResponse struct {
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawResponse) ProtoMessage() {}
+
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{8}
+}
+
+type CancelScheduledPublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPublishRequest) Reset() {
+	*x = CancelScheduledPublishRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPublishRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPublishRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPublishRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelScheduledPublishRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CancelScheduledPublishRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelScheduledPublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPublishResponse) Reset() {
+	*x = CancelScheduledPublishResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPublishResponse) ProtoMessage() {}
+
+func (x *CancelScheduledPublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPublishResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPublishResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{10}
+}
+
+type GetByAuthorRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Uid    int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 不为空的时候只返回打了这个标签的文章
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// 不为空的时候只返回这个分类的文章
+	Category      string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByAuthorRequest) Reset() {
+	*x = GetByAuthorRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAuthorRequest) ProtoMessage() {}
+
+func (x *GetByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetByAuthorRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetByAuthorRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type GetByAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByAuthorResponse) Reset() {
+	*x = GetByAuthorResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAuthorResponse) ProtoMessage() {}
+
+func (x *GetByAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetByAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetByAuthorResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type GetByAuthorByCursorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 上一次返回的 next_cursor，第一页不填
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Tag           string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category      string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByAuthorByCursorRequest) Reset() {
+	*x = GetByAuthorByCursorRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByAuthorByCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAuthorByCursorRequest) ProtoMessage() {}
+
+func (x *GetByAuthorByCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAuthorByCursorRequest.ProtoReflect.Descriptor instead.
+func (*GetByAuthorByCursorRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetByAuthorByCursorRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *GetByAuthorByCursorRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetByAuthorByCursorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetByAuthorByCursorRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetByAuthorByCursorRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type GetByAuthorByCursorResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Articles []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	// 为空说明没有更多了
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByAuthorByCursorResponse) Reset() {
+	*x = GetByAuthorByCursorResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByAuthorByCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAuthorByCursorResponse) ProtoMessage() {}
+
+func (x *GetByAuthorByCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAuthorByCursorResponse.ProtoReflect.Descriptor instead.
+func (*GetByAuthorByCursorResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetByAuthorByCursorResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *GetByAuthorByCursorResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdRequest) Reset() {
+	*x = GetByIdRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdRequest) ProtoMessage() {}
+
+func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetByIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdResponse) Reset() {
+	*x = GetByIdResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdResponse) ProtoMessage() {}
+
+func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetByIdResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetByIdResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type GetPubByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 读者，没有登录的话不填
+	Uid           int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPubByIdRequest) Reset() {
+	*x = GetPubByIdRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPubByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPubByIdRequest) ProtoMessage() {}
+
+func (x *GetPubByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPubByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPubByIdRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPubByIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetPubByIdRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type GetPubByIdResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// 文章不在系列里面的时候为空
+	Series        *SeriesNav `protobuf:"bytes,2,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPubByIdResponse) Reset() {
+	*x = GetPubByIdResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPubByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPubByIdResponse) ProtoMessage() {}
+
+func (x *GetPubByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPubByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetPubByIdResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPubByIdResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *GetPubByIdResponse) GetSeries() *SeriesNav {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetPubByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPubByIdsRequest) Reset() {
+	*x = GetPubByIdsRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPubByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPubByIdsRequest) ProtoMessage() {}
+
+func (x *GetPubByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPubByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetPubByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPubByIdsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetPubByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPubByIdsResponse) Reset() {
+	*x = GetPubByIdsResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPubByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPubByIdsResponse) ProtoMessage() {}
+
+func (x *GetPubByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPubByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetPubByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPubByIdsResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+// SeriesNav 文章在系列里面的位置
+type SeriesNav struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId int64                  `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// 从 0 开始的章节序号
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Total int32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// 为 0 说明已经是第一篇或者最后一篇了
+	PrevId        int64 `protobuf:"varint,5,opt,name=prev_id,json=prevId,proto3" json:"prev_id,omitempty"`
+	NextId        int64 `protobuf:"varint,6,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesNav) Reset() {
+	*x = SeriesNav{}
+	mi := &file_article_v1_article_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesNav) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesNav) ProtoMessage() {}
+
+func (x *SeriesNav) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesNav.ProtoReflect.Descriptor instead.
+func (*SeriesNav) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SeriesNav) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *SeriesNav) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SeriesNav) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SeriesNav) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SeriesNav) GetPrevId() int64 {
+	if x != nil {
+		return x.PrevId
+	}
+	return 0
+}
+
+func (x *SeriesNav) GetNextId() int64 {
+	if x != nil {
+		return x.NextId
+	}
+	return 0
+}
+
+type ListPubRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 不为空的时候只返回打了这个标签的文章
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// 不为空的时候只返回这个分类的文章
+	Category      string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPubRequest) Reset() {
+	*x = ListPubRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPubRequest) ProtoMessage() {}
+
+func (x *ListPubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPubRequest.ProtoReflect.Descriptor instead.
+func (*ListPubRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPubRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListPubRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPubRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPubRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListPubRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListPubResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPubResponse) Reset() {
+	*x = ListPubResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPubResponse) ProtoMessage() {}
+
+func (x *ListPubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPubResponse.ProtoReflect.Descriptor instead.
+func (*ListPubResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPubResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type ListPubByCursorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只返回 start 之前更新的文章
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// 上一次返回的 next_cursor，第一页不填
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Tag           string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category      string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPubByCursorRequest) Reset() {
+	*x = ListPubByCursorRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPubByCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPubByCursorRequest) ProtoMessage() {}
+
+func (x *ListPubByCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPubByCursorRequest.ProtoReflect.Descriptor instead.
+func (*ListPubByCursorRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPubByCursorRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListPubByCursorRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPubByCursorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPubByCursorRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListPubByCursorRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListPubByCursorResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Articles []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	// 为空说明没有更多了
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPubByCursorResponse) Reset() {
+	*x = ListPubByCursorResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPubByCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPubByCursorResponse) ProtoMessage() {}
+
+func (x *ListPubByCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPubByCursorResponse.ProtoReflect.Descriptor instead.
+func (*ListPubByCursorResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPubByCursorResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListPubByCursorResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ArticleRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Ctime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleRevision) Reset() {
+	*x = ArticleRevision{}
+	mi := &file_article_v1_article_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleRevision) ProtoMessage() {}
+
+func (x *ArticleRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleRevision.ProtoReflect.Descriptor instead.
+func (*ArticleRevision) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArticleRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ArticleRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ArticleRevision) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetCtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ctime
+	}
+	return nil
+}
+
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListRevisionsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ArticleRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRevisionsResponse) GetRevisions() []*ArticleRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionRequest) Reset() {
+	*x = GetRevisionRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionRequest) ProtoMessage() {}
+
+func (x *GetRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRevisionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *GetRevisionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type GetRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *ArticleRevision       `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionResponse) Reset() {
+	*x = GetRevisionResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionResponse) ProtoMessage() {}
+
+func (x *GetRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRevisionResponse) GetRevision() *ArticleRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=DiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_article_v1_article_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DiffLine) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_EQUAL
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 旧版本
+	FromRevisionId int64 `protobuf:"varint,3,opt,name=from_revision_id,json=fromRevisionId,proto3" json:"from_revision_id,omitempty"`
+	// 新版本
+	ToRevisionId  int64 `protobuf:"varint,4,opt,name=to_revision_id,json=toRevisionId,proto3" json:"to_revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRevisionsRequest) Reset() {
+	*x = DiffRevisionsRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DiffRevisionsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *DiffRevisionsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiffRevisionsRequest) GetFromRevisionId() int64 {
+	if x != nil {
+		return x.FromRevisionId
+	}
+	return 0
+}
+
+func (x *DiffRevisionsRequest) GetToRevisionId() int64 {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return 0
+}
+
+type DiffRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*DiffLine            `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRevisionsResponse) Reset() {
+	*x = DiffRevisionsResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DiffRevisionsResponse) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RestoreRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRevisionRequest) Reset() {
+	*x = RestoreRevisionRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreRevisionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *RestoreRevisionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type RestoreRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRevisionResponse) Reset() {
+	*x = RestoreRevisionResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{35}
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *DeleteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{37}
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTrashRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTrashResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *RestoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{41}
+}
+
+type ListExpiredTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 在这个时间之前放进回收站的
+	Before        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiredTrashRequest) Reset() {
+	*x = ListExpiredTrashRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiredTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiredTrashRequest) ProtoMessage() {}
+
+func (x *ListExpiredTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiredTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiredTrashRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListExpiredTrashRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListExpiredTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListExpiredTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiredTrashResponse) Reset() {
+	*x = ListExpiredTrashResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiredTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiredTrashResponse) ProtoMessage() {}
+
+func (x *ListExpiredTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiredTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiredTrashResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListExpiredTrashResponse) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type PurgeTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 和 ListExpiredTrash 用同一个时间，期间被恢复或者重新删除的文章不会被清理
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PurgeTrashRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *PurgeTrashRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type PurgeTrashResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 真的被删除了的文章
+	Ids           []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PurgeTrashResponse) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type Series struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId    int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 按照章节的顺序
+	ArticleIds    []int64                `protobuf:"varint,5,rep,packed,name=article_ids,json=articleIds,proto3" json:"article_ids,omitempty"`
+	Ctime         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_article_v1_article_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Series) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Series) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Series) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Series) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Series) GetArticleIds() []int64 {
+	if x != nil {
+		return x.ArticleIds
+	}
+	return nil
+}
+
+func (x *Series) GetCtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ctime
+	}
+	return nil
+}
+
+func (x *Series) GetUtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Utime
+	}
+	return nil
+}
+
+type CreateSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 不需要填 id，article_ids 是初始的章节，可以为空
+	Series        *Series `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeriesRequest) Reset() {
+	*x = CreateSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeriesRequest) ProtoMessage() {}
+
+func (x *CreateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateSeriesRequest) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type CreateSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeriesResponse) Reset() {
+	*x = CreateSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeriesResponse) ProtoMessage() {}
+
+func (x *CreateSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateSeriesResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *Series                `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeriesRequest) Reset() {
+	*x = UpdateSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeriesRequest) ProtoMessage() {}
+
+func (x *UpdateSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateSeriesRequest) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type UpdateSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeriesResponse) Reset() {
+	*x = UpdateSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeriesResponse) ProtoMessage() {}
+
+func (x *UpdateSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeriesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{50}
+}
+
+type DeleteSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeriesRequest) Reset() {
+	*x = DeleteSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeriesRequest) ProtoMessage() {}
+
+func (x *DeleteSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeriesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteSeriesRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *DeleteSeriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeriesResponse) Reset() {
+	*x = DeleteSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeriesResponse) ProtoMessage() {}
+
+func (x *DeleteSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeriesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{52}
+}
+
+type GetSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesRequest) Reset() {
+	*x = GetSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesRequest) ProtoMessage() {}
+
+func (x *GetSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSeriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *Series                `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesResponse) Reset() {
+	*x = GetSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesResponse) ProtoMessage() {}
+
+func (x *GetSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSeriesResponse) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type ListSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeriesRequest) Reset() {
+	*x = ListSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeriesRequest) ProtoMessage() {}
+
+func (x *ListSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSeriesRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListSeriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListSeriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*Series              `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeriesResponse) Reset() {
+	*x = ListSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeriesResponse) ProtoMessage() {}
+
+func (x *ListSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListSeriesResponse) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type AddSeriesArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSeriesArticleRequest) Reset() {
+	*x = AddSeriesArticleRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSeriesArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSeriesArticleRequest) ProtoMessage() {}
+
+func (x *AddSeriesArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSeriesArticleRequest.ProtoReflect.Descriptor instead.
+func (*AddSeriesArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddSeriesArticleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *AddSeriesArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddSeriesArticleRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type AddSeriesArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSeriesArticleResponse) Reset() {
+	*x = AddSeriesArticleResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSeriesArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSeriesArticleResponse) ProtoMessage() {}
+
+func (x *AddSeriesArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSeriesArticleResponse.ProtoReflect.Descriptor instead.
+func (*AddSeriesArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{58}
+}
+
+type RemoveSeriesArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSeriesArticleRequest) Reset() {
+	*x = RemoveSeriesArticleRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSeriesArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeriesArticleRequest) ProtoMessage() {}
+
+func (x *RemoveSeriesArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeriesArticleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSeriesArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveSeriesArticleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *RemoveSeriesArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveSeriesArticleRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type RemoveSeriesArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSeriesArticleResponse) Reset() {
+	*x = RemoveSeriesArticleResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSeriesArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeriesArticleResponse) ProtoMessage() {}
+
+func (x *RemoveSeriesArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeriesArticleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSeriesArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{60}
+}
+
+type ReorderSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 调整之后的顺序，必须刚好是系列里面现有的文章
+	ArticleIds    []int64 `protobuf:"varint,3,rep,packed,name=article_ids,json=articleIds,proto3" json:"article_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSeriesRequest) Reset() {
+	*x = ReorderSeriesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSeriesRequest) ProtoMessage() {}
+
+func (x *ReorderSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReorderSeriesRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ReorderSeriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReorderSeriesRequest) GetArticleIds() []int64 {
+	if x != nil {
+		return x.ArticleIds
+	}
+	return nil
+}
+
+type ReorderSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSeriesResponse) Reset() {
+	*x = ReorderSeriesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSeriesResponse) ProtoMessage() {}
+
+func (x *ReorderSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{62}
+}
+
+type Collaborator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role          CollaboratorRole       `protobuf:"varint,3,opt,name=role,proto3,enum=CollaboratorRole" json:"role,omitempty"`
+	Ctime         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
+	mi := &file_article_v1_article_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collaborator) ProtoMessage() {}
+
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Collaborator) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Collaborator) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *Collaborator) GetRole() CollaboratorRole {
+	if x != nil {
+		return x.Role
+	}
+	return CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+}
+
+func (x *Collaborator) GetCtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ctime
+	}
+	return nil
+}
+
+func (x *Collaborator) GetUtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Utime
+	}
+	return nil
+}
+
+type InviteCollaboratorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 邀请的人，必须是作者
+	Uid       int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ArticleId int64 `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// 被邀请的人
+	CollaboratorUid int64 `protobuf:"varint,3,opt,name=collaborator_uid,json=collaboratorUid,proto3" json:"collaborator_uid,omitempty"`
+	// 只能是 VIEWER 或者 EDITOR
+	Role          CollaboratorRole `protobuf:"varint,4,opt,name=role,proto3,enum=CollaboratorRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCollaboratorRequest) Reset() {
+	*x = InviteCollaboratorRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCollaboratorRequest) ProtoMessage() {}
+
+func (x *InviteCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*InviteCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *InviteCollaboratorRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *InviteCollaboratorRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *InviteCollaboratorRequest) GetCollaboratorUid() int64 {
+	if x != nil {
+		return x.CollaboratorUid
+	}
+	return 0
+}
+
+func (x *InviteCollaboratorRequest) GetRole() CollaboratorRole {
+	if x != nil {
+		return x.Role
+	}
+	return CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+}
+
+type InviteCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCollaboratorResponse) Reset() {
+	*x = InviteCollaboratorResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCollaboratorResponse) ProtoMessage() {}
+
+func (x *InviteCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*InviteCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{65}
+}
+
+type RemoveCollaboratorRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ArticleId       int64                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	CollaboratorUid int64                  `protobuf:"varint,3,opt,name=collaborator_uid,json=collaboratorUid,proto3" json:"collaborator_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorRequest) Reset() {
+	*x = RemoveCollaboratorRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorRequest) ProtoMessage() {}
+
+func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveCollaboratorRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *RemoveCollaboratorRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *RemoveCollaboratorRequest) GetCollaboratorUid() int64 {
+	if x != nil {
+		return x.CollaboratorUid
+	}
+	return 0
+}
+
+type RemoveCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorResponse) Reset() {
+	*x = RemoveCollaboratorResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorResponse) ProtoMessage() {}
+
+func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{67}
+}
+
+type ListCollaboratorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsRequest) Reset() {
+	*x = ListCollaboratorsRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsRequest) ProtoMessage() {}
+
+func (x *ListCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListCollaboratorsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListCollaboratorsRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type ListCollaboratorsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 不包括作者本人
+	Collaborators []*Collaborator `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsResponse) Reset() {
+	*x = ListCollaboratorsResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsResponse) ProtoMessage() {}
+
+func (x *ListCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListCollaboratorsResponse) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 第一次上传的人
+	Uid      int64  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// 根据文件内容判断的类型
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// 内容的 sha256
+	Hash string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	Url  string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// 下面三个只有图片才有
+	Width         int32                  `protobuf:"varint,8,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	ThumbUrl      string                 `protobuf:"bytes,10,opt,name=thumb_url,json=thumbUrl,proto3" json:"thumb_url,omitempty"`
+	Ctime         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_article_v1_article_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetThumbUrl() string {
+	if x != nil {
+		return x.ThumbUrl
+	}
+	return ""
+}
+
+func (x *Attachment) GetCtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ctime
+	}
+	return nil
+}
+
+type UploadAttachmentMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentMeta) Reset() {
+	*x = UploadAttachmentMeta{}
+	mi := &file_article_v1_article_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentMeta) ProtoMessage() {}
+
+func (x *UploadAttachmentMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentMeta.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentMeta) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UploadAttachmentMeta) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UploadAttachmentMeta) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Meta
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMeta() *UploadAttachmentMeta {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Meta); ok {
+			return x.Meta
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Meta struct {
+	Meta *UploadAttachmentMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Meta) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type UploadAttachmentResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Attachment *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// 在文章内容里面引用这个附件的地址，也就是 attachment:<id>
+	Ref           string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *UploadAttachmentResponse) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentResponse) Reset() {
+	*x = GetAttachmentResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ArticleReview struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId int64                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	AuthorId  int64                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// 提交审核的时候草稿的版本
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// 命中的敏感词
+	Hits          []string               `protobuf:"bytes,5,rep,name=hits,proto3" json:"hits,omitempty"`
+	Status        ReviewStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=ReviewStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,8,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Ctime         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleReview) Reset() {
+	*x = ArticleReview{}
+	mi := &file_article_v1_article_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleReview) ProtoMessage() {}
+
+func (x *ArticleReview) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleReview.ProtoReflect.Descriptor instead.
+func (*ArticleReview) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ArticleReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArticleReview) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ArticleReview) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ArticleReview) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ArticleReview) GetHits() []string {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *ArticleReview) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *ArticleReview) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ArticleReview) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ArticleReview) GetCtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ctime
+	}
+	return nil
+}
+
+func (x *ArticleReview) GetUtime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Utime
+	}
+	return nil
+}
+
+type ReviewArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核员
+	Uid           int64  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ReviewId      int64  `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Approve       bool   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewArticleRequest) Reset() {
+	*x = ReviewArticleRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewArticleRequest) ProtoMessage() {}
+
+func (x *ReviewArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewArticleRequest.ProtoReflect.Descriptor instead.
+func (*ReviewArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReviewArticleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ReviewArticleRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewArticleRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewArticleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewArticleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核之后文章的状态，通过的时候是已发表或者定时发表
+	Status        int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewArticleResponse) Reset() {
+	*x = ReviewArticleResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewArticleResponse) ProtoMessage() {}
+
+func (x *ReviewArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewArticleResponse.ProtoReflect.Descriptor instead.
+func (*ReviewArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReviewArticleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ListPendingReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingReviewsRequest) Reset() {
+	*x = ListPendingReviewsRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReviewsRequest) ProtoMessage() {}
+
+func (x *ListPendingReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListPendingReviewsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListPendingReviewsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPendingReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPendingReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*ArticleReview       `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingReviewsResponse) Reset() {
+	*x = ListPendingReviewsResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReviewsResponse) ProtoMessage() {}
+
+func (x *ListPendingReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListPendingReviewsResponse) GetReviews() []*ArticleReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type GetArticleReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ArticleId     int64                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleReviewRequest) Reset() {
+	*x = GetArticleReviewRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleReviewRequest) ProtoMessage() {}
+
+func (x *GetArticleReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleReviewRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetArticleReviewRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *GetArticleReviewRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+type GetArticleReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *ArticleReview         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawResponse) Reset() {
-	*x = WithdrawResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[6]
+func (x *GetArticleReviewResponse) Reset() {
+	*x = GetArticleReviewResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawResponse) String() string {
+func (x *GetArticleReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawResponse) ProtoMessage() {}
+func (*GetArticleReviewResponse) ProtoMessage() {}
 
-func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[6]
+func (x *GetArticleReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,35 +4849,46 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetArticleReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleReviewResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{82}
 }
 
-type GetByAuthorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *GetArticleReviewResponse) GetReview() *ArticleReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type GrantSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 管理员
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 订阅的读者
+	Subscriber int64 `protobuf:"varint,2,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	AuthorId   int64 `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// 不填表示永远不过期
+	ExpireAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByAuthorRequest) Reset() {
-	*x = GetByAuthorRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[7]
+func (x *GrantSubscriptionRequest) Reset() {
+	*x = GrantSubscriptionRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByAuthorRequest) String() string {
+func (x *GrantSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByAuthorRequest) ProtoMessage() {}
+func (*GrantSubscriptionRequest) ProtoMessage() {}
 
-func (x *GetByAuthorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[7]
+func (x *GrantSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,54 +4899,60 @@ func (x *GetByAuthorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByAuthorRequest.ProtoReflect.Descriptor instead.
-func (*GetByAuthorRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GrantSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GrantSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetByAuthorRequest) GetUid() int64 {
+func (x *GrantSubscriptionRequest) GetUid() int64 {
 	if x != nil {
 		return x.Uid
 	}
 	return 0
 }
 
-func (x *GetByAuthorRequest) GetOffset() int32 {
+func (x *GrantSubscriptionRequest) GetSubscriber() int64 {
 	if x != nil {
-		return x.Offset
+		return x.Subscriber
 	}
 	return 0
 }
 
-func (x *GetByAuthorRequest) GetLimit() int32 {
+func (x *GrantSubscriptionRequest) GetAuthorId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.AuthorId
 	}
 	return 0
 }
 
-type GetByAuthorResponse struct {
+func (x *GrantSubscriptionRequest) GetExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+type GrantSubscriptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByAuthorResponse) Reset() {
-	*x = GetByAuthorResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[8]
+func (x *GrantSubscriptionResponse) Reset() {
+	*x = GrantSubscriptionResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByAuthorResponse) String() string {
+func (x *GrantSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByAuthorResponse) ProtoMessage() {}
+func (*GrantSubscriptionResponse) ProtoMessage() {}
 
-func (x *GetByAuthorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[8]
+func (x *GrantSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,40 +4963,33 @@ func (x *GetByAuthorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByAuthorResponse.ProtoReflect.Descriptor instead.
-func (*GetByAuthorResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetByAuthorResponse) GetArticles() []*Article {
-	if x != nil {
-		return x.Articles
-	}
-	return nil
+// Deprecated: Use GrantSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GrantSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{84}
 }
 
-type GetByIdRequest struct {
+type ExportArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdRequest) Reset() {
-	*x = GetByIdRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[9]
+func (x *ExportArticlesRequest) Reset() {
+	*x = ExportArticlesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdRequest) String() string {
+func (x *ExportArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdRequest) ProtoMessage() {}
+func (*ExportArticlesRequest) ProtoMessage() {}
 
-func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[9]
+func (x *ExportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,40 +5000,40 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ExportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ExportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetByIdRequest) GetId() int64 {
+func (x *ExportArticlesRequest) GetUid() int64 {
 	if x != nil {
-		return x.Id
+		return x.Uid
 	}
 	return 0
 }
 
-type GetByIdResponse struct {
+type ExportArticlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdResponse) Reset() {
-	*x = GetByIdResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[10]
+func (x *ExportArticlesResponse) Reset() {
+	*x = ExportArticlesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdResponse) String() string {
+func (x *ExportArticlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdResponse) ProtoMessage() {}
+func (*ExportArticlesResponse) ProtoMessage() {}
 
-func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[10]
+func (x *ExportArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,41 +5044,40 @@ func (x *GetByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetByIdResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExportArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ExportArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetByIdResponse) GetArticle() *Article {
+func (x *ExportArticlesResponse) GetChunk() []byte {
 	if x != nil {
-		return x.Article
+		return x.Chunk
 	}
 	return nil
 }
 
-type GetPubByIdRequest struct {
+type ImportArticlesMeta struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uid           int64                  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPubByIdRequest) Reset() {
-	*x = GetPubByIdRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[11]
+func (x *ImportArticlesMeta) Reset() {
+	*x = ImportArticlesMeta{}
+	mi := &file_article_v1_article_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPubByIdRequest) String() string {
+func (x *ImportArticlesMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPubByIdRequest) ProtoMessage() {}
+func (*ImportArticlesMeta) ProtoMessage() {}
 
-func (x *GetPubByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[11]
+func (x *ImportArticlesMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,47 +5088,44 @@ func (x *GetPubByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPubByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetPubByIdRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetPubByIdRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ImportArticlesMeta.ProtoReflect.Descriptor instead.
+func (*ImportArticlesMeta) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetPubByIdRequest) GetUid() int64 {
+func (x *ImportArticlesMeta) GetUid() int64 {
 	if x != nil {
 		return x.Uid
 	}
 	return 0
 }
 
-type GetPubByIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+type ImportArticlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ImportArticlesRequest_Meta
+	//	*ImportArticlesRequest_Chunk
+	Data          isImportArticlesRequest_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPubByIdResponse) Reset() {
-	*x = GetPubByIdResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[12]
+func (x *ImportArticlesRequest) Reset() {
+	*x = ImportArticlesRequest{}
+	mi := &file_article_v1_article_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPubByIdResponse) String() string {
+func (x *ImportArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPubByIdResponse) ProtoMessage() {}
+func (*ImportArticlesRequest) ProtoMessage() {}
 
-func (x *GetPubByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[12]
+func (x *ImportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,100 +5136,81 @@ func (x *GetPubByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPubByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetPubByIdResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ImportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetPubByIdResponse) GetArticle() *Article {
+func (x *ImportArticlesRequest) GetData() isImportArticlesRequest_Data {
 	if x != nil {
-		return x.Article
+		return x.Data
 	}
 	return nil
 }
 
-type ListPubRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListPubRequest) Reset() {
-	*x = ListPubRequest{}
-	mi := &file_article_v1_article_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListPubRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListPubRequest) ProtoMessage() {}
-
-func (x *ListPubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[13]
+func (x *ImportArticlesRequest) GetMeta() *ImportArticlesMeta {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Data.(*ImportArticlesRequest_Meta); ok {
+			return x.Meta
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListPubRequest.ProtoReflect.Descriptor instead.
-func (*ListPubRequest) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{13}
+	return nil
 }
 
-func (x *ListPubRequest) GetStart() *timestamppb.Timestamp {
+func (x *ImportArticlesRequest) GetChunk() []byte {
 	if x != nil {
-		return x.Start
+		if x, ok := x.Data.(*ImportArticlesRequest_Chunk); ok {
+			return x.Chunk
+		}
 	}
 	return nil
 }
 
-func (x *ListPubRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+type isImportArticlesRequest_Data interface {
+	isImportArticlesRequest_Data()
 }
 
-func (x *ListPubRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+type ImportArticlesRequest_Meta struct {
+	Meta *ImportArticlesMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
 }
 
-type ListPubResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+type ImportArticlesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportArticlesRequest_Meta) isImportArticlesRequest_Data() {}
+
+func (*ImportArticlesRequest_Chunk) isImportArticlesRequest_Data() {}
+
+type ImportArticlesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 压缩包里面的文件名
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// 新建的文章，失败的时候是 0
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 导入之后文章的状态，命中敏感词的是待审核
+	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// 失败的原因，成功的时候为空
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPubResponse) Reset() {
-	*x = ListPubResponse{}
-	mi := &file_article_v1_article_proto_msgTypes[14]
+func (x *ImportArticlesResponse) Reset() {
+	*x = ImportArticlesResponse{}
+	mi := &file_article_v1_article_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPubResponse) String() string {
+func (x *ImportArticlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPubResponse) ProtoMessage() {}
+func (*ImportArticlesResponse) ProtoMessage() {}
 
-func (x *ListPubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_v1_article_proto_msgTypes[14]
+func (x *ImportArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_v1_article_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,23 +5221,44 @@ func (x *ListPubResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPubResponse.ProtoReflect.Descriptor instead.
-func (*ListPubResponse) Descriptor() ([]byte, []int) {
-	return file_article_v1_article_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ImportArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_v1_article_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ListPubResponse) GetArticles() []*Article {
+func (x *ImportArticlesResponse) GetFilename() string {
 	if x != nil {
-		return x.Articles
+		return x.Filename
 	}
-	return nil
+	return ""
+}
+
+func (x *ImportArticlesResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 var File_article_v1_article_proto protoreflect.FileDescriptor
 
 const file_article_v1_article_proto_rawDesc = "" +
 	"\n" +
-	"\x18article/v1/article.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x01\n" +
+	"\x18article/v1/article.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x04\n" +
 	"\aArticle\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x14\n" +
@@ -782,49 +5266,389 @@ const file_article_v1_article_proto_rawDesc = "" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\x05R\x06status\x120\n" +
 	"\x05ctime\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\x120\n" +
-	"\x05utime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05utime\"1\n" +
+	"\x05utime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05utime\x129\n" +
+	"\n" +
+	"publish_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x12\n" +
+	"\x04html\x18\n" +
+	" \x01(\tR\x04html\x12\x1a\n" +
+	"\x03toc\x18\v \x03(\v2\b.HeadingR\x03toc\x12\x1a\n" +
+	"\babstract\x18\f \x01(\tR\babstract\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x120\n" +
+	"\x05dtime\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x05dtime\x12&\n" +
+	"\x06access\x18\x0f \x01(\x0e2\x0e.ArticleAccessR\x06access\x12\x16\n" +
+	"\x06locked\x18\x10 \x01(\bR\x06locked\x12\x1a\n" +
+	"\bcategory\x18\x11 \x01(\tR\bcategory\"C\n" +
+	"\aHeading\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\\\n" +
 	"\vSaveRequest\x12\"\n" +
-	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\"\x1e\n" +
+	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"8\n" +
 	"\fSaveResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\":\n" +
+	"\x0fVersionConflict\x12'\n" +
+	"\x0fcurrent_version\x18\x01 \x01(\x03R\x0ecurrentVersion\"4\n" +
 	"\x0ePublishRequest\x12\"\n" +
-	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\"!\n" +
+	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\"9\n" +
 	"\x0fPublishResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"3\n" +
 	"\x0fWithdrawRequest\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x03R\x02id\"\x12\n" +
-	"\x10WithdrawResponse\"T\n" +
+	"\x10WithdrawResponse\"A\n" +
+	"\x1dCancelScheduledPublishRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\" \n" +
+	"\x1eCancelScheduledPublishResponse\"\x82\x01\n" +
 	"\x12GetByAuthorRequest\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\";\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\";\n" +
 	"\x13GetByAuthorResponse\x12$\n" +
-	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\" \n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\"\x8a\x01\n" +
+	"\x1aGetByAuthorByCursorRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\"d\n" +
+	"\x1bGetByAuthorByCursorResponse\x12$\n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\" \n" +
 	"\x0eGetByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
 	"\x0fGetByIdResponse\x12\"\n" +
 	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\"5\n" +
 	"\x11GetPubByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
-	"\x03uid\x18\x02 \x01(\x03R\x03uid\"8\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\"\\\n" +
 	"\x12GetPubByIdResponse\x12\"\n" +
-	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\"p\n" +
+	"\aarticle\x18\x01 \x01(\v2\b.ArticleR\aarticle\x12\"\n" +
+	"\x06series\x18\x02 \x01(\v2\n" +
+	".SeriesNavR\x06series\"&\n" +
+	"\x12GetPubByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\";\n" +
+	"\x13GetPubByIdsResponse\x12$\n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\"\x9c\x01\n" +
+	"\tSeriesNav\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\x03R\bseriesId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x17\n" +
+	"\aprev_id\x18\x05 \x01(\x03R\x06prevId\x12\x17\n" +
+	"\anext_id\x18\x06 \x01(\x03R\x06nextId\"\x9e\x01\n" +
 	"\x0eListPubRequest\x120\n" +
 	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"7\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\"7\n" +
 	"\x0fListPubResponse\x12$\n" +
-	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles2\xe1\x02\n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\"\xa6\x01\n" +
+	"\x16ListPubByCursorRequest\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\"`\n" +
+	"\x17ListPubByCursorResponse\x12$\n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xd7\x01\n" +
+	"\x0fArticleRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x120\n" +
+	"\x05ctime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\"f\n" +
+	"\x14ListRevisionsRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"G\n" +
+	"\x15ListRevisionsResponse\x12.\n" +
+	"\trevisions\x18\x01 \x03(\v2\x10.ArticleRevisionR\trevisions\"W\n" +
+	"\x12GetRevisionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vrevision_id\x18\x03 \x01(\x03R\n" +
+	"revisionId\"C\n" +
+	"\x13GetRevisionResponse\x12,\n" +
+	"\brevision\x18\x01 \x01(\v2\x10.ArticleRevisionR\brevision\"7\n" +
+	"\bDiffLine\x12\x17\n" +
+	"\x02op\x18\x01 \x01(\x0e2\a.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x88\x01\n" +
+	"\x14DiffRevisionsRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12(\n" +
+	"\x10from_revision_id\x18\x03 \x01(\x03R\x0efromRevisionId\x12$\n" +
+	"\x0eto_revision_id\x18\x04 \x01(\x03R\ftoRevisionId\"8\n" +
+	"\x15DiffRevisionsResponse\x12\x1f\n" +
+	"\x05lines\x18\x01 \x03(\v2\t.DiffLineR\x05lines\"[\n" +
+	"\x16RestoreRevisionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vrevision_id\x18\x03 \x01(\x03R\n" +
+	"revisionId\"\x19\n" +
+	"\x17RestoreRevisionResponse\"1\n" +
+	"\rDeleteRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x10\n" +
+	"\x0eDeleteResponse\"R\n" +
+	"\x10ListTrashRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"9\n" +
+	"\x11ListTrashResponse\x12$\n" +
+	"\barticles\x18\x01 \x03(\v2\b.ArticleR\barticles\"2\n" +
+	"\x0eRestoreRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x11\n" +
+	"\x0fRestoreResponse\"c\n" +
+	"\x17ListExpiredTrashRequest\x122\n" +
+	"\x06before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\",\n" +
+	"\x18ListExpiredTrashResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"Y\n" +
+	"\x11PurgeTrashRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"&\n" +
+	"\x12PurgeTrashResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"\xf2\x01\n" +
+	"\x06Series\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\varticle_ids\x18\x05 \x03(\x03R\n" +
+	"articleIds\x120\n" +
+	"\x05ctime\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\x120\n" +
+	"\x05utime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05utime\"6\n" +
+	"\x13CreateSeriesRequest\x12\x1f\n" +
+	"\x06series\x18\x01 \x01(\v2\a.SeriesR\x06series\"&\n" +
+	"\x14CreateSeriesResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x13UpdateSeriesRequest\x12\x1f\n" +
+	"\x06series\x18\x01 \x01(\v2\a.SeriesR\x06series\"\x16\n" +
+	"\x14UpdateSeriesResponse\"7\n" +
+	"\x13DeleteSeriesRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x16\n" +
+	"\x14DeleteSeriesResponse\"\"\n" +
+	"\x10GetSeriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x11GetSeriesResponse\x12\x1f\n" +
+	"\x06series\x18\x01 \x01(\v2\a.SeriesR\x06series\"S\n" +
+	"\x11ListSeriesRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"5\n" +
+	"\x12ListSeriesResponse\x12\x1f\n" +
+	"\x06series\x18\x01 \x03(\v2\a.SeriesR\x06series\"Z\n" +
+	"\x17AddSeriesArticleRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x03R\tarticleId\"\x1a\n" +
+	"\x18AddSeriesArticleResponse\"]\n" +
+	"\x1aRemoveSeriesArticleRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x03R\tarticleId\"\x1d\n" +
+	"\x1bRemoveSeriesArticleResponse\"Y\n" +
+	"\x14ReorderSeriesRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x1f\n" +
+	"\varticle_ids\x18\x03 \x03(\x03R\n" +
+	"articleIds\"\x17\n" +
+	"\x15ReorderSeriesResponse\"\xca\x01\n" +
+	"\fCollaborator\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03R\tarticleId\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\x12%\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x11.CollaboratorRoleR\x04role\x120\n" +
+	"\x05ctime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\x120\n" +
+	"\x05utime\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05utime\"\x9e\x01\n" +
+	"\x19InviteCollaboratorRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\x12)\n" +
+	"\x10collaborator_uid\x18\x03 \x01(\x03R\x0fcollaboratorUid\x12%\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x11.CollaboratorRoleR\x04role\"\x1c\n" +
+	"\x1aInviteCollaboratorResponse\"w\n" +
+	"\x19RemoveCollaboratorRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\x12)\n" +
+	"\x10collaborator_uid\x18\x03 \x01(\x03R\x0fcollaboratorUid\"\x1c\n" +
+	"\x1aRemoveCollaboratorResponse\"K\n" +
+	"\x18ListCollaboratorsRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\"P\n" +
+	"\x19ListCollaboratorsResponse\x123\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\r.CollaboratorR\rcollaborators\"\xa4\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04hash\x18\x06 \x01(\tR\x04hash\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\b \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\t \x01(\x05R\x06height\x12\x1b\n" +
+	"\tthumb_url\x18\n" +
+	" \x01(\tR\bthumbUrl\x120\n" +
+	"\x05ctime\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\"D\n" +
+	"\x14UploadAttachmentMeta\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"f\n" +
+	"\x17UploadAttachmentRequest\x12+\n" +
+	"\x04meta\x18\x01 \x01(\v2\x15.UploadAttachmentMetaH\x00R\x04meta\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"Y\n" +
+	"\x18UploadAttachmentResponse\x12+\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\v.AttachmentR\n" +
+	"attachment\x12\x10\n" +
+	"\x03ref\x18\x02 \x01(\tR\x03ref\"&\n" +
+	"\x14GetAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x15GetAttachmentResponse\x12+\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\v.AttachmentR\n" +
+	"attachment\"\xcd\x02\n" +
+	"\rArticleReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04hits\x18\x05 \x03(\tR\x04hits\x12%\n" +
+	"\x06status\x18\x06 \x01(\x0e2\r.ReviewStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1f\n" +
+	"\vreviewer_id\x18\b \x01(\x03R\n" +
+	"reviewerId\x120\n" +
+	"\x05ctime\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\x120\n" +
+	"\x05utime\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05utime\"w\n" +
+	"\x14ReviewArticleRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x03R\breviewId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"/\n" +
+	"\x15ReviewArticleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"[\n" +
+	"\x19ListPendingReviewsRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"F\n" +
+	"\x1aListPendingReviewsResponse\x12(\n" +
+	"\areviews\x18\x01 \x03(\v2\x0e.ArticleReviewR\areviews\"J\n" +
+	"\x17GetArticleReviewRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x03R\tarticleId\"B\n" +
+	"\x18GetArticleReviewResponse\x12&\n" +
+	"\x06review\x18\x01 \x01(\v2\x0e.ArticleReviewR\x06review\"\xa2\x01\n" +
+	"\x18GrantSubscriptionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x1e\n" +
+	"\n" +
+	"subscriber\x18\x02 \x01(\x03R\n" +
+	"subscriber\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x127\n" +
+	"\texpire_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\"\x1b\n" +
+	"\x19GrantSubscriptionResponse\")\n" +
+	"\x15ExportArticlesRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\".\n" +
+	"\x16ExportArticlesResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"&\n" +
+	"\x12ImportArticlesMeta\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\"b\n" +
+	"\x15ImportArticlesRequest\x12)\n" +
+	"\x04meta\x18\x01 \x01(\v2\x13.ImportArticlesMetaH\x00R\x04meta\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"r\n" +
+	"\x16ImportArticlesResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error*h\n" +
+	"\rArticleAccess\x12\x19\n" +
+	"\x15ARTICLE_ACCESS_PUBLIC\x10\x00\x12\x1c\n" +
+	"\x18ARTICLE_ACCESS_FOLLOWERS\x10\x01\x12\x1e\n" +
+	"\x1aARTICLE_ACCESS_SUBSCRIBERS\x10\x02*C\n" +
+	"\x06DiffOp\x12\x11\n" +
+	"\rDIFF_OP_EQUAL\x10\x00\x12\x12\n" +
+	"\x0eDIFF_OP_INSERT\x10\x01\x12\x12\n" +
+	"\x0eDIFF_OP_DELETE\x10\x02*\x8e\x01\n" +
+	"\x10CollaboratorRole\x12!\n" +
+	"\x1dCOLLABORATOR_ROLE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COLLABORATOR_ROLE_VIEWER\x10\x01\x12\x1c\n" +
+	"\x18COLLABORATOR_ROLE_EDITOR\x10\x02\x12\x1b\n" +
+	"\x17COLLABORATOR_ROLE_OWNER\x10\x03*\x9c\x01\n" +
+	"\fReviewStatus\x12\x1d\n" +
+	"\x19REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REVIEW_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16REVIEW_STATUS_APPROVED\x10\x02\x12\x1a\n" +
+	"\x16REVIEW_STATUS_REJECTED\x10\x03\x12\x1a\n" +
+	"\x16REVIEW_STATUS_CANCELED\x10\x042\xbd\x13\n" +
 	"\x0eArticleService\x12#\n" +
 	"\x04Save\x12\f.SaveRequest\x1a\r.SaveResponse\x12,\n" +
 	"\aPublish\x12\x0f.PublishRequest\x1a\x10.PublishResponse\x12/\n" +
-	"\bWithdraw\x12\x10.WithdrawRequest\x1a\x11.WithdrawResponse\x128\n" +
-	"\vGetByAuthor\x12\x13.GetByAuthorRequest\x1a\x14.GetByAuthorResponse\x12,\n" +
+	"\bWithdraw\x12\x10.WithdrawRequest\x1a\x11.WithdrawResponse\x12Y\n" +
+	"\x16CancelScheduledPublish\x12\x1e.CancelScheduledPublishRequest\x1a\x1f.CancelScheduledPublishResponse\x128\n" +
+	"\vGetByAuthor\x12\x13.GetByAuthorRequest\x1a\x14.GetByAuthorResponse\x12P\n" +
+	"\x13GetByAuthorByCursor\x12\x1b.GetByAuthorByCursorRequest\x1a\x1c.GetByAuthorByCursorResponse\x12,\n" +
 	"\aGetById\x12\x0f.GetByIdRequest\x1a\x10.GetByIdResponse\x125\n" +
 	"\n" +
-	"GetPubById\x12\x12.GetPubByIdRequest\x1a\x13.GetPubByIdResponse\x12,\n" +
-	"\aListPub\x12\x0f.ListPubRequest\x1a\x10.ListPubResponseBKB\fArticleProtoP\x01Z9github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1b\x06proto3"
+	"GetPubById\x12\x12.GetPubByIdRequest\x1a\x13.GetPubByIdResponse\x128\n" +
+	"\vGetPubByIds\x12\x13.GetPubByIdsRequest\x1a\x14.GetPubByIdsResponse\x12,\n" +
+	"\aListPub\x12\x0f.ListPubRequest\x1a\x10.ListPubResponse\x12D\n" +
+	"\x0fListPubByCursor\x12\x17.ListPubByCursorRequest\x1a\x18.ListPubByCursorResponse\x12>\n" +
+	"\rListRevisions\x12\x15.ListRevisionsRequest\x1a\x16.ListRevisionsResponse\x128\n" +
+	"\vGetRevision\x12\x13.GetRevisionRequest\x1a\x14.GetRevisionResponse\x12>\n" +
+	"\rDiffRevisions\x12\x15.DiffRevisionsRequest\x1a\x16.DiffRevisionsResponse\x12D\n" +
+	"\x0fRestoreRevision\x12\x17.RestoreRevisionRequest\x1a\x18.RestoreRevisionResponse\x12)\n" +
+	"\x06Delete\x12\x0e.DeleteRequest\x1a\x0f.DeleteResponse\x122\n" +
+	"\tListTrash\x12\x11.ListTrashRequest\x1a\x12.ListTrashResponse\x12,\n" +
+	"\aRestore\x12\x0f.RestoreRequest\x1a\x10.RestoreResponse\x12G\n" +
+	"\x10ListExpiredTrash\x12\x18.ListExpiredTrashRequest\x1a\x19.ListExpiredTrashResponse\x125\n" +
+	"\n" +
+	"PurgeTrash\x12\x12.PurgeTrashRequest\x1a\x13.PurgeTrashResponse\x12;\n" +
+	"\fCreateSeries\x12\x14.CreateSeriesRequest\x1a\x15.CreateSeriesResponse\x12;\n" +
+	"\fUpdateSeries\x12\x14.UpdateSeriesRequest\x1a\x15.UpdateSeriesResponse\x12;\n" +
+	"\fDeleteSeries\x12\x14.DeleteSeriesRequest\x1a\x15.DeleteSeriesResponse\x122\n" +
+	"\tGetSeries\x12\x11.GetSeriesRequest\x1a\x12.GetSeriesResponse\x125\n" +
+	"\n" +
+	"ListSeries\x12\x12.ListSeriesRequest\x1a\x13.ListSeriesResponse\x12G\n" +
+	"\x10AddSeriesArticle\x12\x18.AddSeriesArticleRequest\x1a\x19.AddSeriesArticleResponse\x12P\n" +
+	"\x13RemoveSeriesArticle\x12\x1b.RemoveSeriesArticleRequest\x1a\x1c.RemoveSeriesArticleResponse\x12>\n" +
+	"\rReorderSeries\x12\x15.ReorderSeriesRequest\x1a\x16.ReorderSeriesResponse\x12M\n" +
+	"\x12InviteCollaborator\x12\x1a.InviteCollaboratorRequest\x1a\x1b.InviteCollaboratorResponse\x12M\n" +
+	"\x12RemoveCollaborator\x12\x1a.RemoveCollaboratorRequest\x1a\x1b.RemoveCollaboratorResponse\x12J\n" +
+	"\x11ListCollaborators\x12\x19.ListCollaboratorsRequest\x1a\x1a.ListCollaboratorsResponse\x12I\n" +
+	"\x10UploadAttachment\x12\x18.UploadAttachmentRequest\x1a\x19.UploadAttachmentResponse(\x01\x12>\n" +
+	"\rGetAttachment\x12\x15.GetAttachmentRequest\x1a\x16.GetAttachmentResponse\x12>\n" +
+	"\rReviewArticle\x12\x15.ReviewArticleRequest\x1a\x16.ReviewArticleResponse\x12M\n" +
+	"\x12ListPendingReviews\x12\x1a.ListPendingReviewsRequest\x1a\x1b.ListPendingReviewsResponse\x12G\n" +
+	"\x10GetArticleReview\x12\x18.GetArticleReviewRequest\x1a\x19.GetArticleReviewResponse\x12J\n" +
+	"\x11GrantSubscription\x12\x19.GrantSubscriptionRequest\x1a\x1a.GrantSubscriptionResponse\x12C\n" +
+	"\x0eExportArticles\x12\x16.ExportArticlesRequest\x1a\x17.ExportArticlesResponse0\x01\x12E\n" +
+	"\x0eImportArticles\x12\x16.ImportArticlesRequest\x1a\x17.ImportArticlesResponse(\x010\x01BKB\fArticleProtoP\x01Z9github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1b\x06proto3"
 
 var (
 	file_article_v1_article_proto_rawDescOnce sync.Once
@@ -838,54 +5662,237 @@ func file_article_v1_article_proto_rawDescGZIP() []byte {
 	return file_article_v1_article_proto_rawDescData
 }
 
-var file_article_v1_article_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_article_v1_article_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_article_v1_article_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_article_v1_article_proto_goTypes = []any{
-	(*Article)(nil),               // 0: Article
-	(*SaveRequest)(nil),           // 1: SaveRequest
-	(*SaveResponse)(nil),          // 2: SaveResponse
-	(*PublishRequest)(nil),        // 3: PublishRequest
-	(*PublishResponse)(nil),       // 4: PublishResponse
-	(*WithdrawRequest)(nil),       // 5: WithdrawRequest
-	(*WithdrawResponse)(nil),      // 6: WithdrawResponse
-	(*GetByAuthorRequest)(nil),    // 7: GetByAuthorRequest
-	(*GetByAuthorResponse)(nil),   // 8: GetByAuthorResponse
-	(*GetByIdRequest)(nil),        // 9: GetByIdRequest
-	(*GetByIdResponse)(nil),       // 10: GetByIdResponse
-	(*GetPubByIdRequest)(nil),     // 11: GetPubByIdRequest
-	(*GetPubByIdResponse)(nil),    // 12: GetPubByIdResponse
-	(*ListPubRequest)(nil),        // 13: ListPubRequest
-	(*ListPubResponse)(nil),       // 14: ListPubResponse
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(ArticleAccess)(0),                     // 0: ArticleAccess
+	(DiffOp)(0),                            // 1: DiffOp
+	(CollaboratorRole)(0),                  // 2: CollaboratorRole
+	(ReviewStatus)(0),                      // 3: ReviewStatus
+	(*Article)(nil),                        // 4: Article
+	(*Heading)(nil),                        // 5: Heading
+	(*SaveRequest)(nil),                    // 6: SaveRequest
+	(*SaveResponse)(nil),                   // 7: SaveResponse
+	(*VersionConflict)(nil),                // 8: VersionConflict
+	(*PublishRequest)(nil),                 // 9: PublishRequest
+	(*PublishResponse)(nil),                // 10: PublishResponse
+	(*WithdrawRequest)(nil),                // 11: WithdrawRequest
+	(*WithdrawResponse)(nil),               // 12: WithdrawResponse
+	(*CancelScheduledPublishRequest)(nil),  // 13: CancelScheduledPublishRequest
+	(*CancelScheduledPublishResponse)(nil), // 14: CancelScheduledPublishResponse
+	(*GetByAuthorRequest)(nil),             // 15: GetByAuthorRequest
+	(*GetByAuthorResponse)(nil),            // 16: GetByAuthorResponse
+	(*GetByAuthorByCursorRequest)(nil),     // 17: GetByAuthorByCursorRequest
+	(*GetByAuthorByCursorResponse)(nil),    // 18: GetByAuthorByCursorResponse
+	(*GetByIdRequest)(nil),                 // 19: GetByIdRequest
+	(*GetByIdResponse)(nil),                // 20: GetByIdResponse
+	(*GetPubByIdRequest)(nil),              // 21: GetPubByIdRequest
+	(*GetPubByIdResponse)(nil),             // 22: GetPubByIdResponse
+	(*GetPubByIdsRequest)(nil),             // 23: GetPubByIdsRequest
+	(*GetPubByIdsResponse)(nil),            // 24: GetPubByIdsResponse
+	(*SeriesNav)(nil),                      // 25: SeriesNav
+	(*ListPubRequest)(nil),                 // 26: ListPubRequest
+	(*ListPubResponse)(nil),                // 27: ListPubResponse
+	(*ListPubByCursorRequest)(nil),         // 28: ListPubByCursorRequest
+	(*ListPubByCursorResponse)(nil),        // 29: ListPubByCursorResponse
+	(*ArticleRevision)(nil),                // 30: ArticleRevision
+	(*ListRevisionsRequest)(nil),           // 31: ListRevisionsRequest
+	(*ListRevisionsResponse)(nil),          // 32: ListRevisionsResponse
+	(*GetRevisionRequest)(nil),             // 33: GetRevisionRequest
+	(*GetRevisionResponse)(nil),            // 34: GetRevisionResponse
+	(*DiffLine)(nil),                       // 35: DiffLine
+	(*DiffRevisionsRequest)(nil),           // 36: DiffRevisionsRequest
+	(*DiffRevisionsResponse)(nil),          // 37: DiffRevisionsResponse
+	(*RestoreRevisionRequest)(nil),         // 38: RestoreRevisionRequest
+	(*RestoreRevisionResponse)(nil),        // 39: RestoreRevisionResponse
+	(*DeleteRequest)(nil),                  // 40: DeleteRequest
+	(*DeleteResponse)(nil),                 // 41: DeleteResponse
+	(*ListTrashRequest)(nil),               // 42: ListTrashRequest
+	(*ListTrashResponse)(nil),              // 43: ListTrashResponse
+	(*RestoreRequest)(nil),                 // 44: RestoreRequest
+	(*RestoreResponse)(nil),                // 45: RestoreResponse
+	(*ListExpiredTrashRequest)(nil),        // 46: ListExpiredTrashRequest
+	(*ListExpiredTrashResponse)(nil),       // 47: ListExpiredTrashResponse
+	(*PurgeTrashRequest)(nil),              // 48: PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),             // 49: PurgeTrashResponse
+	(*Series)(nil),                         // 50: Series
+	(*CreateSeriesRequest)(nil),            // 51: CreateSeriesRequest
+	(*CreateSeriesResponse)(nil),           // 52: CreateSeriesResponse
+	(*UpdateSeriesRequest)(nil),            // 53: UpdateSeriesRequest
+	(*UpdateSeriesResponse)(nil),           // 54: UpdateSeriesResponse
+	(*DeleteSeriesRequest)(nil),            // 55: DeleteSeriesRequest
+	(*DeleteSeriesResponse)(nil),           // 56: DeleteSeriesResponse
+	(*GetSeriesRequest)(nil),               // 57: GetSeriesRequest
+	(*GetSeriesResponse)(nil),              // 58: GetSeriesResponse
+	(*ListSeriesRequest)(nil),              // 59: ListSeriesRequest
+	(*ListSeriesResponse)(nil),             // 60: ListSeriesResponse
+	(*AddSeriesArticleRequest)(nil),        // 61: AddSeriesArticleRequest
+	(*AddSeriesArticleResponse)(nil),       // 62: AddSeriesArticleResponse
+	(*RemoveSeriesArticleRequest)(nil),     // 63: RemoveSeriesArticleRequest
+	(*RemoveSeriesArticleResponse)(nil),    // 64: RemoveSeriesArticleResponse
+	(*ReorderSeriesRequest)(nil),           // 65: ReorderSeriesRequest
+	(*ReorderSeriesResponse)(nil),          // 66: ReorderSeriesResponse
+	(*Collaborator)(nil),                   // 67: Collaborator
+	(*InviteCollaboratorRequest)(nil),      // 68: InviteCollaboratorRequest
+	(*InviteCollaboratorResponse)(nil),     // 69: InviteCollaboratorResponse
+	(*RemoveCollaboratorRequest)(nil),      // 70: RemoveCollaboratorRequest
+	(*RemoveCollaboratorResponse)(nil),     // 71: RemoveCollaboratorResponse
+	(*ListCollaboratorsRequest)(nil),       // 72: ListCollaboratorsRequest
+	(*ListCollaboratorsResponse)(nil),      // 73: ListCollaboratorsResponse
+	(*Attachment)(nil),                     // 74: Attachment
+	(*UploadAttachmentMeta)(nil),           // 75: UploadAttachmentMeta
+	(*UploadAttachmentRequest)(nil),        // 76: UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),       // 77: UploadAttachmentResponse
+	(*GetAttachmentRequest)(nil),           // 78: GetAttachmentRequest
+	(*GetAttachmentResponse)(nil),          // 79: GetAttachmentResponse
+	(*ArticleReview)(nil),                  // 80: ArticleReview
+	(*ReviewArticleRequest)(nil),           // 81: ReviewArticleRequest
+	(*ReviewArticleResponse)(nil),          // 82: ReviewArticleResponse
+	(*ListPendingReviewsRequest)(nil),      // 83: ListPendingReviewsRequest
+	(*ListPendingReviewsResponse)(nil),     // 84: ListPendingReviewsResponse
+	(*GetArticleReviewRequest)(nil),        // 85: GetArticleReviewRequest
+	(*GetArticleReviewResponse)(nil),       // 86: GetArticleReviewResponse
+	(*GrantSubscriptionRequest)(nil),       // 87: GrantSubscriptionRequest
+	(*GrantSubscriptionResponse)(nil),      // 88: GrantSubscriptionResponse
+	(*ExportArticlesRequest)(nil),          // 89: ExportArticlesRequest
+	(*ExportArticlesResponse)(nil),         // 90: ExportArticlesResponse
+	(*ImportArticlesMeta)(nil),             // 91: ImportArticlesMeta
+	(*ImportArticlesRequest)(nil),          // 92: ImportArticlesRequest
+	(*ImportArticlesResponse)(nil),         // 93: ImportArticlesResponse
+	(*timestamppb.Timestamp)(nil),          // 94: google.protobuf.Timestamp
 }
 var file_article_v1_article_proto_depIdxs = []int32{
-	15, // 0: Article.ctime:type_name -> google.protobuf.Timestamp
-	15, // 1: Article.utime:type_name -> google.protobuf.Timestamp
-	0,  // 2: SaveRequest.article:type_name -> Article
-	0,  // 3: PublishRequest.article:type_name -> Article
-	0,  // 4: GetByAuthorResponse.articles:type_name -> Article
-	0,  // 5: GetByIdResponse.article:type_name -> Article
-	0,  // 6: GetPubByIdResponse.article:type_name -> Article
-	15, // 7: ListPubRequest.start:type_name -> google.protobuf.Timestamp
-	0,  // 8: ListPubResponse.articles:type_name -> Article
-	1,  // 9: ArticleService.Save:input_type -> SaveRequest
-	3,  // 10: ArticleService.Publish:input_type -> PublishRequest
-	5,  // 11: ArticleService.Withdraw:input_type -> WithdrawRequest
-	7,  // 12: ArticleService.GetByAuthor:input_type -> GetByAuthorRequest
-	9,  // 13: ArticleService.GetById:input_type -> GetByIdRequest
-	11, // 14: ArticleService.GetPubById:input_type -> GetPubByIdRequest
-	13, // 15: ArticleService.ListPub:input_type -> ListPubRequest
-	2,  // 16: ArticleService.Save:output_type -> SaveResponse
-	4,  // 17: ArticleService.Publish:output_type -> PublishResponse
-	6,  // 18: ArticleService.Withdraw:output_type -> WithdrawResponse
-	8,  // 19: ArticleService.GetByAuthor:output_type -> GetByAuthorResponse
-	10, // 20: ArticleService.GetById:output_type -> GetByIdResponse
-	12, // 21: ArticleService.GetPubById:output_type -> GetPubByIdResponse
-	14, // 22: ArticleService.ListPub:output_type -> ListPubResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	94, // 0: Article.ctime:type_name -> google.protobuf.Timestamp
+	94, // 1: Article.utime:type_name -> google.protobuf.Timestamp
+	94, // 2: Article.publish_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: Article.toc:type_name -> Heading
+	94, // 4: Article.dtime:type_name -> google.protobuf.Timestamp
+	0,  // 5: Article.access:type_name -> ArticleAccess
+	4,  // 6: SaveRequest.article:type_name -> Article
+	4,  // 7: PublishRequest.article:type_name -> Article
+	4,  // 8: GetByAuthorResponse.articles:type_name -> Article
+	4,  // 9: GetByAuthorByCursorResponse.articles:type_name -> Article
+	4,  // 10: GetByIdResponse.article:type_name -> Article
+	4,  // 11: GetPubByIdResponse.article:type_name -> Article
+	25, // 12: GetPubByIdResponse.series:type_name -> SeriesNav
+	4,  // 13: GetPubByIdsResponse.articles:type_name -> Article
+	94, // 14: ListPubRequest.start:type_name -> google.protobuf.Timestamp
+	4,  // 15: ListPubResponse.articles:type_name -> Article
+	94, // 16: ListPubByCursorRequest.start:type_name -> google.protobuf.Timestamp
+	4,  // 17: ListPubByCursorResponse.articles:type_name -> Article
+	94, // 18: ArticleRevision.ctime:type_name -> google.protobuf.Timestamp
+	30, // 19: ListRevisionsResponse.revisions:type_name -> ArticleRevision
+	30, // 20: GetRevisionResponse.revision:type_name -> ArticleRevision
+	1,  // 21: DiffLine.op:type_name -> DiffOp
+	35, // 22: DiffRevisionsResponse.lines:type_name -> DiffLine
+	4,  // 23: ListTrashResponse.articles:type_name -> Article
+	94, // 24: ListExpiredTrashRequest.before:type_name -> google.protobuf.Timestamp
+	94, // 25: PurgeTrashRequest.before:type_name -> google.protobuf.Timestamp
+	94, // 26: Series.ctime:type_name -> google.protobuf.Timestamp
+	94, // 27: Series.utime:type_name -> google.protobuf.Timestamp
+	50, // 28: CreateSeriesRequest.series:type_name -> Series
+	50, // 29: UpdateSeriesRequest.series:type_name -> Series
+	50, // 30: GetSeriesResponse.series:type_name -> Series
+	50, // 31: ListSeriesResponse.series:type_name -> Series
+	2,  // 32: Collaborator.role:type_name -> CollaboratorRole
+	94, // 33: Collaborator.ctime:type_name -> google.protobuf.Timestamp
+	94, // 34: Collaborator.utime:type_name -> google.protobuf.Timestamp
+	2,  // 35: InviteCollaboratorRequest.role:type_name -> CollaboratorRole
+	67, // 36: ListCollaboratorsResponse.collaborators:type_name -> Collaborator
+	94, // 37: Attachment.ctime:type_name -> google.protobuf.Timestamp
+	75, // 38: UploadAttachmentRequest.meta:type_name -> UploadAttachmentMeta
+	74, // 39: UploadAttachmentResponse.attachment:type_name -> Attachment
+	74, // 40: GetAttachmentResponse.attachment:type_name -> Attachment
+	3,  // 41: ArticleReview.status:type_name -> ReviewStatus
+	94, // 42: ArticleReview.ctime:type_name -> google.protobuf.Timestamp
+	94, // 43: ArticleReview.utime:type_name -> google.protobuf.Timestamp
+	80, // 44: ListPendingReviewsResponse.reviews:type_name -> ArticleReview
+	80, // 45: GetArticleReviewResponse.review:type_name -> ArticleReview
+	94, // 46: GrantSubscriptionRequest.expire_at:type_name -> google.protobuf.Timestamp
+	91, // 47: ImportArticlesRequest.meta:type_name -> ImportArticlesMeta
+	6,  // 48: ArticleService.Save:input_type -> SaveRequest
+	9,  // 49: ArticleService.Publish:input_type -> PublishRequest
+	11, // 50: ArticleService.Withdraw:input_type -> WithdrawRequest
+	13, // 51: ArticleService.CancelScheduledPublish:input_type -> CancelScheduledPublishRequest
+	15, // 52: ArticleService.GetByAuthor:input_type -> GetByAuthorRequest
+	17, // 53: ArticleService.GetByAuthorByCursor:input_type -> GetByAuthorByCursorRequest
+	19, // 54: ArticleService.GetById:input_type -> GetByIdRequest
+	21, // 55: ArticleService.GetPubById:input_type -> GetPubByIdRequest
+	23, // 56: ArticleService.GetPubByIds:input_type -> GetPubByIdsRequest
+	26, // 57: ArticleService.ListPub:input_type -> ListPubRequest
+	28, // 58: ArticleService.ListPubByCursor:input_type -> ListPubByCursorRequest
+	31, // 59: ArticleService.ListRevisions:input_type -> ListRevisionsRequest
+	33, // 60: ArticleService.GetRevision:input_type -> GetRevisionRequest
+	36, // 61: ArticleService.DiffRevisions:input_type -> DiffRevisionsRequest
+	38, // 62: ArticleService.RestoreRevision:input_type -> RestoreRevisionRequest
+	40, // 63: ArticleService.Delete:input_type -> DeleteRequest
+	42, // 64: ArticleService.ListTrash:input_type -> ListTrashRequest
+	44, // 65: ArticleService.Restore:input_type -> RestoreRequest
+	46, // 66: ArticleService.ListExpiredTrash:input_type -> ListExpiredTrashRequest
+	48, // 67: ArticleService.PurgeTrash:input_type -> PurgeTrashRequest
+	51, // 68: ArticleService.CreateSeries:input_type -> CreateSeriesRequest
+	53, // 69: ArticleService.UpdateSeries:input_type -> UpdateSeriesRequest
+	55, // 70: ArticleService.DeleteSeries:input_type -> DeleteSeriesRequest
+	57, // 71: ArticleService.GetSeries:input_type -> GetSeriesRequest
+	59, // 72: ArticleService.ListSeries:input_type -> ListSeriesRequest
+	61, // 73: ArticleService.AddSeriesArticle:input_type -> AddSeriesArticleRequest
+	63, // 74: ArticleService.RemoveSeriesArticle:input_type -> RemoveSeriesArticleRequest
+	65, // 75: ArticleService.ReorderSeries:input_type -> ReorderSeriesRequest
+	68, // 76: ArticleService.InviteCollaborator:input_type -> InviteCollaboratorRequest
+	70, // 77: ArticleService.RemoveCollaborator:input_type -> RemoveCollaboratorRequest
+	72, // 78: ArticleService.ListCollaborators:input_type -> ListCollaboratorsRequest
+	76, // 79: ArticleService.UploadAttachment:input_type -> UploadAttachmentRequest
+	78, // 80: ArticleService.GetAttachment:input_type -> GetAttachmentRequest
+	81, // 81: ArticleService.ReviewArticle:input_type -> ReviewArticleRequest
+	83, // 82: ArticleService.ListPendingReviews:input_type -> ListPendingReviewsRequest
+	85, // 83: ArticleService.GetArticleReview:input_type -> GetArticleReviewRequest
+	87, // 84: ArticleService.GrantSubscription:input_type -> GrantSubscriptionRequest
+	89, // 85: ArticleService.ExportArticles:input_type -> ExportArticlesRequest
+	92, // 86: ArticleService.ImportArticles:input_type -> ImportArticlesRequest
+	7,  // 87: ArticleService.Save:output_type -> SaveResponse
+	10, // 88: ArticleService.Publish:output_type -> PublishResponse
+	12, // 89: ArticleService.Withdraw:output_type -> WithdrawResponse
+	14, // 90: ArticleService.CancelScheduledPublish:output_type -> CancelScheduledPublishResponse
+	16, // 91: ArticleService.GetByAuthor:output_type -> GetByAuthorResponse
+	18, // 92: ArticleService.GetByAuthorByCursor:output_type -> GetByAuthorByCursorResponse
+	20, // 93: ArticleService.GetById:output_type -> GetByIdResponse
+	22, // 94: ArticleService.GetPubById:output_type -> GetPubByIdResponse
+	24, // 95: ArticleService.GetPubByIds:output_type -> GetPubByIdsResponse
+	27, // 96: ArticleService.ListPub:output_type -> ListPubResponse
+	29, // 97: ArticleService.ListPubByCursor:output_type -> ListPubByCursorResponse
+	32, // 98: ArticleService.ListRevisions:output_type -> ListRevisionsResponse
+	34, // 99: ArticleService.GetRevision:output_type -> GetRevisionResponse
+	37, // 100: ArticleService.DiffRevisions:output_type -> DiffRevisionsResponse
+	39, // 101: ArticleService.RestoreRevision:output_type -> RestoreRevisionResponse
+	41, // 102: ArticleService.Delete:output_type -> DeleteResponse
+	43, // 103: ArticleService.ListTrash:output_type -> ListTrashResponse
+	45, // 104: ArticleService.Restore:output_type -> RestoreResponse
+	47, // 105: ArticleService.ListExpiredTrash:output_type -> ListExpiredTrashResponse
+	49, // 106: ArticleService.PurgeTrash:output_type -> PurgeTrashResponse
+	52, // 107: ArticleService.CreateSeries:output_type -> CreateSeriesResponse
+	54, // 108: ArticleService.UpdateSeries:output_type -> UpdateSeriesResponse
+	56, // 109: ArticleService.DeleteSeries:output_type -> DeleteSeriesResponse
+	58, // 110: ArticleService.GetSeries:output_type -> GetSeriesResponse
+	60, // 111: ArticleService.ListSeries:output_type -> ListSeriesResponse
+	62, // 112: ArticleService.AddSeriesArticle:output_type -> AddSeriesArticleResponse
+	64, // 113: ArticleService.RemoveSeriesArticle:output_type -> RemoveSeriesArticleResponse
+	66, // 114: ArticleService.ReorderSeries:output_type -> ReorderSeriesResponse
+	69, // 115: ArticleService.InviteCollaborator:output_type -> InviteCollaboratorResponse
+	71, // 116: ArticleService.RemoveCollaborator:output_type -> RemoveCollaboratorResponse
+	73, // 117: ArticleService.ListCollaborators:output_type -> ListCollaboratorsResponse
+	77, // 118: ArticleService.UploadAttachment:output_type -> UploadAttachmentResponse
+	79, // 119: ArticleService.GetAttachment:output_type -> GetAttachmentResponse
+	82, // 120: ArticleService.ReviewArticle:output_type -> ReviewArticleResponse
+	84, // 121: ArticleService.ListPendingReviews:output_type -> ListPendingReviewsResponse
+	86, // 122: ArticleService.GetArticleReview:output_type -> GetArticleReviewResponse
+	88, // 123: ArticleService.GrantSubscription:output_type -> GrantSubscriptionResponse
+	90, // 124: ArticleService.ExportArticles:output_type -> ExportArticlesResponse
+	93, // 125: ArticleService.ImportArticles:output_type -> ImportArticlesResponse
+	87, // [87:126] is the sub-list for method output_type
+	48, // [48:87] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_article_v1_article_proto_init() }
@@ -893,18 +5900,27 @@ func file_article_v1_article_proto_init() {
 	if File_article_v1_article_proto != nil {
 		return
 	}
+	file_article_v1_article_proto_msgTypes[72].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Meta)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_article_v1_article_proto_msgTypes[88].OneofWrappers = []any{
+		(*ImportArticlesRequest_Meta)(nil),
+		(*ImportArticlesRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_article_v1_article_proto_rawDesc), len(file_article_v1_article_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_article_v1_article_proto_goTypes,
 		DependencyIndexes: file_article_v1_article_proto_depIdxs,
+		EnumInfos:         file_article_v1_article_proto_enumTypes,
 		MessageInfos:      file_article_v1_article_proto_msgTypes,
 	}.Build()
 	File_article_v1_article_proto = out.File
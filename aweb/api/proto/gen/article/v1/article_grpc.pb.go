@@ -19,13 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArticleService_Save_FullMethodName        = "/ArticleService/Save"
-	ArticleService_Publish_FullMethodName     = "/ArticleService/Publish"
-	ArticleService_Withdraw_FullMethodName    = "/ArticleService/Withdraw"
-	ArticleService_GetByAuthor_FullMethodName = "/ArticleService/GetByAuthor"
-	ArticleService_GetById_FullMethodName     = "/ArticleService/GetById"
-	ArticleService_GetPubById_FullMethodName  = "/ArticleService/GetPubById"
-	ArticleService_ListPub_FullMethodName     = "/ArticleService/ListPub"
+	ArticleService_Save_FullMethodName                   = "/ArticleService/Save"
+	ArticleService_Publish_FullMethodName                = "/ArticleService/Publish"
+	ArticleService_Withdraw_FullMethodName               = "/ArticleService/Withdraw"
+	ArticleService_CancelScheduledPublish_FullMethodName = "/ArticleService/CancelScheduledPublish"
+	ArticleService_GetByAuthor_FullMethodName            = "/ArticleService/GetByAuthor"
+	ArticleService_GetByAuthorByCursor_FullMethodName    = "/ArticleService/GetByAuthorByCursor"
+	ArticleService_GetById_FullMethodName                = "/ArticleService/GetById"
+	ArticleService_GetPubById_FullMethodName             = "/ArticleService/GetPubById"
+	ArticleService_GetPubByIds_FullMethodName            = "/ArticleService/GetPubByIds"
+	ArticleService_ListPub_FullMethodName                = "/ArticleService/ListPub"
+	ArticleService_ListPubByCursor_FullMethodName        = "/ArticleService/ListPubByCursor"
+	ArticleService_ListRevisions_FullMethodName          = "/ArticleService/ListRevisions"
+	ArticleService_GetRevision_FullMethodName            = "/ArticleService/GetRevision"
+	ArticleService_DiffRevisions_FullMethodName          = "/ArticleService/DiffRevisions"
+	ArticleService_RestoreRevision_FullMethodName        = "/ArticleService/RestoreRevision"
+	ArticleService_Delete_FullMethodName                 = "/ArticleService/Delete"
+	ArticleService_ListTrash_FullMethodName              = "/ArticleService/ListTrash"
+	ArticleService_Restore_FullMethodName                = "/ArticleService/Restore"
+	ArticleService_ListExpiredTrash_FullMethodName       = "/ArticleService/ListExpiredTrash"
+	ArticleService_PurgeTrash_FullMethodName             = "/ArticleService/PurgeTrash"
+	ArticleService_CreateSeries_FullMethodName           = "/ArticleService/CreateSeries"
+	ArticleService_UpdateSeries_FullMethodName           = "/ArticleService/UpdateSeries"
+	ArticleService_DeleteSeries_FullMethodName           = "/ArticleService/DeleteSeries"
+	ArticleService_GetSeries_FullMethodName              = "/ArticleService/GetSeries"
+	ArticleService_ListSeries_FullMethodName             = "/ArticleService/ListSeries"
+	ArticleService_AddSeriesArticle_FullMethodName       = "/ArticleService/AddSeriesArticle"
+	ArticleService_RemoveSeriesArticle_FullMethodName    = "/ArticleService/RemoveSeriesArticle"
+	ArticleService_ReorderSeries_FullMethodName          = "/ArticleService/ReorderSeries"
+	ArticleService_InviteCollaborator_FullMethodName     = "/ArticleService/InviteCollaborator"
+	ArticleService_RemoveCollaborator_FullMethodName     = "/ArticleService/RemoveCollaborator"
+	ArticleService_ListCollaborators_FullMethodName      = "/ArticleService/ListCollaborators"
+	ArticleService_UploadAttachment_FullMethodName       = "/ArticleService/UploadAttachment"
+	ArticleService_GetAttachment_FullMethodName          = "/ArticleService/GetAttachment"
+	ArticleService_ReviewArticle_FullMethodName          = "/ArticleService/ReviewArticle"
+	ArticleService_ListPendingReviews_FullMethodName     = "/ArticleService/ListPendingReviews"
+	ArticleService_GetArticleReview_FullMethodName       = "/ArticleService/GetArticleReview"
+	ArticleService_GrantSubscription_FullMethodName      = "/ArticleService/GrantSubscription"
+	ArticleService_ExportArticles_FullMethodName         = "/ArticleService/ExportArticles"
+	ArticleService_ImportArticles_FullMethodName         = "/ArticleService/ImportArticles"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -35,10 +67,72 @@ type ArticleServiceClient interface {
 	Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveResponse, error)
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	CancelScheduledPublish(ctx context.Context, in *CancelScheduledPublishRequest, opts ...grpc.CallOption) (*CancelScheduledPublishResponse, error)
 	GetByAuthor(ctx context.Context, in *GetByAuthorRequest, opts ...grpc.CallOption) (*GetByAuthorResponse, error)
+	// 按照 (utime, id) 倒序的游标翻页，翻页期间文章被修改也不会重复或者遗漏
+	GetByAuthorByCursor(ctx context.Context, in *GetByAuthorByCursorRequest, opts ...grpc.CallOption) (*GetByAuthorByCursorResponse, error)
 	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error)
 	GetPubById(ctx context.Context, in *GetPubByIdRequest, opts ...grpc.CallOption) (*GetPubByIdResponse, error)
+	// GetPubByIds 给别的服务展示文章列表用，不算阅读，不是公开的文章只返回摘要
+	// 已经下线或者不存在的不返回
+	GetPubByIds(ctx context.Context, in *GetPubByIdsRequest, opts ...grpc.CallOption) (*GetPubByIdsResponse, error)
 	ListPub(ctx context.Context, in *ListPubRequest, opts ...grpc.CallOption) (*ListPubResponse, error)
+	ListPubByCursor(ctx context.Context, in *ListPubByCursorRequest, opts ...grpc.CallOption) (*ListPubByCursorResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	// Delete 放进回收站，已经发表的文章会同时下线
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// Restore 从回收站恢复成草稿，发表过的文章需要重新发表
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
+	// ListExpiredTrash 和 PurgeTrash 是给清理回收站的定时任务用的
+	ListExpiredTrash(ctx context.Context, in *ListExpiredTrashRequest, opts ...grpc.CallOption) (*ListExpiredTrashResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	// 系列，作者把已经发表的文章按顺序组织成章节，一篇文章只能在一个系列里面
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error)
+	// UpdateSeries 只修改标题和简介
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error)
+	// DeleteSeries 只删除系列，里面的文章不受影响
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error)
+	// GetSeries 给读者看的，只包含还在线上的文章
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error)
+	// ListSeries 给作者管理用的，包含已经下线的文章
+	ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+	// AddSeriesArticle 加到系列的最后
+	AddSeriesArticle(ctx context.Context, in *AddSeriesArticleRequest, opts ...grpc.CallOption) (*AddSeriesArticleResponse, error)
+	RemoveSeriesArticle(ctx context.Context, in *RemoveSeriesArticleRequest, opts ...grpc.CallOption) (*RemoveSeriesArticleResponse, error)
+	ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error)
+	// 协作者，作者之外，编辑者可以修改和发表文章，查看者只能看
+	// 修改状态、删除和管理协作者只有作者本人可以，没有权限的时候返回 PERMISSION_DENIED
+	// InviteCollaborator 已经是协作者的，就是修改角色
+	InviteCollaborator(ctx context.Context, in *InviteCollaboratorRequest, opts ...grpc.CallOption) (*InviteCollaboratorResponse, error)
+	// RemoveCollaborator 作者可以移除任何协作者，协作者可以自己退出
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	// 附件，文章内容里面用 attachment:<id> 引用，发表的时候换成真实的地址
+	// UploadAttachment 第一条消息必须是 meta，后面是文件内容的分片
+	// 内容一样的文件只保存一份，重复上传返回之前的附件
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error)
+	// 审核，发表的时候命中敏感词的文章不会发表，而是进入审核队列
+	// ReviewArticle 和 ListPendingReviews 只有审核员可以调用，不是的话返回 PERMISSION_DENIED
+	// ReviewArticle 驳回的时候必须有原因，审核之前文章又被修改过的话返回 ABORTED
+	ReviewArticle(ctx context.Context, in *ReviewArticleRequest, opts ...grpc.CallOption) (*ReviewArticleResponse, error)
+	// ListPendingReviews 先提交的排在前面
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error)
+	// GetArticleReview 作者查看文章最近一次的审核结果
+	GetArticleReview(ctx context.Context, in *GetArticleReviewRequest, opts ...grpc.CallOption) (*GetArticleReviewResponse, error)
+	// GrantSubscription 给读者开通或者续订对作者的订阅，只有管理员可以调用
+	GrantSubscription(ctx context.Context, in *GrantSubscriptionRequest, opts ...grpc.CallOption) (*GrantSubscriptionResponse, error)
+	// 导入导出，格式是 zip 包，每篇文章一个带 YAML front matter 的 Markdown 文件
+	// ExportArticles 导出作者所有的文章，回收站里面的除外，zip 包分片返回
+	ExportArticles(ctx context.Context, in *ExportArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportArticlesResponse], error)
+	// ImportArticles 第一条消息必须是 meta，后面是 zip 包的分片
+	// 客户端发完之后，服务端每处理完一个文件返回一条结果，单个文件失败不影响别的文件
+	// 每篇文章都是新建的，status 是 published 或者 scheduled 的走发表流程，别的保存成草稿
+	ImportArticles(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportArticlesRequest, ImportArticlesResponse], error)
 }
 
 type articleServiceClient struct {
@@ -79,6 +173,16 @@ func (c *articleServiceClient) Withdraw(ctx context.Context, in *WithdrawRequest
 	return out, nil
 }
 
+func (c *articleServiceClient) CancelScheduledPublish(ctx context.Context, in *CancelScheduledPublishRequest, opts ...grpc.CallOption) (*CancelScheduledPublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledPublishResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CancelScheduledPublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) GetByAuthor(ctx context.Context, in *GetByAuthorRequest, opts ...grpc.CallOption) (*GetByAuthorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetByAuthorResponse)
@@ -86,228 +190,1258 @@ func (c *articleServiceClient) GetByAuthor(ctx context.Context, in *GetByAuthorR
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *articleServiceClient) GetByAuthorByCursor(ctx context.Context, in *GetByAuthorByCursorRequest, opts ...grpc.CallOption) (*GetByAuthorByCursorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetByAuthorByCursorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetByAuthorByCursor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetByIdResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetPubById(ctx context.Context, in *GetPubByIdRequest, opts ...grpc.CallOption) (*GetPubByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPubByIdResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetPubById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetPubByIds(ctx context.Context, in *GetPubByIdsRequest, opts ...grpc.CallOption) (*GetPubByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPubByIdsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetPubByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListPub(ctx context.Context, in *ListPubRequest, opts ...grpc.CallOption) (*ListPubResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPubResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListPub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListPubByCursor(ctx context.Context, in *ListPubByCursorRequest, opts ...grpc.CallOption) (*ListPubByCursorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPubByCursorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListPubByCursor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DiffRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RestoreRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, ArticleService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, ArticleService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListExpiredTrash(ctx context.Context, in *ListExpiredTrashRequest, opts ...grpc.CallOption) (*ListExpiredTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiredTrashResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListExpiredTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, ArticleService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DeleteSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) AddSeriesArticle(ctx context.Context, in *AddSeriesArticleRequest, opts ...grpc.CallOption) (*AddSeriesArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSeriesArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_AddSeriesArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RemoveSeriesArticle(ctx context.Context, in *RemoveSeriesArticleRequest, opts ...grpc.CallOption) (*RemoveSeriesArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSeriesArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RemoveSeriesArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderSeriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ReorderSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) InviteCollaborator(ctx context.Context, in *InviteCollaboratorRequest, opts ...grpc.CallOption) (*InviteCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteCollaboratorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_InviteCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RemoveCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[0], ArticleService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *articleServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*GetAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ReviewArticle(ctx context.Context, in *ReviewArticleRequest, opts ...grpc.CallOption) (*ReviewArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ReviewArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingReviewsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListPendingReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleReview(ctx context.Context, in *GetArticleReviewRequest, opts ...grpc.CallOption) (*GetArticleReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleReviewResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GrantSubscription(ctx context.Context, in *GrantSubscriptionRequest, opts ...grpc.CallOption) (*GrantSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantSubscriptionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GrantSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ExportArticles(ctx context.Context, in *ExportArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportArticlesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[1], ArticleService_ExportArticles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportArticlesRequest, ExportArticlesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ExportArticlesClient = grpc.ServerStreamingClient[ExportArticlesResponse]
+
+func (c *articleServiceClient) ImportArticles(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportArticlesRequest, ImportArticlesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[2], ArticleService_ImportArticles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportArticlesRequest, ImportArticlesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ImportArticlesClient = grpc.BidiStreamingClient[ImportArticlesRequest, ImportArticlesResponse]
+
+// ArticleServiceServer is the server API for ArticleService service.
+// All implementations must embed UnimplementedArticleServiceServer
+// for forward compatibility.
+type ArticleServiceServer interface {
+	Save(context.Context, *SaveRequest) (*SaveResponse, error)
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
+	CancelScheduledPublish(context.Context, *CancelScheduledPublishRequest) (*CancelScheduledPublishResponse, error)
+	GetByAuthor(context.Context, *GetByAuthorRequest) (*GetByAuthorResponse, error)
+	// 按照 (utime, id) 倒序的游标翻页，翻页期间文章被修改也不会重复或者遗漏
+	GetByAuthorByCursor(context.Context, *GetByAuthorByCursorRequest) (*GetByAuthorByCursorResponse, error)
+	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
+	GetPubById(context.Context, *GetPubByIdRequest) (*GetPubByIdResponse, error)
+	// GetPubByIds 给别的服务展示文章列表用，不算阅读，不是公开的文章只返回摘要
+	// 已经下线或者不存在的不返回
+	GetPubByIds(context.Context, *GetPubByIdsRequest) (*GetPubByIdsResponse, error)
+	ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error)
+	ListPubByCursor(context.Context, *ListPubByCursorRequest) (*ListPubByCursorResponse, error)
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	// Delete 放进回收站，已经发表的文章会同时下线
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// Restore 从回收站恢复成草稿，发表过的文章需要重新发表
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
+	// ListExpiredTrash 和 PurgeTrash 是给清理回收站的定时任务用的
+	ListExpiredTrash(context.Context, *ListExpiredTrashRequest) (*ListExpiredTrashResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// 系列，作者把已经发表的文章按顺序组织成章节，一篇文章只能在一个系列里面
+	CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error)
+	// UpdateSeries 只修改标题和简介
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error)
+	// DeleteSeries 只删除系列，里面的文章不受影响
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error)
+	// GetSeries 给读者看的，只包含还在线上的文章
+	GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error)
+	// ListSeries 给作者管理用的，包含已经下线的文章
+	ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error)
+	// AddSeriesArticle 加到系列的最后
+	AddSeriesArticle(context.Context, *AddSeriesArticleRequest) (*AddSeriesArticleResponse, error)
+	RemoveSeriesArticle(context.Context, *RemoveSeriesArticleRequest) (*RemoveSeriesArticleResponse, error)
+	ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error)
+	// 协作者，作者之外，编辑者可以修改和发表文章，查看者只能看
+	// 修改状态、删除和管理协作者只有作者本人可以，没有权限的时候返回 PERMISSION_DENIED
+	// InviteCollaborator 已经是协作者的，就是修改角色
+	InviteCollaborator(context.Context, *InviteCollaboratorRequest) (*InviteCollaboratorResponse, error)
+	// RemoveCollaborator 作者可以移除任何协作者，协作者可以自己退出
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	// 附件，文章内容里面用 attachment:<id> 引用，发表的时候换成真实的地址
+	// UploadAttachment 第一条消息必须是 meta，后面是文件内容的分片
+	// 内容一样的文件只保存一份，重复上传返回之前的附件
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error)
+	// 审核，发表的时候命中敏感词的文章不会发表，而是进入审核队列
+	// ReviewArticle 和 ListPendingReviews 只有审核员可以调用，不是的话返回 PERMISSION_DENIED
+	// ReviewArticle 驳回的时候必须有原因，审核之前文章又被修改过的话返回 ABORTED
+	ReviewArticle(context.Context, *ReviewArticleRequest) (*ReviewArticleResponse, error)
+	// ListPendingReviews 先提交的排在前面
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error)
+	// GetArticleReview 作者查看文章最近一次的审核结果
+	GetArticleReview(context.Context, *GetArticleReviewRequest) (*GetArticleReviewResponse, error)
+	// GrantSubscription 给读者开通或者续订对作者的订阅，只有管理员可以调用
+	GrantSubscription(context.Context, *GrantSubscriptionRequest) (*GrantSubscriptionResponse, error)
+	// 导入导出，格式是 zip 包，每篇文章一个带 YAML front matter 的 Markdown 文件
+	// ExportArticles 导出作者所有的文章，回收站里面的除外，zip 包分片返回
+	ExportArticles(*ExportArticlesRequest, grpc.ServerStreamingServer[ExportArticlesResponse]) error
+	// ImportArticles 第一条消息必须是 meta，后面是 zip 包的分片
+	// 客户端发完之后，服务端每处理完一个文件返回一条结果，单个文件失败不影响别的文件
+	// 每篇文章都是新建的，status 是 published 或者 scheduled 的走发表流程，别的保存成草稿
+	ImportArticles(grpc.BidiStreamingServer[ImportArticlesRequest, ImportArticlesResponse]) error
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+// UnimplementedArticleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedArticleServiceServer struct{}
+
+func (UnimplementedArticleServiceServer) Save(context.Context, *SaveRequest) (*SaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
+}
+func (UnimplementedArticleServiceServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedArticleServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedArticleServiceServer) CancelScheduledPublish(context.Context, *CancelScheduledPublishRequest) (*CancelScheduledPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPublish not implemented")
+}
+func (UnimplementedArticleServiceServer) GetByAuthor(context.Context, *GetByAuthorRequest) (*GetByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByAuthor not implemented")
+}
+func (UnimplementedArticleServiceServer) GetByAuthorByCursor(context.Context, *GetByAuthorByCursorRequest) (*GetByAuthorByCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByAuthorByCursor not implemented")
+}
+func (UnimplementedArticleServiceServer) GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedArticleServiceServer) GetPubById(context.Context, *GetPubByIdRequest) (*GetPubByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubById not implemented")
+}
+func (UnimplementedArticleServiceServer) GetPubByIds(context.Context, *GetPubByIdsRequest) (*GetPubByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubByIds not implemented")
+}
+func (UnimplementedArticleServiceServer) ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPub not implemented")
+}
+func (UnimplementedArticleServiceServer) ListPubByCursor(context.Context, *ListPubByCursorRequest) (*ListPubByCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPubByCursor not implemented")
+}
+func (UnimplementedArticleServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedArticleServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedArticleServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedArticleServiceServer) ListExpiredTrash(context.Context, *ListExpiredTrashRequest) (*ListExpiredTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiredTrash not implemented")
+}
+func (UnimplementedArticleServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedArticleServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) AddSeriesArticle(context.Context, *AddSeriesArticleRequest) (*AddSeriesArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSeriesArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) RemoveSeriesArticle(context.Context, *RemoveSeriesArticleRequest) (*RemoveSeriesArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeriesArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSeries not implemented")
+}
+func (UnimplementedArticleServiceServer) InviteCollaborator(context.Context, *InviteCollaboratorRequest) (*InviteCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCollaborator not implemented")
+}
+func (UnimplementedArticleServiceServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedArticleServiceServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedArticleServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedArticleServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*GetAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedArticleServiceServer) ReviewArticle(context.Context, *ReviewArticleRequest) (*ReviewArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleReview(context.Context, *GetArticleReviewRequest) (*GetArticleReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleReview not implemented")
+}
+func (UnimplementedArticleServiceServer) GrantSubscription(context.Context, *GrantSubscriptionRequest) (*GrantSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSubscription not implemented")
+}
+func (UnimplementedArticleServiceServer) ExportArticles(*ExportArticlesRequest, grpc.ServerStreamingServer[ExportArticlesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) ImportArticles(grpc.BidiStreamingServer[ImportArticlesRequest, ImportArticlesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
+func (UnimplementedArticleServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArticleServiceServer will
+// result in compilation errors.
+type UnsafeArticleServiceServer interface {
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+func RegisterArticleServiceServer(s grpc.ServiceRegistrar, srv ArticleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedArticleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ArticleService_ServiceDesc, srv)
+}
+
+func _ArticleService_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Save(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Save_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Save(ctx, req.(*SaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CancelScheduledPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CancelScheduledPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CancelScheduledPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CancelScheduledPublish(ctx, req.(*CancelScheduledPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetByAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetByAuthor(ctx, req.(*GetByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetByAuthorByCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByAuthorByCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetByAuthorByCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetByAuthorByCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetByAuthorByCursor(ctx, req.(*GetByAuthorByCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetById(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetPubById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPubByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetPubById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetPubById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetPubById(ctx, req.(*GetPubByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetPubByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPubByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetPubByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetPubByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetPubByIds(ctx, req.(*GetPubByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListPub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListPub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListPub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListPub(ctx, req.(*ListPubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListPubByCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPubByCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListPubByCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListPubByCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListPubByCursor(ctx, req.(*ListPubByCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListExpiredTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiredTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListExpiredTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListExpiredTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListExpiredTrash(ctx, req.(*ListExpiredTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *articleServiceClient) GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetByIdResponse)
-	err := c.cc.Invoke(ctx, ArticleService_GetById_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ArticleService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DeleteSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *articleServiceClient) GetPubById(ctx context.Context, in *GetPubByIdRequest, opts ...grpc.CallOption) (*GetPubByIdResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPubByIdResponse)
-	err := c.cc.Invoke(ctx, ArticleService_GetPubById_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ArticleService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *articleServiceClient) ListPub(ctx context.Context, in *ListPubRequest, opts ...grpc.CallOption) (*ListPubResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPubResponse)
-	err := c.cc.Invoke(ctx, ArticleService_ListPub_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ArticleService_ListSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListSeries(ctx, req.(*ListSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ArticleServiceServer is the server API for ArticleService service.
-// All implementations must embed UnimplementedArticleServiceServer
-// for forward compatibility.
-type ArticleServiceServer interface {
-	Save(context.Context, *SaveRequest) (*SaveResponse, error)
-	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
-	Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
-	GetByAuthor(context.Context, *GetByAuthorRequest) (*GetByAuthorResponse, error)
-	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
-	GetPubById(context.Context, *GetPubByIdRequest) (*GetPubByIdResponse, error)
-	ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error)
-	mustEmbedUnimplementedArticleServiceServer()
+func _ArticleService_AddSeriesArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSeriesArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).AddSeriesArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_AddSeriesArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).AddSeriesArticle(ctx, req.(*AddSeriesArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedArticleServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedArticleServiceServer struct{}
-
-func (UnimplementedArticleServiceServer) Save(context.Context, *SaveRequest) (*SaveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
-}
-func (UnimplementedArticleServiceServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
-}
-func (UnimplementedArticleServiceServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
-}
-func (UnimplementedArticleServiceServer) GetByAuthor(context.Context, *GetByAuthorRequest) (*GetByAuthorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByAuthor not implemented")
-}
-func (UnimplementedArticleServiceServer) GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
-}
-func (UnimplementedArticleServiceServer) GetPubById(context.Context, *GetPubByIdRequest) (*GetPubByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPubById not implemented")
-}
-func (UnimplementedArticleServiceServer) ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPub not implemented")
+func _ArticleService_RemoveSeriesArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSeriesArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RemoveSeriesArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RemoveSeriesArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RemoveSeriesArticle(ctx, req.(*RemoveSeriesArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
-func (UnimplementedArticleServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ArticleServiceServer will
-// result in compilation errors.
-type UnsafeArticleServiceServer interface {
-	mustEmbedUnimplementedArticleServiceServer()
+func _ArticleService_ReorderSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ReorderSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ReorderSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ReorderSeries(ctx, req.(*ReorderSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterArticleServiceServer(s grpc.ServiceRegistrar, srv ArticleServiceServer) {
-	// If the following call pancis, it indicates UnimplementedArticleServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ArticleService_InviteCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ArticleService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).InviteCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_InviteCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).InviteCollaborator(ctx, req.(*InviteCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveRequest)
+func _ArticleService_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).Save(ctx, in)
+		return srv.(ArticleServiceServer).RemoveCollaborator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_Save_FullMethodName,
+		FullMethod: ArticleService_RemoveCollaborator_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).Save(ctx, req.(*SaveRequest))
+		return srv.(ArticleServiceServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishRequest)
+func _ArticleService_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).Publish(ctx, in)
+		return srv.(ArticleServiceServer).ListCollaborators(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_Publish_FullMethodName,
+		FullMethod: ArticleService_ListCollaborators_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).Publish(ctx, req.(*PublishRequest))
+		return srv.(ArticleServiceServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawRequest)
+func _ArticleService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArticleServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _ArticleService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).Withdraw(ctx, in)
+		return srv.(ArticleServiceServer).GetAttachment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_Withdraw_FullMethodName,
+		FullMethod: ArticleService_GetAttachment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).Withdraw(ctx, req.(*WithdrawRequest))
+		return srv.(ArticleServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_GetByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByAuthorRequest)
+func _ArticleService_ReviewArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewArticleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).GetByAuthor(ctx, in)
+		return srv.(ArticleServiceServer).ReviewArticle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_GetByAuthor_FullMethodName,
+		FullMethod: ArticleService_ReviewArticle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).GetByAuthor(ctx, req.(*GetByAuthorRequest))
+		return srv.(ArticleServiceServer).ReviewArticle(ctx, req.(*ReviewArticleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _ArticleService_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).GetById(ctx, in)
+		return srv.(ArticleServiceServer).ListPendingReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_GetById_FullMethodName,
+		FullMethod: ArticleService_ListPendingReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).GetById(ctx, req.(*GetByIdRequest))
+		return srv.(ArticleServiceServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_GetPubById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPubByIdRequest)
+func _ArticleService_GetArticleReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).GetPubById(ctx, in)
+		return srv.(ArticleServiceServer).GetArticleReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_GetPubById_FullMethodName,
+		FullMethod: ArticleService_GetArticleReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).GetPubById(ctx, req.(*GetPubByIdRequest))
+		return srv.(ArticleServiceServer).GetArticleReview(ctx, req.(*GetArticleReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArticleService_ListPub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPubRequest)
+func _ArticleService_GrantSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArticleServiceServer).ListPub(ctx, in)
+		return srv.(ArticleServiceServer).GrantSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArticleService_ListPub_FullMethodName,
+		FullMethod: ArticleService_GrantSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServiceServer).ListPub(ctx, req.(*ListPubRequest))
+		return srv.(ArticleServiceServer).GrantSubscription(ctx, req.(*GrantSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_ExportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportArticlesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArticleServiceServer).ExportArticles(m, &grpc.GenericServerStream[ExportArticlesRequest, ExportArticlesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ExportArticlesServer = grpc.ServerStreamingServer[ExportArticlesResponse]
+
+func _ArticleService_ImportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArticleServiceServer).ImportArticles(&grpc.GenericServerStream[ImportArticlesRequest, ImportArticlesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ImportArticlesServer = grpc.BidiStreamingServer[ImportArticlesRequest, ImportArticlesResponse]
+
 // ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,10 +1461,18 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Withdraw",
 			Handler:    _ArticleService_Withdraw_Handler,
 		},
+		{
+			MethodName: "CancelScheduledPublish",
+			Handler:    _ArticleService_CancelScheduledPublish_Handler,
+		},
 		{
 			MethodName: "GetByAuthor",
 			Handler:    _ArticleService_GetByAuthor_Handler,
 		},
+		{
+			MethodName: "GetByAuthorByCursor",
+			Handler:    _ArticleService_GetByAuthorByCursor_Handler,
+		},
 		{
 			MethodName: "GetById",
 			Handler:    _ArticleService_GetById_Handler,
@@ -339,11 +1481,136 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPubById",
 			Handler:    _ArticleService_GetPubById_Handler,
 		},
+		{
+			MethodName: "GetPubByIds",
+			Handler:    _ArticleService_GetPubByIds_Handler,
+		},
 		{
 			MethodName: "ListPub",
 			Handler:    _ArticleService_ListPub_Handler,
 		},
+		{
+			MethodName: "ListPubByCursor",
+			Handler:    _ArticleService_ListPubByCursor_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ArticleService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _ArticleService_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _ArticleService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _ArticleService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ArticleService_Delete_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _ArticleService_ListTrash_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _ArticleService_Restore_Handler,
+		},
+		{
+			MethodName: "ListExpiredTrash",
+			Handler:    _ArticleService_ListExpiredTrash_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _ArticleService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _ArticleService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _ArticleService_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _ArticleService_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _ArticleService_GetSeries_Handler,
+		},
+		{
+			MethodName: "ListSeries",
+			Handler:    _ArticleService_ListSeries_Handler,
+		},
+		{
+			MethodName: "AddSeriesArticle",
+			Handler:    _ArticleService_AddSeriesArticle_Handler,
+		},
+		{
+			MethodName: "RemoveSeriesArticle",
+			Handler:    _ArticleService_RemoveSeriesArticle_Handler,
+		},
+		{
+			MethodName: "ReorderSeries",
+			Handler:    _ArticleService_ReorderSeries_Handler,
+		},
+		{
+			MethodName: "InviteCollaborator",
+			Handler:    _ArticleService_InviteCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _ArticleService_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _ArticleService_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _ArticleService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "ReviewArticle",
+			Handler:    _ArticleService_ReviewArticle_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _ArticleService_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "GetArticleReview",
+			Handler:    _ArticleService_GetArticleReview_Handler,
+		},
+		{
+			MethodName: "GrantSubscription",
+			Handler:    _ArticleService_GrantSubscription_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _ArticleService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportArticles",
+			Handler:       _ArticleService_ExportArticles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportArticles",
+			Handler:       _ArticleService_ImportArticles_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "article/v1/article.proto",
 }
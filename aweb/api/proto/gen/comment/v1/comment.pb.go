@@ -22,6 +22,94 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DeleteByBizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizIds        []int64                `protobuf:"varint,2,rep,packed,name=biz_ids,json=bizIds,proto3" json:"biz_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteByBizRequest) Reset() {
+	*x = DeleteByBizRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteByBizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteByBizRequest) ProtoMessage() {}
+
+func (x *DeleteByBizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteByBizRequest.ProtoReflect.Descriptor instead.
+func (*DeleteByBizRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteByBizRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *DeleteByBizRequest) GetBizIds() []int64 {
+	if x != nil {
+		return x.BizIds
+	}
+	return nil
+}
+
+type DeleteByBizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteByBizResponse) Reset() {
+	*x = DeleteByBizResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteByBizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteByBizResponse) ProtoMessage() {}
+
+func (x *DeleteByBizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteByBizResponse.ProtoReflect.Descriptor instead.
+func (*DeleteByBizResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+}
+
 type CommentListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 按照资源来排序
@@ -37,7 +125,7 @@ type CommentListRequest struct {
 
 func (x *CommentListRequest) Reset() {
 	*x = CommentListRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49,7 +137,7 @@ func (x *CommentListRequest) String() string {
 func (*CommentListRequest) ProtoMessage() {}
 
 func (x *CommentListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +150,7 @@ func (x *CommentListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentListRequest.ProtoReflect.Descriptor instead.
 func (*CommentListRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CommentListRequest) GetBiz() string {
@@ -103,7 +191,7 @@ type CommentListResponse struct {
 
 func (x *CommentListResponse) Reset() {
 	*x = CommentListResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -115,7 +203,7 @@ func (x *CommentListResponse) String() string {
 func (*CommentListResponse) ProtoMessage() {}
 
 func (x *CommentListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,7 +216,7 @@ func (x *CommentListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentListResponse.ProtoReflect.Descriptor instead.
 func (*CommentListResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CommentListResponse) GetComments() []*Comment {
@@ -154,7 +242,7 @@ type DeleteCommentRequest struct {
 
 func (x *DeleteCommentRequest) Reset() {
 	*x = DeleteCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -166,7 +254,7 @@ func (x *DeleteCommentRequest) String() string {
 func (*DeleteCommentRequest) ProtoMessage() {}
 
 func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +267,7 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{2}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DeleteCommentRequest) GetId() int64 {
@@ -197,7 +285,7 @@ type DeleteCommentResponse struct {
 
 func (x *DeleteCommentResponse) Reset() {
 	*x = DeleteCommentResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +297,7 @@ func (x *DeleteCommentResponse) String() string {
 func (*DeleteCommentResponse) ProtoMessage() {}
 
 func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +310,7 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{3}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5}
 }
 
 type CreateCommentRequest struct {
@@ -234,7 +322,7 @@ type CreateCommentRequest struct {
 
 func (x *CreateCommentRequest) Reset() {
 	*x = CreateCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -246,7 +334,7 @@ func (x *CreateCommentRequest) String() string {
 func (*CreateCommentRequest) ProtoMessage() {}
 
 func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +347,7 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{4}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateCommentRequest) GetComment() *Comment {
@@ -277,7 +365,7 @@ type CreateCommentResponse struct {
 
 func (x *CreateCommentResponse) Reset() {
 	*x = CreateCommentResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +377,7 @@ func (x *CreateCommentResponse) String() string {
 func (*CreateCommentResponse) ProtoMessage() {}
 
 func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +390,7 @@ func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
 func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{7}
 }
 
 type GetMoreRepliesRequest struct {
@@ -318,7 +406,7 @@ type GetMoreRepliesRequest struct {
 
 func (x *GetMoreRepliesRequest) Reset() {
 	*x = GetMoreRepliesRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +418,7 @@ func (x *GetMoreRepliesRequest) String() string {
 func (*GetMoreRepliesRequest) ProtoMessage() {}
 
 func (x *GetMoreRepliesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +431,7 @@ func (x *GetMoreRepliesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMoreRepliesRequest.ProtoReflect.Descriptor instead.
 func (*GetMoreRepliesRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetMoreRepliesRequest) GetRid() int64 {
@@ -377,7 +465,7 @@ type GetMoreRepliesResponse struct {
 
 func (x *GetMoreRepliesResponse) Reset() {
 	*x = GetMoreRepliesResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +477,7 @@ func (x *GetMoreRepliesResponse) String() string {
 func (*GetMoreRepliesResponse) ProtoMessage() {}
 
 func (x *GetMoreRepliesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +490,7 @@ func (x *GetMoreRepliesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMoreRepliesResponse.ProtoReflect.Descriptor instead.
 func (*GetMoreRepliesResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{7}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMoreRepliesResponse) GetReplies() []*Comment {
@@ -436,7 +524,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +536,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +549,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8}
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Comment) GetId() int64 {
@@ -532,7 +620,11 @@ var File_comment_v1_comment_proto protoreflect.FileDescriptor
 const file_comment_v1_comment_proto_rawDesc = "" +
 	"\n" +
 	"\x18comment/v1/comment.proto\x12\n" +
-	"comment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"i\n" +
+	"comment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
+	"\x12DeleteByBizRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x17\n" +
+	"\abiz_ids\x18\x02 \x03(\x03R\x06bizIds\"\x15\n" +
+	"\x13DeleteByBizResponse\"i\n" +
 	"\x12CommentListRequest\x12\x10\n" +
 	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x14\n" +
 	"\x05bizid\x18\x02 \x01(\x03R\x05bizid\x12\x15\n" +
@@ -564,12 +656,13 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"\x0eparent_comment\x18\a \x01(\v2\x13.comment.v1.CommentR\rparentComment\x120\n" +
 	"\x05ctime\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05ctime\x120\n" +
 	"\x05utime\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\x05utime2\xe8\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05utime2\xb8\x03\n" +
 	"\x0eCommentService\x12Q\n" +
 	"\x0eGetCommentList\x12\x1e.comment.v1.CommentListRequest\x1a\x1f.comment.v1.CommentListResponse\x12T\n" +
 	"\rDeleteComment\x12 .comment.v1.DeleteCommentRequest\x1a!.comment.v1.DeleteCommentResponse\x12T\n" +
 	"\rCreateComment\x12 .comment.v1.CreateCommentRequest\x1a!.comment.v1.CreateCommentResponse\x12W\n" +
-	"\x0eGetMoreReplies\x12!.comment.v1.GetMoreRepliesRequest\x1a\".comment.v1.GetMoreRepliesResponseB\xac\x01\n" +
+	"\x0eGetMoreReplies\x12!.comment.v1.GetMoreRepliesRequest\x1a\".comment.v1.GetMoreRepliesResponse\x12N\n" +
+	"\vDeleteByBiz\x12\x1e.comment.v1.DeleteByBizRequest\x1a\x1f.comment.v1.DeleteByBizResponseB\xac\x01\n" +
 	"\x0ecom.comment.v1B\fCommentProtoP\x01ZCgithub.com/pluckhuang/goweb/aweb/api/proto/gen/comment/v1;commentv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Comment.V1\xca\x02\n" +
 	"Comment\\V1\xe2\x02\x16Comment\\V1\\GPBMetadata\xea\x02\vComment::V1b\x06proto3"
@@ -586,37 +679,41 @@ func file_comment_v1_comment_proto_rawDescGZIP() []byte {
 	return file_comment_v1_comment_proto_rawDescData
 }
 
-var file_comment_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_comment_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_comment_v1_comment_proto_goTypes = []any{
-	(*CommentListRequest)(nil),     // 0: comment.v1.CommentListRequest
-	(*CommentListResponse)(nil),    // 1: comment.v1.CommentListResponse
-	(*DeleteCommentRequest)(nil),   // 2: comment.v1.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),  // 3: comment.v1.DeleteCommentResponse
-	(*CreateCommentRequest)(nil),   // 4: comment.v1.CreateCommentRequest
-	(*CreateCommentResponse)(nil),  // 5: comment.v1.CreateCommentResponse
-	(*GetMoreRepliesRequest)(nil),  // 6: comment.v1.GetMoreRepliesRequest
-	(*GetMoreRepliesResponse)(nil), // 7: comment.v1.GetMoreRepliesResponse
-	(*Comment)(nil),                // 8: comment.v1.Comment
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*DeleteByBizRequest)(nil),     // 0: comment.v1.DeleteByBizRequest
+	(*DeleteByBizResponse)(nil),    // 1: comment.v1.DeleteByBizResponse
+	(*CommentListRequest)(nil),     // 2: comment.v1.CommentListRequest
+	(*CommentListResponse)(nil),    // 3: comment.v1.CommentListResponse
+	(*DeleteCommentRequest)(nil),   // 4: comment.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),  // 5: comment.v1.DeleteCommentResponse
+	(*CreateCommentRequest)(nil),   // 6: comment.v1.CreateCommentRequest
+	(*CreateCommentResponse)(nil),  // 7: comment.v1.CreateCommentResponse
+	(*GetMoreRepliesRequest)(nil),  // 8: comment.v1.GetMoreRepliesRequest
+	(*GetMoreRepliesResponse)(nil), // 9: comment.v1.GetMoreRepliesResponse
+	(*Comment)(nil),                // 10: comment.v1.Comment
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
 }
 var file_comment_v1_comment_proto_depIdxs = []int32{
-	8,  // 0: comment.v1.CommentListResponse.comments:type_name -> comment.v1.Comment
-	8,  // 1: comment.v1.CreateCommentRequest.comment:type_name -> comment.v1.Comment
-	8,  // 2: comment.v1.GetMoreRepliesResponse.replies:type_name -> comment.v1.Comment
-	8,  // 3: comment.v1.Comment.root_comment:type_name -> comment.v1.Comment
-	8,  // 4: comment.v1.Comment.parent_comment:type_name -> comment.v1.Comment
-	9,  // 5: comment.v1.Comment.ctime:type_name -> google.protobuf.Timestamp
-	9,  // 6: comment.v1.Comment.utime:type_name -> google.protobuf.Timestamp
-	0,  // 7: comment.v1.CommentService.GetCommentList:input_type -> comment.v1.CommentListRequest
-	2,  // 8: comment.v1.CommentService.DeleteComment:input_type -> comment.v1.DeleteCommentRequest
-	4,  // 9: comment.v1.CommentService.CreateComment:input_type -> comment.v1.CreateCommentRequest
-	6,  // 10: comment.v1.CommentService.GetMoreReplies:input_type -> comment.v1.GetMoreRepliesRequest
-	1,  // 11: comment.v1.CommentService.GetCommentList:output_type -> comment.v1.CommentListResponse
-	3,  // 12: comment.v1.CommentService.DeleteComment:output_type -> comment.v1.DeleteCommentResponse
-	5,  // 13: comment.v1.CommentService.CreateComment:output_type -> comment.v1.CreateCommentResponse
-	7,  // 14: comment.v1.CommentService.GetMoreReplies:output_type -> comment.v1.GetMoreRepliesResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
+	10, // 0: comment.v1.CommentListResponse.comments:type_name -> comment.v1.Comment
+	10, // 1: comment.v1.CreateCommentRequest.comment:type_name -> comment.v1.Comment
+	10, // 2: comment.v1.GetMoreRepliesResponse.replies:type_name -> comment.v1.Comment
+	10, // 3: comment.v1.Comment.root_comment:type_name -> comment.v1.Comment
+	10, // 4: comment.v1.Comment.parent_comment:type_name -> comment.v1.Comment
+	11, // 5: comment.v1.Comment.ctime:type_name -> google.protobuf.Timestamp
+	11, // 6: comment.v1.Comment.utime:type_name -> google.protobuf.Timestamp
+	2,  // 7: comment.v1.CommentService.GetCommentList:input_type -> comment.v1.CommentListRequest
+	4,  // 8: comment.v1.CommentService.DeleteComment:input_type -> comment.v1.DeleteCommentRequest
+	6,  // 9: comment.v1.CommentService.CreateComment:input_type -> comment.v1.CreateCommentRequest
+	8,  // 10: comment.v1.CommentService.GetMoreReplies:input_type -> comment.v1.GetMoreRepliesRequest
+	0,  // 11: comment.v1.CommentService.DeleteByBiz:input_type -> comment.v1.DeleteByBizRequest
+	3,  // 12: comment.v1.CommentService.GetCommentList:output_type -> comment.v1.CommentListResponse
+	5,  // 13: comment.v1.CommentService.DeleteComment:output_type -> comment.v1.DeleteCommentResponse
+	7,  // 14: comment.v1.CommentService.CreateComment:output_type -> comment.v1.CreateCommentResponse
+	9,  // 15: comment.v1.CommentService.GetMoreReplies:output_type -> comment.v1.GetMoreRepliesResponse
+	1,  // 16: comment.v1.CommentService.DeleteByBiz:output_type -> comment.v1.DeleteByBizResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -633,7 +730,7 @@ func file_comment_v1_comment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_v1_comment_proto_rawDesc), len(file_comment_v1_comment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
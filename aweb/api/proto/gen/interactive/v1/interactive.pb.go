@@ -9,6 +9,7 @@ package interactivev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,28 +22,134 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetByIdsRequest struct {
+type Granularity int32
+
+const (
+	Granularity_GRANULARITY_UNKNOWN Granularity = 0
+	Granularity_GRANULARITY_HOUR    Granularity = 1
+	Granularity_GRANULARITY_DAY     Granularity = 2
+)
+
+// Enum value maps for Granularity.
+var (
+	Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNKNOWN",
+		1: "GRANULARITY_HOUR",
+		2: "GRANULARITY_DAY",
+	}
+	Granularity_value = map[string]int32{
+		"GRANULARITY_UNKNOWN": 0,
+		"GRANULARITY_HOUR":    1,
+		"GRANULARITY_DAY":     2,
+	}
+)
+
+func (x Granularity) Enum() *Granularity {
+	p := new(Granularity)
+	*p = x
+	return p
+}
+
+func (x Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_interactive_v1_interactive_proto_enumTypes[0].Descriptor()
+}
+
+func (Granularity) Type() protoreflect.EnumType {
+	return &file_interactive_v1_interactive_proto_enumTypes[0]
+}
+
+func (x Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Granularity.Descriptor instead.
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{0}
+}
+
+type ReactionType int32
+
+const (
+	ReactionType_REACTION_TYPE_UNKNOWN ReactionType = 0
+	// 有启发
+	ReactionType_REACTION_TYPE_INSIGHTFUL ReactionType = 1
+	// 有趣
+	ReactionType_REACTION_TYPE_FUNNY ReactionType = 2
+	// 庆祝
+	ReactionType_REACTION_TYPE_CELEBRATE ReactionType = 3
+)
+
+// Enum value maps for ReactionType.
+var (
+	ReactionType_name = map[int32]string{
+		0: "REACTION_TYPE_UNKNOWN",
+		1: "REACTION_TYPE_INSIGHTFUL",
+		2: "REACTION_TYPE_FUNNY",
+		3: "REACTION_TYPE_CELEBRATE",
+	}
+	ReactionType_value = map[string]int32{
+		"REACTION_TYPE_UNKNOWN":    0,
+		"REACTION_TYPE_INSIGHTFUL": 1,
+		"REACTION_TYPE_FUNNY":      2,
+		"REACTION_TYPE_CELEBRATE":  3,
+	}
+)
+
+func (x ReactionType) Enum() *ReactionType {
+	p := new(ReactionType)
+	*p = x
+	return p
+}
+
+func (x ReactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_interactive_v1_interactive_proto_enumTypes[1].Descriptor()
+}
+
+func (ReactionType) Type() protoreflect.EnumType {
+	return &file_interactive_v1_interactive_proto_enumTypes[1]
+}
+
+func (x ReactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactionType.Descriptor instead.
+func (ReactionType) EnumDescriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{1}
+}
+
+type ReactRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Type          ReactionType           `protobuf:"varint,4,opt,name=type,proto3,enum=interactive.v1.ReactionType" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdsRequest) Reset() {
-	*x = GetByIdsRequest{}
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
 	mi := &file_interactive_v1_interactive_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdsRequest) String() string {
+func (x *ReactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdsRequest) ProtoMessage() {}
+func (*ReactRequest) ProtoMessage() {}
 
-func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_interactive_v1_interactive_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -54,47 +161,1916 @@ func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdsRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
 	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetByIdsRequest) GetBiz() string {
+func (x *ReactRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ReactRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ReactRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNKNOWN
+}
+
+type ReactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactResponse) Reset() {
+	*x = ReactResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactResponse) ProtoMessage() {}
+
+func (x *ReactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactResponse.ProtoReflect.Descriptor instead.
+func (*ReactResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{1}
+}
+
+type UnreactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreactRequest) Reset() {
+	*x = UnreactRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactRequest) ProtoMessage() {}
+
+func (x *UnreactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactRequest.ProtoReflect.Descriptor instead.
+func (*UnreactRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnreactRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *UnreactRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *UnreactRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type UnreactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreactResponse) Reset() {
+	*x = UnreactResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactResponse) ProtoMessage() {}
+
+func (x *UnreactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactResponse.ProtoReflect.Descriptor instead.
+func (*UnreactResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{3}
+}
+
+type GetReactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReactionsRequest) Reset() {
+	*x = GetReactionsRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReactionsRequest) ProtoMessage() {}
+
+func (x *GetReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetReactionsRequest) GetBiz() string {
 	if x != nil {
 		return x.Biz
 	}
 	return ""
 }
 
-func (x *GetByIdsRequest) GetIds() []int64 {
+func (x *GetReactionsRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GetReactionsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ReactionType           `protobuf:"varint,1,opt,name=type,proto3,enum=interactive.v1.ReactionType" json:"type,omitempty"`
+	Cnt           int64                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReactionCount) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNKNOWN
+}
+
+func (x *ReactionCount) GetCnt() int64 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+type GetReactionsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Counts []*ReactionCount       `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	// 没有表态是 REACTION_TYPE_UNKNOWN
+	MyReaction    ReactionType `protobuf:"varint,2,opt,name=my_reaction,json=myReaction,proto3,enum=interactive.v1.ReactionType" json:"my_reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReactionsResponse) Reset() {
+	*x = GetReactionsResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReactionsResponse) ProtoMessage() {}
+
+func (x *GetReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReactionsResponse) GetCounts() []*ReactionCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetReactionsResponse) GetMyReaction() ReactionType {
+	if x != nil {
+		return x.MyReaction
+	}
+	return ReactionType_REACTION_TYPE_UNKNOWN
+}
+
+type GetSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*GetSeriesRequest_BizId
+	//	*GetSeriesRequest_AuthorId
+	Target        isGetSeriesRequest_Target `protobuf_oneof:"target"`
+	Biz           string                    `protobuf:"bytes,3,opt,name=biz,proto3" json:"biz,omitempty"`
+	Granularity   Granularity               `protobuf:"varint,4,opt,name=granularity,proto3,enum=interactive.v1.Granularity" json:"granularity,omitempty"`
+	Start         *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesRequest) Reset() {
+	*x = GetSeriesRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesRequest) ProtoMessage() {}
+
+func (x *GetSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSeriesRequest) GetTarget() isGetSeriesRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *GetSeriesRequest) GetBizId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*GetSeriesRequest_BizId); ok {
+			return x.BizId
+		}
+	}
+	return 0
+}
+
+func (x *GetSeriesRequest) GetAuthorId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*GetSeriesRequest_AuthorId); ok {
+			return x.AuthorId
+		}
+	}
+	return 0
+}
+
+func (x *GetSeriesRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetSeriesRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNKNOWN
+}
+
+func (x *GetSeriesRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetSeriesRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type isGetSeriesRequest_Target interface {
+	isGetSeriesRequest_Target()
+}
+
+type GetSeriesRequest_BizId struct {
+	// 单个资源，要和 biz 一起用
+	BizId int64 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3,oneof"`
+}
+
+type GetSeriesRequest_AuthorId struct {
+	// 作者所有的文章，只支持 biz 是 article
+	AuthorId int64 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3,oneof"`
+}
+
+func (*GetSeriesRequest_BizId) isGetSeriesRequest_Target() {}
+
+func (*GetSeriesRequest_AuthorId) isGetSeriesRequest_Target() {}
+
+type GetSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按时间升序，没有互动的桶计数是 0
+	Points        []*SeriesPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesResponse) Reset() {
+	*x = GetSeriesResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesResponse) ProtoMessage() {}
+
+func (x *GetSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSeriesResponse) GetPoints() []*SeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SeriesPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 桶的开始时间
+	Start   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	ReadCnt int64                  `protobuf:"varint,2,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
+	// 净增的点赞数，取消点赞会减掉，可能是负数
+	LikeCnt       int64 `protobuf:"varint,3,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
+	CollectCnt    int64 `protobuf:"varint,4,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesPoint) Reset() {
+	*x = SeriesPoint{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesPoint) ProtoMessage() {}
+
+func (x *SeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesPoint.ProtoReflect.Descriptor instead.
+func (*SeriesPoint) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SeriesPoint) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *SeriesPoint) GetReadCnt() int64 {
+	if x != nil {
+		return x.ReadCnt
+	}
+	return 0
+}
+
+func (x *SeriesPoint) GetLikeCnt() int64 {
+	if x != nil {
+		return x.LikeCnt
+	}
+	return 0
+}
+
+func (x *SeriesPoint) GetCollectCnt() int64 {
+	if x != nil {
+		return x.CollectCnt
+	}
+	return 0
+}
+
+type DeleteByBizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizIds        []int64                `protobuf:"varint,2,rep,packed,name=biz_ids,json=bizIds,proto3" json:"biz_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteByBizRequest) Reset() {
+	*x = DeleteByBizRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteByBizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteByBizRequest) ProtoMessage() {}
+
+func (x *DeleteByBizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteByBizRequest.ProtoReflect.Descriptor instead.
+func (*DeleteByBizRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteByBizRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *DeleteByBizRequest) GetBizIds() []int64 {
+	if x != nil {
+		return x.BizIds
+	}
+	return nil
+}
+
+type DeleteByBizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteByBizResponse) Reset() {
+	*x = DeleteByBizResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteByBizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteByBizResponse) ProtoMessage() {}
+
+func (x *DeleteByBizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteByBizResponse.ProtoReflect.Descriptor instead.
+func (*DeleteByBizResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{11}
+}
+
+type GetByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdsRequest) Reset() {
+	*x = GetByIdsRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsRequest) ProtoMessage() {}
+
+func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetByIdsRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetByIdsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intrs         map[int64]*Interactive `protobuf:"bytes,1,rep,name=intrs,proto3" json:"intrs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdsResponse) Reset() {
+	*x = GetByIdsResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsResponse) ProtoMessage() {}
+
+func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetByIdsResponse) GetIntrs() map[int64]*Interactive {
+	if x != nil {
+		return x.Intrs
+	}
+	return nil
+}
+
+type ListLikedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Biz   string                 `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	// 上一次返回的 next_cursor，第一页不填
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikedRequest) Reset() {
+	*x = ListLikedRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikedRequest) ProtoMessage() {}
+
+func (x *ListLikedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikedRequest.ProtoReflect.Descriptor instead.
+func (*ListLikedRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLikedRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListLikedRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *ListLikedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListLikedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListLikedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*HistoryItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 为空说明没有更多了
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLikedResponse) Reset() {
+	*x = ListLikedResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLikedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLikedResponse) ProtoMessage() {}
+
+func (x *ListLikedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLikedResponse.ProtoReflect.Descriptor instead.
+func (*ListLikedResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListLikedResponse) GetItems() []*HistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListLikedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListCollectedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Biz   string                 `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	// 上一次返回的 next_cursor，第一页不填
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectedRequest) Reset() {
+	*x = ListCollectedRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectedRequest) ProtoMessage() {}
+
+func (x *ListCollectedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectedRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectedRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCollectedRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListCollectedRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *ListCollectedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCollectedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCollectedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*HistoryItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 为空说明没有更多了
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectedResponse) Reset() {
+	*x = ListCollectedResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectedResponse) ProtoMessage() {}
+
+func (x *ListCollectedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectedResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectedResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCollectedResponse) GetItems() []*HistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCollectedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type HistoryItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Biz   string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	// 点赞或者收藏的时间，毫秒数
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// 目前只有 article 有，资源已经下线的时候为空
+	Meta          *ItemMeta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryItem) Reset() {
+	*x = HistoryItem{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryItem) ProtoMessage() {}
+
+func (x *HistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryItem.ProtoReflect.Descriptor instead.
+func (*HistoryItem) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HistoryItem) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *HistoryItem) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *HistoryItem) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *HistoryItem) GetMeta() *ItemMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ItemMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Abstract      string                 `protobuf:"bytes,3,opt,name=abstract,proto3" json:"abstract,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemMeta) Reset() {
+	*x = ItemMeta{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemMeta) ProtoMessage() {}
+
+func (x *ItemMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemMeta.ProtoReflect.Descriptor instead.
+func (*ItemMeta) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ItemMeta) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ItemMeta) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ItemMeta) GetAbstract() string {
+	if x != nil {
+		return x.Abstract
+	}
+	return ""
+}
+
+type GetByIdsWithViewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdsWithViewerRequest) Reset() {
+	*x = GetByIdsWithViewerRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdsWithViewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsWithViewerRequest) ProtoMessage() {}
+
+func (x *GetByIdsWithViewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsWithViewerRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdsWithViewerRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetByIdsWithViewerRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetByIdsWithViewerRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetByIdsWithViewerRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type GetByIdsWithViewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intrs         map[int64]*Interactive `protobuf:"bytes,1,rep,name=intrs,proto3" json:"intrs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdsWithViewerResponse) Reset() {
+	*x = GetByIdsWithViewerResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdsWithViewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsWithViewerResponse) ProtoMessage() {}
+
+func (x *GetByIdsWithViewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsWithViewerResponse.ProtoReflect.Descriptor instead.
+func (*GetByIdsWithViewerResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetByIdsWithViewerResponse) GetIntrs() map[int64]*Interactive {
+	if x != nil {
+		return x.Intrs
+	}
+	return nil
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intr          *Interactive           `protobuf:"bytes,1,opt,name=intr,proto3" json:"intr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetResponse) GetIntr() *Interactive {
+	if x != nil {
+		return x.Intr
+	}
+	return nil
+}
+
+type Interactive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	ReadCnt       int64                  `protobuf:"varint,3,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
+	LikeCnt       int64                  `protobuf:"varint,4,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
+	CollectCnt    int64                  `protobuf:"varint,5,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
+	Liked         bool                   `protobuf:"varint,6,opt,name=liked,proto3" json:"liked,omitempty"`
+	Collected     bool                   `protobuf:"varint,7,opt,name=collected,proto3" json:"collected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interactive) Reset() {
+	*x = Interactive{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interactive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interactive) ProtoMessage() {}
+
+func (x *Interactive) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interactive.ProtoReflect.Descriptor instead.
+func (*Interactive) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Interactive) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *Interactive) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *Interactive) GetReadCnt() int64 {
+	if x != nil {
+		return x.ReadCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetLikeCnt() int64 {
+	if x != nil {
+		return x.LikeCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetCollectCnt() int64 {
+	if x != nil {
+		return x.CollectCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *Interactive) GetCollected() bool {
+	if x != nil {
+		return x.Collected
+	}
+	return false
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GetRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type CollectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectResponse) Reset() {
+	*x = CollectResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectResponse) ProtoMessage() {}
+
+func (x *CollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
+func (*CollectResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{25}
+}
+
+type CollectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Cid           int64                  `protobuf:"varint,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectRequest) Reset() {
+	*x = CollectRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectRequest) ProtoMessage() {}
+
+func (x *CollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
+func (*CollectRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CollectRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CollectRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+type CancelCollectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCollectRequest) Reset() {
+	*x = CancelCollectRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCollectRequest) ProtoMessage() {}
+
+func (x *CancelCollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCollectRequest.ProtoReflect.Descriptor instead.
+func (*CancelCollectRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancelCollectRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CancelCollectRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CancelCollectRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type CancelCollectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCollectResponse) Reset() {
+	*x = CancelCollectResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCollectResponse) ProtoMessage() {}
+
+func (x *CancelCollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCollectResponse.ProtoReflect.Descriptor instead.
+func (*CancelCollectResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{28}
+}
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           int64                  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Private       bool                   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	Ctime         int64                  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         int64                  `protobuf:"varint,6,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Collection) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Collection) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Collection) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+type CollectionItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cid   int64                  `protobuf:"varint,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Biz   string                 `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64                  `protobuf:"varint,3,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid   int64                  `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 放进这个收藏夹的时间
+	Ctime         int64 `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItem) Reset() {
+	*x = CollectionItem{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItem) ProtoMessage() {}
+
+func (x *CollectionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItem.ProtoReflect.Descriptor instead.
+func (*CollectionItem) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CollectionItem) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Private       bool                   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateCollectionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateCollectionResponse) GetId() int64 {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetByIdsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Intrs         map[int64]*Interactive `protobuf:"bytes,1,rep,name=intrs,proto3" json:"intrs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type UpdateCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 只有收藏夹的主人能修改
+	Uid           int64  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Private       bool   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdsResponse) Reset() {
-	*x = GetByIdsResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[1]
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdsResponse) String() string {
+func (x *UpdateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdsResponse) ProtoMessage() {}
+func (*UpdateCollectionRequest) ProtoMessage() {}
 
-func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[1]
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,40 +2081,60 @@ func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdsResponse.ProtoReflect.Descriptor instead.
-func (*GetByIdsResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetByIdsResponse) GetIntrs() map[int64]*Interactive {
+func (x *UpdateCollectionRequest) GetId() int64 {
 	if x != nil {
-		return x.Intrs
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetResponse struct {
+func (x *UpdateCollectionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type UpdateCollectionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Intr          *Interactive           `protobuf:"bytes,1,opt,name=intr,proto3" json:"intr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResponse) Reset() {
-	*x = GetResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[2]
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResponse) String() string {
+func (x *UpdateCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResponse) ProtoMessage() {}
+func (*UpdateCollectionResponse) ProtoMessage() {}
 
-func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[2]
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,46 +2145,34 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
-func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *GetResponse) GetIntr() *Interactive {
-	if x != nil {
-		return x.Intr
-	}
-	return nil
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{34}
 }
 
-type Interactive struct {
+type DeleteCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	ReadCnt       int64                  `protobuf:"varint,3,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
-	LikeCnt       int64                  `protobuf:"varint,4,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
-	CollectCnt    int64                  `protobuf:"varint,5,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
-	Liked         bool                   `protobuf:"varint,6,opt,name=liked,proto3" json:"liked,omitempty"`
-	Collected     bool                   `protobuf:"varint,7,opt,name=collected,proto3" json:"collected,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           int64                  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Interactive) Reset() {
-	*x = Interactive{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[3]
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interactive) String() string {
+func (x *DeleteCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interactive) ProtoMessage() {}
+func (*DeleteCollectionRequest) ProtoMessage() {}
 
-func (x *Interactive) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[3]
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,84 +2183,88 @@ func (x *Interactive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interactive.ProtoReflect.Descriptor instead.
-func (*Interactive) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Interactive) GetBiz() string {
+func (x *DeleteCollectionRequest) GetId() int64 {
 	if x != nil {
-		return x.Biz
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Interactive) GetBizId() int64 {
+func (x *DeleteCollectionRequest) GetUid() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Uid
 	}
 	return 0
 }
 
-func (x *Interactive) GetReadCnt() int64 {
-	if x != nil {
-		return x.ReadCnt
-	}
-	return 0
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Interactive) GetLikeCnt() int64 {
-	if x != nil {
-		return x.LikeCnt
-	}
-	return 0
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Interactive) GetCollectCnt() int64 {
-	if x != nil {
-		return x.CollectCnt
-	}
-	return 0
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Interactive) GetLiked() bool {
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[36]
 	if x != nil {
-		return x.Liked
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Interactive) GetCollected() bool {
-	if x != nil {
-		return x.Collected
-	}
-	return false
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{36}
 }
 
-type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+type ListCollectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 谁在看
+	Viewer int64 `protobuf:"varint,1,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	// 看谁的收藏夹
+	Owner         int64 `protobuf:"varint,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[4]
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRequest) String() string {
+func (x *ListCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequest) ProtoMessage() {}
+func (*ListCollectionsRequest) ProtoMessage() {}
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[4]
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,53 +2275,61 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetRequest) GetBiz() string {
+func (x *ListCollectionsRequest) GetViewer() int64 {
 	if x != nil {
-		return x.Biz
+		return x.Viewer
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRequest) GetBizId() int64 {
+func (x *ListCollectionsRequest) GetOwner() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Owner
 	}
 	return 0
 }
 
-func (x *GetRequest) GetUid() int64 {
+func (x *ListCollectionsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Uid
+		return x.Offset
 	}
 	return 0
 }
 
-type CollectResponse struct {
+func (x *ListCollectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectResponse) Reset() {
-	*x = CollectResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[5]
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectResponse) String() string {
+func (x *ListCollectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectResponse) ProtoMessage() {}
+func (*ListCollectionsResponse) ProtoMessage() {}
 
-func (x *CollectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[5]
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,36 +2340,44 @@ func (x *CollectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
-func (*CollectResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{38}
 }
 
-type CollectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Uid           int64                  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
-	Cid           int64                  `protobuf:"varint,4,opt,name=cid,proto3" json:"cid,omitempty"`
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type ListCollectionItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 谁在看，cid 为 0 的时候是看自己的默认收藏夹
+	Uid           int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Cid           int64 `protobuf:"varint,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectRequest) Reset() {
-	*x = CollectRequest{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[6]
+func (x *ListCollectionItemsRequest) Reset() {
+	*x = ListCollectionItemsRequest{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectRequest) String() string {
+func (x *ListCollectionItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectRequest) ProtoMessage() {}
+func (*ListCollectionItemsRequest) ProtoMessage() {}
 
-func (x *CollectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[6]
+func (x *ListCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,39 +2388,83 @@ func (x *CollectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
-func (*CollectRequest) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CollectRequest) GetBiz() string {
+func (x *ListCollectionItemsRequest) GetUid() int64 {
 	if x != nil {
-		return x.Biz
+		return x.Uid
 	}
-	return ""
+	return 0
 }
 
-func (x *CollectRequest) GetBizId() int64 {
+func (x *ListCollectionItemsRequest) GetCid() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Cid
 	}
 	return 0
 }
 
-func (x *CollectRequest) GetUid() int64 {
+func (x *ListCollectionItemsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Uid
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *CollectRequest) GetCid() int64 {
+func (x *ListCollectionItemsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Cid
+		return x.Limit
 	}
 	return 0
 }
 
+type ListCollectionItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CollectionItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionItemsResponse) Reset() {
+	*x = ListCollectionItemsResponse{}
+	mi := &file_interactive_v1_interactive_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsResponse) ProtoMessage() {}
+
+func (x *ListCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interactive_v1_interactive_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCollectionItemsResponse) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type CancelLikeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Biz           string                 `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
@@ -428,7 +2476,7 @@ type CancelLikeRequest struct {
 
 func (x *CancelLikeRequest) Reset() {
 	*x = CancelLikeRequest{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[7]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +2488,7 @@ func (x *CancelLikeRequest) String() string {
 func (*CancelLikeRequest) ProtoMessage() {}
 
 func (x *CancelLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[7]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +2501,7 @@ func (x *CancelLikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLikeRequest.ProtoReflect.Descriptor instead.
 func (*CancelLikeRequest) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{7}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CancelLikeRequest) GetBiz() string {
@@ -485,7 +2533,7 @@ type CancelLikeResponse struct {
 
 func (x *CancelLikeResponse) Reset() {
 	*x = CancelLikeResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[8]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +2545,7 @@ func (x *CancelLikeResponse) String() string {
 func (*CancelLikeResponse) ProtoMessage() {}
 
 func (x *CancelLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[8]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +2558,7 @@ func (x *CancelLikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLikeResponse.ProtoReflect.Descriptor instead.
 func (*CancelLikeResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{8}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{42}
 }
 
 type LikeRequest struct {
@@ -524,7 +2572,7 @@ type LikeRequest struct {
 
 func (x *LikeRequest) Reset() {
 	*x = LikeRequest{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[9]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +2584,7 @@ func (x *LikeRequest) String() string {
 func (*LikeRequest) ProtoMessage() {}
 
 func (x *LikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[9]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +2597,7 @@ func (x *LikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
 func (*LikeRequest) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{9}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *LikeRequest) GetBiz() string {
@@ -581,7 +2629,7 @@ type LikeResponse struct {
 
 func (x *LikeResponse) Reset() {
 	*x = LikeResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[10]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +2641,7 @@ func (x *LikeResponse) String() string {
 func (*LikeResponse) ProtoMessage() {}
 
 func (x *LikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[10]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +2654,7 @@ func (x *LikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
 func (*LikeResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{10}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{44}
 }
 
 type IncrReadCntRequest struct {
@@ -619,7 +2667,7 @@ type IncrReadCntRequest struct {
 
 func (x *IncrReadCntRequest) Reset() {
 	*x = IncrReadCntRequest{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[11]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +2679,7 @@ func (x *IncrReadCntRequest) String() string {
 func (*IncrReadCntRequest) ProtoMessage() {}
 
 func (x *IncrReadCntRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[11]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,7 +2692,7 @@ func (x *IncrReadCntRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrReadCntRequest.ProtoReflect.Descriptor instead.
 func (*IncrReadCntRequest) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{11}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *IncrReadCntRequest) GetBiz() string {
@@ -669,7 +2717,7 @@ type IncrReadCntResponse struct {
 
 func (x *IncrReadCntResponse) Reset() {
 	*x = IncrReadCntResponse{}
-	mi := &file_interactive_v1_interactive_proto_msgTypes[12]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +2729,7 @@ func (x *IncrReadCntResponse) String() string {
 func (*IncrReadCntResponse) ProtoMessage() {}
 
 func (x *IncrReadCntResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_interactive_v1_interactive_proto_msgTypes[12]
+	mi := &file_interactive_v1_interactive_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,14 +2742,56 @@ func (x *IncrReadCntResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrReadCntResponse.ProtoReflect.Descriptor instead.
 func (*IncrReadCntResponse) Descriptor() ([]byte, []int) {
-	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{12}
+	return file_interactive_v1_interactive_proto_rawDescGZIP(), []int{46}
 }
 
 var File_interactive_v1_interactive_proto protoreflect.FileDescriptor
 
 const file_interactive_v1_interactive_proto_rawDesc = "" +
 	"\n" +
-	" interactive/v1/interactive.proto\x12\x0einteractive.v1\"5\n" +
+	" interactive/v1/interactive.proto\x12\x0einteractive.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"{\n" +
+	"\fReactRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\x03R\x03uid\x120\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1c.interactive.v1.ReactionTypeR\x04type\"\x0f\n" +
+	"\rReactResponse\"K\n" +
+	"\x0eUnreactRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\x03R\x03uid\"\x11\n" +
+	"\x0fUnreactResponse\"P\n" +
+	"\x13GetReactionsRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\x03R\x03uid\"S\n" +
+	"\rReactionCount\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.interactive.v1.ReactionTypeR\x04type\x12\x10\n" +
+	"\x03cnt\x18\x02 \x01(\x03R\x03cnt\"\x8c\x01\n" +
+	"\x14GetReactionsResponse\x125\n" +
+	"\x06counts\x18\x01 \x03(\v2\x1d.interactive.v1.ReactionCountR\x06counts\x12=\n" +
+	"\vmy_reaction\x18\x02 \x01(\x0e2\x1c.interactive.v1.ReactionTypeR\n" +
+	"myReaction\"\x85\x02\n" +
+	"\x10GetSeriesRequest\x12\x17\n" +
+	"\x06biz_id\x18\x01 \x01(\x03H\x00R\x05bizId\x12\x1d\n" +
+	"\tauthor_id\x18\x02 \x01(\x03H\x00R\bauthorId\x12\x10\n" +
+	"\x03biz\x18\x03 \x01(\tR\x03biz\x12=\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1b.interactive.v1.GranularityR\vgranularity\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03endB\b\n" +
+	"\x06target\"H\n" +
+	"\x11GetSeriesResponse\x123\n" +
+	"\x06points\x18\x01 \x03(\v2\x1b.interactive.v1.SeriesPointR\x06points\"\x96\x01\n" +
+	"\vSeriesPoint\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x19\n" +
+	"\bread_cnt\x18\x02 \x01(\x03R\areadCnt\x12\x19\n" +
+	"\blike_cnt\x18\x03 \x01(\x03R\alikeCnt\x12\x1f\n" +
+	"\vcollect_cnt\x18\x04 \x01(\x03R\n" +
+	"collectCnt\"?\n" +
+	"\x12DeleteByBizRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x17\n" +
+	"\abiz_ids\x18\x02 \x03(\x03R\x06bizIds\"\x15\n" +
+	"\x13DeleteByBizResponse\"5\n" +
 	"\x0fGetByIdsRequest\x12\x10\n" +
 	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x10\n" +
 	"\x03ids\x18\x02 \x03(\x03R\x03ids\"\xac\x01\n" +
@@ -710,6 +2800,43 @@ const file_interactive_v1_interactive_proto_rawDesc = "" +
 	"\n" +
 	"IntrsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x03R\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.interactive.v1.InteractiveR\x05value:\x028\x01\"d\n" +
+	"\x10ListLikedRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x10\n" +
+	"\x03biz\x18\x02 \x01(\tR\x03biz\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"g\n" +
+	"\x11ListLikedResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.interactive.v1.HistoryItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"h\n" +
+	"\x14ListCollectedRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x10\n" +
+	"\x03biz\x18\x02 \x01(\tR\x03biz\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"k\n" +
+	"\x15ListCollectedResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.interactive.v1.HistoryItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"x\n" +
+	"\vHistoryItem\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\x03R\x04time\x12,\n" +
+	"\x04meta\x18\x04 \x01(\v2\x18.interactive.v1.ItemMetaR\x04meta\"Y\n" +
+	"\bItemMeta\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x1a\n" +
+	"\babstract\x18\x03 \x01(\tR\babstract\"Q\n" +
+	"\x19GetByIdsWithViewerRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\x03R\x03uid\"\xc0\x01\n" +
+	"\x1aGetByIdsWithViewerResponse\x12K\n" +
+	"\x05intrs\x18\x01 \x03(\v25.interactive.v1.GetByIdsWithViewerResponse.IntrsEntryR\x05intrs\x1aU\n" +
+	"\n" +
+	"IntrsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x121\n" +
 	"\x05value\x18\x02 \x01(\v2\x1b.interactive.v1.InteractiveR\x05value:\x028\x01\">\n" +
 	"\vGetResponse\x12/\n" +
 	"\x04intr\x18\x01 \x01(\v2\x1b.interactive.v1.InteractiveR\x04intr\"\xc1\x01\n" +
@@ -732,7 +2859,56 @@ const file_interactive_v1_interactive_proto_rawDesc = "" +
 	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
 	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
 	"\x03uid\x18\x03 \x01(\x03R\x03uid\x12\x10\n" +
-	"\x03cid\x18\x04 \x01(\x03R\x03cid\"N\n" +
+	"\x03cid\x18\x04 \x01(\x03R\x03cid\"Q\n" +
+	"\x14CancelCollectRequest\x12\x10\n" +
+	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\x03R\x03uid\"\x17\n" +
+	"\x15CancelCollectResponse\"\x88\x01\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\x12\x14\n" +
+	"\x05ctime\x18\x05 \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\x06 \x01(\x03R\x05utime\"s\n" +
+	"\x0eCollectionItem\x12\x10\n" +
+	"\x03cid\x18\x01 \x01(\x03R\x03cid\x12\x10\n" +
+	"\x03biz\x18\x02 \x01(\tR\x03biz\x12\x15\n" +
+	"\x06biz_id\x18\x03 \x01(\x03R\x05bizId\x12\x10\n" +
+	"\x03uid\x18\x04 \x01(\x03R\x03uid\x12\x14\n" +
+	"\x05ctime\x18\x05 \x01(\x03R\x05ctime\"Y\n" +
+	"\x17CreateCollectionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aprivate\x18\x03 \x01(\bR\aprivate\"*\n" +
+	"\x18CreateCollectionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"i\n" +
+	"\x17UpdateCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\"\x1a\n" +
+	"\x18UpdateCollectionResponse\";\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\x03R\x03uid\"\x1a\n" +
+	"\x18DeleteCollectionResponse\"t\n" +
+	"\x16ListCollectionsRequest\x12\x16\n" +
+	"\x06viewer\x18\x01 \x01(\x03R\x06viewer\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\x03R\x05owner\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"W\n" +
+	"\x17ListCollectionsResponse\x12<\n" +
+	"\vcollections\x18\x01 \x03(\v2\x1a.interactive.v1.CollectionR\vcollections\"n\n" +
+	"\x1aListCollectionItemsRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12\x10\n" +
+	"\x03cid\x18\x02 \x01(\x03R\x03cid\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"S\n" +
+	"\x1bListCollectionItemsResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.interactive.v1.CollectionItemR\x05items\"N\n" +
 	"\x11CancelLikeRequest\x12\x10\n" +
 	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
 	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x10\n" +
@@ -746,15 +2922,38 @@ const file_interactive_v1_interactive_proto_rawDesc = "" +
 	"\x12IncrReadCntRequest\x12\x10\n" +
 	"\x03biz\x18\x01 \x01(\tR\x03biz\x12\x15\n" +
 	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\"\x15\n" +
-	"\x13IncrReadCntResponse2\xdf\x03\n" +
+	"\x13IncrReadCntResponse*Q\n" +
+	"\vGranularity\x12\x17\n" +
+	"\x13GRANULARITY_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10GRANULARITY_HOUR\x10\x01\x12\x13\n" +
+	"\x0fGRANULARITY_DAY\x10\x02*}\n" +
+	"\fReactionType\x12\x19\n" +
+	"\x15REACTION_TYPE_UNKNOWN\x10\x00\x12\x1c\n" +
+	"\x18REACTION_TYPE_INSIGHTFUL\x10\x01\x12\x17\n" +
+	"\x13REACTION_TYPE_FUNNY\x10\x02\x12\x1b\n" +
+	"\x17REACTION_TYPE_CELEBRATE\x10\x032\xfa\r\n" +
 	"\x12InteractiveService\x12V\n" +
 	"\vIncrReadCnt\x12\".interactive.v1.IncrReadCntRequest\x1a#.interactive.v1.IncrReadCntResponse\x12A\n" +
 	"\x04Like\x12\x1b.interactive.v1.LikeRequest\x1a\x1c.interactive.v1.LikeResponse\x12S\n" +
 	"\n" +
 	"CancelLike\x12!.interactive.v1.CancelLikeRequest\x1a\".interactive.v1.CancelLikeResponse\x12J\n" +
-	"\aCollect\x12\x1e.interactive.v1.CollectRequest\x1a\x1f.interactive.v1.CollectResponse\x12>\n" +
+	"\aCollect\x12\x1e.interactive.v1.CollectRequest\x1a\x1f.interactive.v1.CollectResponse\x12\\\n" +
+	"\rCancelCollect\x12$.interactive.v1.CancelCollectRequest\x1a%.interactive.v1.CancelCollectResponse\x12e\n" +
+	"\x10CreateCollection\x12'.interactive.v1.CreateCollectionRequest\x1a(.interactive.v1.CreateCollectionResponse\x12e\n" +
+	"\x10UpdateCollection\x12'.interactive.v1.UpdateCollectionRequest\x1a(.interactive.v1.UpdateCollectionResponse\x12e\n" +
+	"\x10DeleteCollection\x12'.interactive.v1.DeleteCollectionRequest\x1a(.interactive.v1.DeleteCollectionResponse\x12b\n" +
+	"\x0fListCollections\x12&.interactive.v1.ListCollectionsRequest\x1a'.interactive.v1.ListCollectionsResponse\x12n\n" +
+	"\x13ListCollectionItems\x12*.interactive.v1.ListCollectionItemsRequest\x1a+.interactive.v1.ListCollectionItemsResponse\x12D\n" +
+	"\x05React\x12\x1c.interactive.v1.ReactRequest\x1a\x1d.interactive.v1.ReactResponse\x12J\n" +
+	"\aUnreact\x12\x1e.interactive.v1.UnreactRequest\x1a\x1f.interactive.v1.UnreactResponse\x12Y\n" +
+	"\fGetReactions\x12#.interactive.v1.GetReactionsRequest\x1a$.interactive.v1.GetReactionsResponse\x12P\n" +
+	"\tListLiked\x12 .interactive.v1.ListLikedRequest\x1a!.interactive.v1.ListLikedResponse\x12\\\n" +
+	"\rListCollected\x12$.interactive.v1.ListCollectedRequest\x1a%.interactive.v1.ListCollectedResponse\x12>\n" +
 	"\x03Get\x12\x1a.interactive.v1.GetRequest\x1a\x1b.interactive.v1.GetResponse\x12M\n" +
-	"\bGetByIds\x12\x1f.interactive.v1.GetByIdsRequest\x1a .interactive.v1.GetByIdsResponseB\xcc\x01\n" +
+	"\bGetByIds\x12\x1f.interactive.v1.GetByIdsRequest\x1a .interactive.v1.GetByIdsResponse\x12k\n" +
+	"\x12GetByIdsWithViewer\x12).interactive.v1.GetByIdsWithViewerRequest\x1a*.interactive.v1.GetByIdsWithViewerResponse\x12V\n" +
+	"\vDeleteByBiz\x12\".interactive.v1.DeleteByBizRequest\x1a#.interactive.v1.DeleteByBizResponse\x12P\n" +
+	"\tGetSeries\x12 .interactive.v1.GetSeriesRequest\x1a!.interactive.v1.GetSeriesResponseB\xcc\x01\n" +
 	"\x12com.interactive.v1B\x10InteractiveProtoP\x01ZKgithub.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1;interactivev1\xa2\x02\x03IXX\xaa\x02\x0eInteractive.V1\xca\x02\x0eInteractive\\V1\xe2\x02\x1aInteractive\\V1\\GPBMetadata\xea\x02\x0fInteractive::V1b\x06proto3"
 
 var (
@@ -769,44 +2968,127 @@ func file_interactive_v1_interactive_proto_rawDescGZIP() []byte {
 	return file_interactive_v1_interactive_proto_rawDescData
 }
 
-var file_interactive_v1_interactive_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_interactive_v1_interactive_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_interactive_v1_interactive_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_interactive_v1_interactive_proto_goTypes = []any{
-	(*GetByIdsRequest)(nil),     // 0: interactive.v1.GetByIdsRequest
-	(*GetByIdsResponse)(nil),    // 1: interactive.v1.GetByIdsResponse
-	(*GetResponse)(nil),         // 2: interactive.v1.GetResponse
-	(*Interactive)(nil),         // 3: interactive.v1.Interactive
-	(*GetRequest)(nil),          // 4: interactive.v1.GetRequest
-	(*CollectResponse)(nil),     // 5: interactive.v1.CollectResponse
-	(*CollectRequest)(nil),      // 6: interactive.v1.CollectRequest
-	(*CancelLikeRequest)(nil),   // 7: interactive.v1.CancelLikeRequest
-	(*CancelLikeResponse)(nil),  // 8: interactive.v1.CancelLikeResponse
-	(*LikeRequest)(nil),         // 9: interactive.v1.LikeRequest
-	(*LikeResponse)(nil),        // 10: interactive.v1.LikeResponse
-	(*IncrReadCntRequest)(nil),  // 11: interactive.v1.IncrReadCntRequest
-	(*IncrReadCntResponse)(nil), // 12: interactive.v1.IncrReadCntResponse
-	nil,                         // 13: interactive.v1.GetByIdsResponse.IntrsEntry
+	(Granularity)(0),                    // 0: interactive.v1.Granularity
+	(ReactionType)(0),                   // 1: interactive.v1.ReactionType
+	(*ReactRequest)(nil),                // 2: interactive.v1.ReactRequest
+	(*ReactResponse)(nil),               // 3: interactive.v1.ReactResponse
+	(*UnreactRequest)(nil),              // 4: interactive.v1.UnreactRequest
+	(*UnreactResponse)(nil),             // 5: interactive.v1.UnreactResponse
+	(*GetReactionsRequest)(nil),         // 6: interactive.v1.GetReactionsRequest
+	(*ReactionCount)(nil),               // 7: interactive.v1.ReactionCount
+	(*GetReactionsResponse)(nil),        // 8: interactive.v1.GetReactionsResponse
+	(*GetSeriesRequest)(nil),            // 9: interactive.v1.GetSeriesRequest
+	(*GetSeriesResponse)(nil),           // 10: interactive.v1.GetSeriesResponse
+	(*SeriesPoint)(nil),                 // 11: interactive.v1.SeriesPoint
+	(*DeleteByBizRequest)(nil),          // 12: interactive.v1.DeleteByBizRequest
+	(*DeleteByBizResponse)(nil),         // 13: interactive.v1.DeleteByBizResponse
+	(*GetByIdsRequest)(nil),             // 14: interactive.v1.GetByIdsRequest
+	(*GetByIdsResponse)(nil),            // 15: interactive.v1.GetByIdsResponse
+	(*ListLikedRequest)(nil),            // 16: interactive.v1.ListLikedRequest
+	(*ListLikedResponse)(nil),           // 17: interactive.v1.ListLikedResponse
+	(*ListCollectedRequest)(nil),        // 18: interactive.v1.ListCollectedRequest
+	(*ListCollectedResponse)(nil),       // 19: interactive.v1.ListCollectedResponse
+	(*HistoryItem)(nil),                 // 20: interactive.v1.HistoryItem
+	(*ItemMeta)(nil),                    // 21: interactive.v1.ItemMeta
+	(*GetByIdsWithViewerRequest)(nil),   // 22: interactive.v1.GetByIdsWithViewerRequest
+	(*GetByIdsWithViewerResponse)(nil),  // 23: interactive.v1.GetByIdsWithViewerResponse
+	(*GetResponse)(nil),                 // 24: interactive.v1.GetResponse
+	(*Interactive)(nil),                 // 25: interactive.v1.Interactive
+	(*GetRequest)(nil),                  // 26: interactive.v1.GetRequest
+	(*CollectResponse)(nil),             // 27: interactive.v1.CollectResponse
+	(*CollectRequest)(nil),              // 28: interactive.v1.CollectRequest
+	(*CancelCollectRequest)(nil),        // 29: interactive.v1.CancelCollectRequest
+	(*CancelCollectResponse)(nil),       // 30: interactive.v1.CancelCollectResponse
+	(*Collection)(nil),                  // 31: interactive.v1.Collection
+	(*CollectionItem)(nil),              // 32: interactive.v1.CollectionItem
+	(*CreateCollectionRequest)(nil),     // 33: interactive.v1.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),    // 34: interactive.v1.CreateCollectionResponse
+	(*UpdateCollectionRequest)(nil),     // 35: interactive.v1.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),    // 36: interactive.v1.UpdateCollectionResponse
+	(*DeleteCollectionRequest)(nil),     // 37: interactive.v1.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),    // 38: interactive.v1.DeleteCollectionResponse
+	(*ListCollectionsRequest)(nil),      // 39: interactive.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),     // 40: interactive.v1.ListCollectionsResponse
+	(*ListCollectionItemsRequest)(nil),  // 41: interactive.v1.ListCollectionItemsRequest
+	(*ListCollectionItemsResponse)(nil), // 42: interactive.v1.ListCollectionItemsResponse
+	(*CancelLikeRequest)(nil),           // 43: interactive.v1.CancelLikeRequest
+	(*CancelLikeResponse)(nil),          // 44: interactive.v1.CancelLikeResponse
+	(*LikeRequest)(nil),                 // 45: interactive.v1.LikeRequest
+	(*LikeResponse)(nil),                // 46: interactive.v1.LikeResponse
+	(*IncrReadCntRequest)(nil),          // 47: interactive.v1.IncrReadCntRequest
+	(*IncrReadCntResponse)(nil),         // 48: interactive.v1.IncrReadCntResponse
+	nil,                                 // 49: interactive.v1.GetByIdsResponse.IntrsEntry
+	nil,                                 // 50: interactive.v1.GetByIdsWithViewerResponse.IntrsEntry
+	(*timestamppb.Timestamp)(nil),       // 51: google.protobuf.Timestamp
 }
 var file_interactive_v1_interactive_proto_depIdxs = []int32{
-	13, // 0: interactive.v1.GetByIdsResponse.intrs:type_name -> interactive.v1.GetByIdsResponse.IntrsEntry
-	3,  // 1: interactive.v1.GetResponse.intr:type_name -> interactive.v1.Interactive
-	3,  // 2: interactive.v1.GetByIdsResponse.IntrsEntry.value:type_name -> interactive.v1.Interactive
-	11, // 3: interactive.v1.InteractiveService.IncrReadCnt:input_type -> interactive.v1.IncrReadCntRequest
-	9,  // 4: interactive.v1.InteractiveService.Like:input_type -> interactive.v1.LikeRequest
-	7,  // 5: interactive.v1.InteractiveService.CancelLike:input_type -> interactive.v1.CancelLikeRequest
-	6,  // 6: interactive.v1.InteractiveService.Collect:input_type -> interactive.v1.CollectRequest
-	4,  // 7: interactive.v1.InteractiveService.Get:input_type -> interactive.v1.GetRequest
-	0,  // 8: interactive.v1.InteractiveService.GetByIds:input_type -> interactive.v1.GetByIdsRequest
-	12, // 9: interactive.v1.InteractiveService.IncrReadCnt:output_type -> interactive.v1.IncrReadCntResponse
-	10, // 10: interactive.v1.InteractiveService.Like:output_type -> interactive.v1.LikeResponse
-	8,  // 11: interactive.v1.InteractiveService.CancelLike:output_type -> interactive.v1.CancelLikeResponse
-	5,  // 12: interactive.v1.InteractiveService.Collect:output_type -> interactive.v1.CollectResponse
-	2,  // 13: interactive.v1.InteractiveService.Get:output_type -> interactive.v1.GetResponse
-	1,  // 14: interactive.v1.InteractiveService.GetByIds:output_type -> interactive.v1.GetByIdsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	1,  // 0: interactive.v1.ReactRequest.type:type_name -> interactive.v1.ReactionType
+	1,  // 1: interactive.v1.ReactionCount.type:type_name -> interactive.v1.ReactionType
+	7,  // 2: interactive.v1.GetReactionsResponse.counts:type_name -> interactive.v1.ReactionCount
+	1,  // 3: interactive.v1.GetReactionsResponse.my_reaction:type_name -> interactive.v1.ReactionType
+	0,  // 4: interactive.v1.GetSeriesRequest.granularity:type_name -> interactive.v1.Granularity
+	51, // 5: interactive.v1.GetSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	51, // 6: interactive.v1.GetSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	11, // 7: interactive.v1.GetSeriesResponse.points:type_name -> interactive.v1.SeriesPoint
+	51, // 8: interactive.v1.SeriesPoint.start:type_name -> google.protobuf.Timestamp
+	49, // 9: interactive.v1.GetByIdsResponse.intrs:type_name -> interactive.v1.GetByIdsResponse.IntrsEntry
+	20, // 10: interactive.v1.ListLikedResponse.items:type_name -> interactive.v1.HistoryItem
+	20, // 11: interactive.v1.ListCollectedResponse.items:type_name -> interactive.v1.HistoryItem
+	21, // 12: interactive.v1.HistoryItem.meta:type_name -> interactive.v1.ItemMeta
+	50, // 13: interactive.v1.GetByIdsWithViewerResponse.intrs:type_name -> interactive.v1.GetByIdsWithViewerResponse.IntrsEntry
+	25, // 14: interactive.v1.GetResponse.intr:type_name -> interactive.v1.Interactive
+	31, // 15: interactive.v1.ListCollectionsResponse.collections:type_name -> interactive.v1.Collection
+	32, // 16: interactive.v1.ListCollectionItemsResponse.items:type_name -> interactive.v1.CollectionItem
+	25, // 17: interactive.v1.GetByIdsResponse.IntrsEntry.value:type_name -> interactive.v1.Interactive
+	25, // 18: interactive.v1.GetByIdsWithViewerResponse.IntrsEntry.value:type_name -> interactive.v1.Interactive
+	47, // 19: interactive.v1.InteractiveService.IncrReadCnt:input_type -> interactive.v1.IncrReadCntRequest
+	45, // 20: interactive.v1.InteractiveService.Like:input_type -> interactive.v1.LikeRequest
+	43, // 21: interactive.v1.InteractiveService.CancelLike:input_type -> interactive.v1.CancelLikeRequest
+	28, // 22: interactive.v1.InteractiveService.Collect:input_type -> interactive.v1.CollectRequest
+	29, // 23: interactive.v1.InteractiveService.CancelCollect:input_type -> interactive.v1.CancelCollectRequest
+	33, // 24: interactive.v1.InteractiveService.CreateCollection:input_type -> interactive.v1.CreateCollectionRequest
+	35, // 25: interactive.v1.InteractiveService.UpdateCollection:input_type -> interactive.v1.UpdateCollectionRequest
+	37, // 26: interactive.v1.InteractiveService.DeleteCollection:input_type -> interactive.v1.DeleteCollectionRequest
+	39, // 27: interactive.v1.InteractiveService.ListCollections:input_type -> interactive.v1.ListCollectionsRequest
+	41, // 28: interactive.v1.InteractiveService.ListCollectionItems:input_type -> interactive.v1.ListCollectionItemsRequest
+	2,  // 29: interactive.v1.InteractiveService.React:input_type -> interactive.v1.ReactRequest
+	4,  // 30: interactive.v1.InteractiveService.Unreact:input_type -> interactive.v1.UnreactRequest
+	6,  // 31: interactive.v1.InteractiveService.GetReactions:input_type -> interactive.v1.GetReactionsRequest
+	16, // 32: interactive.v1.InteractiveService.ListLiked:input_type -> interactive.v1.ListLikedRequest
+	18, // 33: interactive.v1.InteractiveService.ListCollected:input_type -> interactive.v1.ListCollectedRequest
+	26, // 34: interactive.v1.InteractiveService.Get:input_type -> interactive.v1.GetRequest
+	14, // 35: interactive.v1.InteractiveService.GetByIds:input_type -> interactive.v1.GetByIdsRequest
+	22, // 36: interactive.v1.InteractiveService.GetByIdsWithViewer:input_type -> interactive.v1.GetByIdsWithViewerRequest
+	12, // 37: interactive.v1.InteractiveService.DeleteByBiz:input_type -> interactive.v1.DeleteByBizRequest
+	9,  // 38: interactive.v1.InteractiveService.GetSeries:input_type -> interactive.v1.GetSeriesRequest
+	48, // 39: interactive.v1.InteractiveService.IncrReadCnt:output_type -> interactive.v1.IncrReadCntResponse
+	46, // 40: interactive.v1.InteractiveService.Like:output_type -> interactive.v1.LikeResponse
+	44, // 41: interactive.v1.InteractiveService.CancelLike:output_type -> interactive.v1.CancelLikeResponse
+	27, // 42: interactive.v1.InteractiveService.Collect:output_type -> interactive.v1.CollectResponse
+	30, // 43: interactive.v1.InteractiveService.CancelCollect:output_type -> interactive.v1.CancelCollectResponse
+	34, // 44: interactive.v1.InteractiveService.CreateCollection:output_type -> interactive.v1.CreateCollectionResponse
+	36, // 45: interactive.v1.InteractiveService.UpdateCollection:output_type -> interactive.v1.UpdateCollectionResponse
+	38, // 46: interactive.v1.InteractiveService.DeleteCollection:output_type -> interactive.v1.DeleteCollectionResponse
+	40, // 47: interactive.v1.InteractiveService.ListCollections:output_type -> interactive.v1.ListCollectionsResponse
+	42, // 48: interactive.v1.InteractiveService.ListCollectionItems:output_type -> interactive.v1.ListCollectionItemsResponse
+	3,  // 49: interactive.v1.InteractiveService.React:output_type -> interactive.v1.ReactResponse
+	5,  // 50: interactive.v1.InteractiveService.Unreact:output_type -> interactive.v1.UnreactResponse
+	8,  // 51: interactive.v1.InteractiveService.GetReactions:output_type -> interactive.v1.GetReactionsResponse
+	17, // 52: interactive.v1.InteractiveService.ListLiked:output_type -> interactive.v1.ListLikedResponse
+	19, // 53: interactive.v1.InteractiveService.ListCollected:output_type -> interactive.v1.ListCollectedResponse
+	24, // 54: interactive.v1.InteractiveService.Get:output_type -> interactive.v1.GetResponse
+	15, // 55: interactive.v1.InteractiveService.GetByIds:output_type -> interactive.v1.GetByIdsResponse
+	23, // 56: interactive.v1.InteractiveService.GetByIdsWithViewer:output_type -> interactive.v1.GetByIdsWithViewerResponse
+	13, // 57: interactive.v1.InteractiveService.DeleteByBiz:output_type -> interactive.v1.DeleteByBizResponse
+	10, // 58: interactive.v1.InteractiveService.GetSeries:output_type -> interactive.v1.GetSeriesResponse
+	39, // [39:59] is the sub-list for method output_type
+	19, // [19:39] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_interactive_v1_interactive_proto_init() }
@@ -814,18 +3096,23 @@ func file_interactive_v1_interactive_proto_init() {
 	if File_interactive_v1_interactive_proto != nil {
 		return
 	}
+	file_interactive_v1_interactive_proto_msgTypes[7].OneofWrappers = []any{
+		(*GetSeriesRequest_BizId)(nil),
+		(*GetSeriesRequest_AuthorId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_interactive_v1_interactive_proto_rawDesc), len(file_interactive_v1_interactive_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_interactive_v1_interactive_proto_goTypes,
 		DependencyIndexes: file_interactive_v1_interactive_proto_depIdxs,
+		EnumInfos:         file_interactive_v1_interactive_proto_enumTypes,
 		MessageInfos:      file_interactive_v1_interactive_proto_msgTypes,
 	}.Build()
 	File_interactive_v1_interactive_proto = out.File
@@ -21,6 +21,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RelatedRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// 最多返回几篇，不填用默认值，超过上限按上限算
+	N int32 `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	// 为 true 的时候结合阅读、点赞、收藏数排序
+	Blend         bool `protobuf:"varint,3,opt,name=blend,proto3" json:"blend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedRequest) Reset() {
+	*x = RelatedRequest{}
+	mi := &file_search_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedRequest) ProtoMessage() {}
+
+func (x *RelatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedRequest.ProtoReflect.Descriptor instead.
+func (*RelatedRequest) Descriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RelatedRequest) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *RelatedRequest) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *RelatedRequest) GetBlend() bool {
+	if x != nil {
+		return x.Blend
+	}
+	return false
+}
+
+type RelatedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按推荐程度降序，不带 content
+	Articles      []*Article `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedResponse) Reset() {
+	*x = RelatedResponse{}
+	mi := &file_search_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedResponse) ProtoMessage() {}
+
+func (x *RelatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedResponse.ProtoReflect.Descriptor instead.
+func (*RelatedResponse) Descriptor() ([]byte, []int) {
+	return file_search_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RelatedResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
@@ -31,7 +138,7 @@ type SearchRequest struct {
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_search_v1_search_proto_msgTypes[0]
+	mi := &file_search_v1_search_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +150,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_search_proto_msgTypes[0]
+	mi := &file_search_v1_search_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +163,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_search_v1_search_proto_rawDescGZIP(), []int{0}
+	return file_search_v1_search_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SearchRequest) GetExpression() string {
@@ -82,7 +189,7 @@ type SearchResponse struct {
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
-	mi := &file_search_v1_search_proto_msgTypes[1]
+	mi := &file_search_v1_search_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +201,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_search_proto_msgTypes[1]
+	mi := &file_search_v1_search_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +214,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_search_v1_search_proto_rawDescGZIP(), []int{1}
+	return file_search_v1_search_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SearchResponse) GetArticle() *ArticleResult {
@@ -126,7 +233,7 @@ type ArticleResult struct {
 
 func (x *ArticleResult) Reset() {
 	*x = ArticleResult{}
-	mi := &file_search_v1_search_proto_msgTypes[2]
+	mi := &file_search_v1_search_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +245,7 @@ func (x *ArticleResult) String() string {
 func (*ArticleResult) ProtoMessage() {}
 
 func (x *ArticleResult) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_search_proto_msgTypes[2]
+	mi := &file_search_v1_search_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +258,7 @@ func (x *ArticleResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleResult.ProtoReflect.Descriptor instead.
 func (*ArticleResult) Descriptor() ([]byte, []int) {
-	return file_search_v1_search_proto_rawDescGZIP(), []int{2}
+	return file_search_v1_search_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ArticleResult) GetArticles() []*Article {
@@ -165,7 +272,14 @@ var File_search_v1_search_proto protoreflect.FileDescriptor
 
 const file_search_v1_search_proto_rawDesc = "" +
 	"\n" +
-	"\x16search/v1/search.proto\x12\tsearch.v1\x1a\x14search/v1/sync.proto\"A\n" +
+	"\x16search/v1/search.proto\x12\tsearch.v1\x1a\x14search/v1/sync.proto\"S\n" +
+	"\x0eRelatedRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03R\tarticleId\x12\f\n" +
+	"\x01n\x18\x02 \x01(\x05R\x01n\x12\x14\n" +
+	"\x05blend\x18\x03 \x01(\bR\x05blend\"A\n" +
+	"\x0fRelatedResponse\x12.\n" +
+	"\barticles\x18\x01 \x03(\v2\x12.search.v1.ArticleR\barticles\"A\n" +
 	"\rSearchRequest\x12\x1e\n" +
 	"\n" +
 	"expression\x18\x01 \x01(\tR\n" +
@@ -174,9 +288,10 @@ const file_search_v1_search_proto_rawDesc = "" +
 	"\x0eSearchResponse\x122\n" +
 	"\aarticle\x18\x02 \x01(\v2\x18.search.v1.ArticleResultR\aarticle\"?\n" +
 	"\rArticleResult\x12.\n" +
-	"\barticles\x18\x01 \x03(\v2\x12.search.v1.ArticleR\barticles2N\n" +
+	"\barticles\x18\x01 \x03(\v2\x12.search.v1.ArticleR\barticles2\x90\x01\n" +
 	"\rSearchService\x12=\n" +
-	"\x06Search\x12\x18.search.v1.SearchRequest\x1a\x19.search.v1.SearchResponseB\xa4\x01\n" +
+	"\x06Search\x12\x18.search.v1.SearchRequest\x1a\x19.search.v1.SearchResponse\x12@\n" +
+	"\aRelated\x12\x19.search.v1.RelatedRequest\x1a\x1a.search.v1.RelatedResponseB\xa4\x01\n" +
 	"\rcom.search.v1B\vSearchProtoP\x01ZAgithub.com/pluckhuang/goweb/aweb/api/proto/gen/search/v1;searchv1\xa2\x02\x03SXX\xaa\x02\tSearch.V1\xca\x02\tSearch\\V1\xe2\x02\x15Search\\V1\\GPBMetadata\xea\x02\n" +
 	"Search::V1b\x06proto3"
 
@@ -192,23 +307,28 @@ func file_search_v1_search_proto_rawDescGZIP() []byte {
 	return file_search_v1_search_proto_rawDescData
 }
 
-var file_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_search_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_search_v1_search_proto_goTypes = []any{
-	(*SearchRequest)(nil),  // 0: search.v1.SearchRequest
-	(*SearchResponse)(nil), // 1: search.v1.SearchResponse
-	(*ArticleResult)(nil),  // 2: search.v1.ArticleResult
-	(*Article)(nil),        // 3: search.v1.Article
+	(*RelatedRequest)(nil),  // 0: search.v1.RelatedRequest
+	(*RelatedResponse)(nil), // 1: search.v1.RelatedResponse
+	(*SearchRequest)(nil),   // 2: search.v1.SearchRequest
+	(*SearchResponse)(nil),  // 3: search.v1.SearchResponse
+	(*ArticleResult)(nil),   // 4: search.v1.ArticleResult
+	(*Article)(nil),         // 5: search.v1.Article
 }
 var file_search_v1_search_proto_depIdxs = []int32{
-	2, // 0: search.v1.SearchResponse.article:type_name -> search.v1.ArticleResult
-	3, // 1: search.v1.ArticleResult.articles:type_name -> search.v1.Article
-	0, // 2: search.v1.SearchService.Search:input_type -> search.v1.SearchRequest
-	1, // 3: search.v1.SearchService.Search:output_type -> search.v1.SearchResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: search.v1.RelatedResponse.articles:type_name -> search.v1.Article
+	4, // 1: search.v1.SearchResponse.article:type_name -> search.v1.ArticleResult
+	5, // 2: search.v1.ArticleResult.articles:type_name -> search.v1.Article
+	2, // 3: search.v1.SearchService.Search:input_type -> search.v1.SearchRequest
+	0, // 4: search.v1.SearchService.Related:input_type -> search.v1.RelatedRequest
+	3, // 5: search.v1.SearchService.Search:output_type -> search.v1.SearchResponse
+	1, // 6: search.v1.SearchService.Related:output_type -> search.v1.RelatedResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_search_v1_search_proto_init() }
@@ -223,7 +343,7 @@ func file_search_v1_search_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_v1_search_proto_rawDesc), len(file_search_v1_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -203,6 +203,8 @@ type Article struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -265,6 +267,20 @@ func (x *Article) GetStatus() int32 {
 	return 0
 }
 
+func (x *Article) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Article) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 var File_search_v1_sync_proto protoreflect.FileDescriptor
 
 const file_search_v1_sync_proto_rawDesc = "" +
@@ -278,12 +294,14 @@ const file_search_v1_sync_proto_rawDesc = "" +
 	"\x10InputAnyResponse\"C\n" +
 	"\x13InputArticleRequest\x12,\n" +
 	"\aarticle\x18\x01 \x01(\v2\x12.search.v1.ArticleR\aarticle\"\x16\n" +
-	"\x14InputArticleResponse\"a\n" +
+	"\x14InputArticleResponse\"\x91\x01\n" +
 	"\aArticle\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status2\xa3\x01\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory2\xa3\x01\n" +
 	"\vSyncService\x12O\n" +
 	"\fInputArticle\x12\x1e.search.v1.InputArticleRequest\x1a\x1f.search.v1.InputArticleResponse\x12C\n" +
 	"\bInputAny\x12\x1a.search.v1.InputAnyRequest\x1a\x1b.search.v1.InputAnyResponseB\xa2\x01\n" +
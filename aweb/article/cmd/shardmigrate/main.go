@@ -0,0 +1,43 @@
+// shardmigrate 把单库里面的文章按照作者搬到 db.shards 配置的分库
+// 搬迁期间要停止写入，并且等本地消息表发完。中断之后用 --from 接着搬
+package main
+
+import (
+	"context"
+
+	"github.com/pluckhuang/goweb/aweb/article/ioc"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	cfile := pflag.String("config",
+		"config/config.yaml", "配置文件路径")
+	from := pflag.Int64("from", 0, "从这个 id 之后开始搬")
+	batch := pflag.Int("batch", 100, "每批搬多少篇文章")
+	pflag.Parse()
+	viper.SetConfigFile(*cfile)
+	err := viper.ReadInConfig()
+	if err != nil {
+		panic(err)
+	}
+
+	l := ioc.InitLogger()
+	src := ioc.InitDB(l)
+	shards := ioc.InitArticleShards()
+	if len(shards) == 0 {
+		panic("没有配置 db.shards")
+	}
+	// 从库可能有延迟，搬迁要读主库
+	ctx := gormx.ForcePrimary(context.Background())
+	err = dao.MigrateToShards(ctx, src, shards, *batch, *from, func(lastId int64) {
+		l.Info("搬迁进度", logger.Int64("lastId", lastId))
+	})
+	if err != nil {
+		panic(err)
+	}
+	l.Info("搬迁完成")
+}
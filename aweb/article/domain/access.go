@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidAccess = errors.New("文章的访问级别不对")
+
+// ArticleAccess 文章的访问级别，作者本人总是可以看全文
+type ArticleAccess uint8
+
+func (a ArticleAccess) ToUint8() uint8 {
+	return uint8(a)
+}
+
+func (a ArticleAccess) Valid() bool {
+	return a <= ArticleAccessSubscribers
+}
+
+const (
+	// ArticleAccessPublic 所有人都可以看
+	ArticleAccessPublic ArticleAccess = iota
+	// ArticleAccessFollowers 关注了作者的人才能看
+	ArticleAccessFollowers
+	// ArticleAccessSubscribers 订阅了作者的人才能看
+	ArticleAccessSubscribers
+)
+
+// Preview 没有权限的读者看到的文章，只保留摘要
+func (a Article) Preview() Article {
+	a.Rendered = RenderedContent{Abstract: a.Abstract()}
+	a.Content = ""
+	a.Locked = true
+	return a
+}
+
+// Subscription 读者对作者的订阅，可以看作者只给订阅者看的文章
+type Subscription struct {
+	Uid      int64
+	AuthorId int64
+	// ExpireAt 零值表示永远不过期
+	ExpireAt time.Time
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+func (s Subscription) Active(now time.Time) bool {
+	return s.ExpireAt.IsZero() || s.ExpireAt.After(now)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticle_Preview(t *testing.T) {
+	art := Article{
+		Id:      1,
+		Title:   "标题",
+		Content: "# 标题\n正文",
+		Access:  ArticleAccessSubscribers,
+		Rendered: RenderedContent{
+			HTML:     "<h1>标题</h1><p>正文</p>",
+			TOC:      []Heading{{Level: 1, ID: "标题", Text: "标题"}},
+			Abstract: "正文",
+		},
+	}
+	res := art.Preview()
+	assert.Equal(t, Article{
+		Id:       1,
+		Title:    "标题",
+		Access:   ArticleAccessSubscribers,
+		Locked:   true,
+		Rendered: RenderedContent{Abstract: "正文"},
+	}, res)
+	// 原来的文章不受影响
+	assert.Equal(t, "<h1>标题</h1><p>正文</p>", art.Rendered.HTML)
+}
+
+func TestSubscription_Active(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		expireAt time.Time
+		want     bool
+	}{
+		{
+			name: "永远不过期",
+			want: true,
+		},
+		{
+			name:     "还没过期",
+			expireAt: now.Add(time.Hour),
+			want:     true,
+		},
+		{
+			name:     "已经过期",
+			expireAt: now.Add(-time.Hour),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Subscription{ExpireAt: tc.expireAt}
+			assert.Equal(t, tc.want, s.Active(now))
+		})
+	}
+}
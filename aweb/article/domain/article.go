@@ -1,6 +1,14 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// MaxContentLength 文章内容最多多少字节，发表的时候要在请求里面同步渲染
+const MaxContentLength = 1 << 20
+
+var ErrContentTooLong = errors.New("文章内容太长")
 
 type Article struct {
 	Id      int64
@@ -8,8 +16,43 @@ type Article struct {
 	Content string
 	Author  Author
 	Status  ArticleStatus
-	Ctime   time.Time
-	Utime   time.Time
+	// Access 谁可以看全文，别的读者只能看到摘要
+	Access ArticleAccess
+	// Locked 读者没有权限看全文，内容已经被去掉了，只剩下摘要
+	Locked bool
+	// PublishAt 定时发表的时间，零值表示立刻发表
+	PublishAt time.Time
+	// Tags 已经规范化过的标签，见 NormalizeTags
+	Tags []string
+	// Category 已经规范化过的分类，空字符串表示没有分类
+	Category string
+	// Dtime 放进回收站的时间
+	Dtime time.Time
+	// Version 草稿的版本号，保存的时候作为期望的版本，0 表示不检查
+	Version int64
+	// Rendered 发表的时候从 Content 渲染出来的，只有线上库的文章才有
+	Rendered RenderedContent
+	// Series 读者看文章的时候，文章所在的系列，不在系列里面就是零值
+	Series SeriesNav
+	Ctime  time.Time
+	Utime  time.Time
+}
+
+// RenderedContent 渲染之后的文章内容
+type RenderedContent struct {
+	// HTML 已经过滤过 XSS，可以直接展示
+	HTML string
+	TOC  []Heading
+	// Abstract 纯文本的摘要，不带任何标记
+	Abstract string
+}
+
+// Heading 目录里面的一项
+type Heading struct {
+	Level int
+	// ID 对应 HTML 里面标题的锚点
+	ID   string
+	Text string
 }
 
 type Author struct {
@@ -18,6 +61,9 @@ type Author struct {
 }
 
 func (a Article) Abstract() string {
+	if a.Rendered.Abstract != "" {
+		return a.Rendered.Abstract
+	}
 	str := []rune(a.Content)
 	// 只取部分作为摘要
 	if len(str) > 128 {
@@ -41,4 +87,23 @@ const (
 	ArticleStatusPublished
 	// ArticleStatusPrivate 仅自己可见
 	ArticleStatusPrivate
+	// ArticleStatusScheduled 等待定时发表
+	ArticleStatusScheduled
+	// ArticleStatusDeleted 在回收站里面，过了保留期就会被彻底删除
+	ArticleStatusDeleted
+	// ArticleStatusPendingReview 发表的时候命中了敏感词，等待人工审核
+	ArticleStatusPendingReview
+	// ArticleStatusRejected 审核没有通过，作者修改之后可以重新发表
+	ArticleStatusRejected
 )
+
+// ArticleRevision 文章的一个历史版本
+type ArticleRevision struct {
+	Id        int64
+	ArticleId int64
+	Author    Author
+	Title     string
+	Content   string
+	Status    ArticleStatus
+	Ctime     time.Time
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Attachment 上传的图片或者附件，内容一样的文件只保存一份
+type Attachment struct {
+	Id int64
+	// Uid 第一次上传的人
+	Uid         int64
+	Filename    string
+	ContentType string
+	Size        int64
+	// Hash 内容的 sha256，十六进制
+	Hash string
+	// Key 在对象存储里面的 key，URL 是对应的访问地址
+	Key string
+	URL string
+	// 下面几个只有能解析的图片才有
+	Width    int
+	Height   int
+	ThumbKey string
+	ThumbURL string
+	Ctime    time.Time
+	// Utime 最后一次被上传的时间，重复上传也会更新
+	Utime time.Time
+}
+
+// attachmentScheme 文章内容里面用 attachment:<id> 引用附件，
+// 比如 ![图片](attachment:123)，发表的时候会换成真实的地址
+const attachmentScheme = "attachment:"
+
+var attachmentRefPattern = regexp.MustCompile(`attachment:(\d+)`)
+
+// AttachmentRef 在文章内容里面引用附件的地址
+func AttachmentRef(id int64) string {
+	return attachmentScheme + strconv.FormatInt(id, 10)
+}
+
+// ParseAttachmentRef 解析链接或者图片的地址，不是附件引用的返回 false
+func ParseAttachmentRef(dest string) (int64, bool) {
+	raw, ok := strings.CutPrefix(dest, attachmentScheme)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// AttachmentRefs 找出内容里面引用的所有附件，去重之后按照出现的顺序返回
+// 宁可多找也不能漏，漏了的附件会被当成没有引用而回收掉
+func AttachmentRefs(content string) []int64 {
+	matches := attachmentRefPattern.FindAllStringSubmatch(content, -1)
+	res := make([]int64, 0, len(matches))
+	seen := make(map[int64]struct{}, len(matches))
+	for _, m := range matches {
+		id, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || id <= 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
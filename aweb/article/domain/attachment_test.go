@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentRefs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    []int64
+	}{
+		{
+			name:    "图片和链接",
+			content: "![a](attachment:12) 下载 [b](attachment:3 \"标题\")",
+			want:    []int64{12, 3},
+		},
+		{
+			name:    "重复引用",
+			content: "![a](attachment:1)\n![a](attachment:1)",
+			want:    []int64{1},
+		},
+		{
+			name:    "没有引用",
+			content: "![a](https://a.com/attachment.png) attachment:abc attachment:0",
+			want:    []int64{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, AttachmentRefs(tc.content))
+		})
+	}
+}
+
+func TestParseAttachmentRef(t *testing.T) {
+	id, ok := ParseAttachmentRef(AttachmentRef(42))
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), id)
+	for _, dest := range []string{"https://a.com", "attachment:", "attachment:-1", "attachment:1x"} {
+		_, ok = ParseAttachmentRef(dest)
+		assert.False(t, ok, dest)
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "github.com/pluckhuang/goweb/aweb/pkg/tagx"
+
+// NormalizeCategory 分类的写法规则和标签一样
+// 一篇文章可以有多个标签，但是只能属于一个分类
+func NormalizeCategory(category string) string {
+	return tagx.Normalize(category)
+}
+
+// ArticleFilter 文章列表的过滤条件，为空的字段不参与过滤
+type ArticleFilter struct {
+	Tag      string
+	Category string
+}
+
+func (f ArticleFilter) IsZero() bool {
+	return f.Tag == "" && f.Category == ""
+}
+
+// Normalize 调用方传进来的条件要和写入的时候一样规范化才能匹配上
+func (f ArticleFilter) Normalize() ArticleFilter {
+	return ArticleFilter{
+		Tag:      NormalizeTag(f.Tag),
+		Category: NormalizeCategory(f.Category),
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticleFilter_Normalize(t *testing.T) {
+	testCases := []struct {
+		name   string
+		filter ArticleFilter
+		want   ArticleFilter
+	}{
+		{
+			name:   "和写入的规则一致",
+			filter: ArticleFilter{Tag: " #Go ", Category: "Ｂａｃｋｅｎｄ  Dev"},
+			want:   ArticleFilter{Tag: "go", Category: "backend-dev"},
+		},
+		{
+			name:   "空白等于没有条件",
+			filter: ArticleFilter{Tag: "  ", Category: "#"},
+			want:   ArticleFilter{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.filter.Normalize()
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.want == ArticleFilter{}, got.IsZero())
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// CollaboratorRole 协作者在文章上的角色，数值越大权限越大
+type CollaboratorRole uint8
+
+func (r CollaboratorRole) ToUint8() uint8 {
+	return uint8(r)
+}
+
+const (
+	// CollaboratorRoleNone 和文章没有关系
+	CollaboratorRoleNone CollaboratorRole = iota
+	// CollaboratorRoleViewer 只能看草稿
+	CollaboratorRoleViewer
+	// CollaboratorRoleEditor 可以修改和发表
+	CollaboratorRoleEditor
+	// CollaboratorRoleOwner 文章的作者，只有作者能修改文章的状态和管理协作者
+	// 作者不存在协作者表里面，就是文章的 author_id
+	CollaboratorRoleOwner
+)
+
+// Invitable 只能邀请别人成为查看者或者编辑者
+func (r CollaboratorRole) Invitable() bool {
+	return r == CollaboratorRoleViewer || r == CollaboratorRoleEditor
+}
+
+func (r CollaboratorRole) CanView() bool {
+	return r >= CollaboratorRoleViewer
+}
+
+func (r CollaboratorRole) CanEdit() bool {
+	return r >= CollaboratorRoleEditor
+}
+
+func (r CollaboratorRole) IsOwner() bool {
+	return r == CollaboratorRoleOwner
+}
+
+type Collaborator struct {
+	ArticleId int64
+	Uid       int64
+	Role      CollaboratorRole
+	Ctime     time.Time
+	Utime     time.Time
+}
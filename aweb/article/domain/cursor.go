@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("非法的分页游标")
+
+// Cursor 按照 (utime, id) 倒序翻页的位置，指向上一页的最后一篇文章
+// 零值表示从第一页开始
+type Cursor struct {
+	Utime int64
+	Id    int64
+}
+
+// CursorOf 以 art 为上一页的最后一篇文章
+func CursorOf(art Article) Cursor {
+	return Cursor{Utime: art.Utime.UnixMilli(), Id: art.Id}
+}
+
+func (c Cursor) IsZero() bool {
+	return c.Utime == 0 && c.Id == 0
+}
+
+// Encode 调用方不应该关心游标的内容，所以编码成不透明的字符串
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.Utime, 10) + ":" + strconv.FormatInt(c.Id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor 空字符串返回零值，也就是第一页
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	utimeStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	utime, err := strconv.ParseInt(utimeStr, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{Utime: utime, Id: id}, nil
+}
+
+// NextCursor 根据这一页的结果算出下一页的游标
+// 不满一页说明没有更多了，返回空字符串
+func NextCursor(arts []Article, limit int) string {
+	if len(arts) == 0 || len(arts) < limit {
+		return ""
+	}
+	return CursorOf(arts[len(arts)-1]).Encode()
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	art := Article{Id: 12, Utime: time.UnixMilli(1700000000123)}
+	c, err := ParseCursor(CursorOf(art).Encode())
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Utime: 1700000000123, Id: 12}, c)
+
+	c, err = ParseCursor("")
+	require.NoError(t, err)
+	assert.True(t, c.IsZero())
+
+	for _, s := range []string{"!!!", "MTIz", "YTpi"} {
+		_, err = ParseCursor(s)
+		assert.Equal(t, ErrInvalidCursor, err, s)
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	arts := []Article{
+		{Id: 3, Utime: time.UnixMilli(300)},
+		{Id: 2, Utime: time.UnixMilli(200)},
+	}
+	assert.Equal(t, "", NextCursor(arts, 3))
+	assert.Equal(t, Cursor{Utime: 200, Id: 2}.Encode(), NextCursor(arts, 2))
+	assert.Equal(t, "", NextCursor(nil, 2))
+}
@@ -0,0 +1,11 @@
+package domain
+
+// OutboxMessage 本地消息表里面等待投递的消息
+type OutboxMessage struct {
+	Id      int64
+	Topic   string
+	Key     string
+	Payload []byte
+	// 已经重试过的次数
+	Retries int
+}
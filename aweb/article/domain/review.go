@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// ArticleReview 一次发表审核，对应草稿的一个版本
+type ArticleReview struct {
+	Id        int64
+	ArticleId int64
+	AuthorId  int64
+	// Version 提交审核的时候草稿的版本，作者再修改的话这次审核就作废了
+	Version int64
+	// Hits 命中的敏感词
+	Hits   []string
+	Status ReviewStatus
+	// Reason 审核的意见，驳回的时候必须有
+	Reason     string
+	ReviewerId int64
+	Ctime      time.Time
+	Utime      time.Time
+}
+
+type ReviewStatus uint8
+
+func (s ReviewStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+const (
+	ReviewStatusUnknown ReviewStatus = iota
+	// ReviewStatusPending 等待审核
+	ReviewStatusPending
+	// ReviewStatusApproved 通过，文章已经发表或者进入了定时发表
+	ReviewStatusApproved
+	// ReviewStatusRejected 驳回
+	ReviewStatusRejected
+	// ReviewStatusCanceled 审核之前作者又修改了文章
+	ReviewStatusCanceled
+)
@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// Series 系列，作者把多篇已经发表的文章按顺序组织成章节
+type Series struct {
+	Id          int64
+	Author      Author
+	Title       string
+	Description string
+	// ArticleIds 按照章节的顺序
+	ArticleIds []int64
+	Ctime      time.Time
+	Utime      time.Time
+}
+
+// SeriesNav 文章在系列里面的位置，用来做上一篇和下一篇的导航
+type SeriesNav struct {
+	SeriesId int64
+	Title    string
+	// Index 从 0 开始的章节序号
+	Index int
+	Total int
+	// Prev 和 Next 为 0 说明已经是第一篇或者最后一篇了
+	Prev int64
+	Next int64
+}
+
+// Nav 找出 aid 在系列里面的位置，aid 不在系列里面的时候返回 false
+func (s Series) Nav(aid int64) (SeriesNav, bool) {
+	for i, id := range s.ArticleIds {
+		if id != aid {
+			continue
+		}
+		nav := SeriesNav{
+			SeriesId: s.Id,
+			Title:    s.Title,
+			Index:    i,
+			Total:    len(s.ArticleIds),
+		}
+		if i > 0 {
+			nav.Prev = s.ArticleIds[i-1]
+		}
+		if i < len(s.ArticleIds)-1 {
+			nav.Next = s.ArticleIds[i+1]
+		}
+		return nav, true
+	}
+	return SeriesNav{}, false
+}
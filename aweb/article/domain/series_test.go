@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesNav(t *testing.T) {
+	s := Series{Id: 1, Title: "系列", ArticleIds: []int64{11, 12, 13}}
+	testCases := []struct {
+		name   string
+		aid    int64
+		want   SeriesNav
+		wantOk bool
+	}{
+		{
+			name:   "第一篇",
+			aid:    11,
+			want:   SeriesNav{SeriesId: 1, Title: "系列", Index: 0, Total: 3, Next: 12},
+			wantOk: true,
+		},
+		{
+			name:   "中间",
+			aid:    12,
+			want:   SeriesNav{SeriesId: 1, Title: "系列", Index: 1, Total: 3, Prev: 11, Next: 13},
+			wantOk: true,
+		},
+		{
+			name:   "最后一篇",
+			aid:    13,
+			want:   SeriesNav{SeriesId: 1, Title: "系列", Index: 2, Total: 3, Prev: 12},
+			wantOk: true,
+		},
+		{
+			name: "不在系列里面",
+			aid:  14,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nav, ok := s.Nav(tc.aid)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, nav)
+		})
+	}
+}
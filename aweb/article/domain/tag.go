@@ -0,0 +1,37 @@
+package domain
+
+import "github.com/pluckhuang/goweb/aweb/pkg/tagx"
+
+const (
+	// MaxTagsPerArticle 一篇文章最多可以打多少个标签
+	MaxTagsPerArticle = 10
+	// MaxTagLength 标签最多多少个字符
+	MaxTagLength = tagx.MaxLength
+)
+
+// NormalizeTag 统一标签的写法，搜索那边查询的时候也是用的 tagx.Normalize
+// 规范化之后为空的标签返回空字符串
+func NormalizeTag(tag string) string {
+	return tagx.Normalize(tag)
+}
+
+// NormalizeTags 规范化并且去重，保持原本的顺序，超过上限的部分直接丢弃
+func NormalizeTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+		if len(res) == MaxTagsPerArticle {
+			break
+		}
+	}
+	return res
+}
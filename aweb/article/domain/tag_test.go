@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{
+			name: "大小写和空白",
+			tags: []string{"  Go ", "#Micro  Service", "GO"},
+			want: []string{"go", "micro-service"},
+		},
+		{
+			name: "空标签",
+			tags: []string{"", " ", "#"},
+			want: []string{},
+		},
+		{
+			name: "超过上限",
+			tags: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			want: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NormalizeTags(tc.tags))
+		})
+	}
+}
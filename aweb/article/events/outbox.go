@@ -0,0 +1,136 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+type OutboxRelayConfig struct {
+	// 每次最多抢占多少条消息
+	BatchSize int `yaml:"batchSize"`
+	// 没有消息的时候，隔多久再去查一次
+	Interval time.Duration `yaml:"interval"`
+	// 超过这个次数就不再重试，标记为失败
+	MaxRetries int `yaml:"maxRetries"`
+	// 第 n 次重试要等待 n * RetryBackoff
+	RetryBackoff time.Duration `yaml:"retryBackoff"`
+}
+
+// OutboxRelay 把本地消息表里面的消息投递到 kafka
+// 多个实例可以同时运行，依赖 Preempt 来避免重复发送
+type OutboxRelay struct {
+	repo     repository.OutboxRepository
+	producer sarama.SyncProducer
+	l        logger.LoggerV1
+	cfg      OutboxRelayConfig
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	// done 在循环退出之后关闭
+	done chan struct{}
+}
+
+func NewOutboxRelay(repo repository.OutboxRepository,
+	producer sarama.SyncProducer,
+	l logger.LoggerV1,
+	cfg OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		repo:     repo,
+		producer: producer,
+		l:        l,
+		cfg:      cfg,
+		closed:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (r *OutboxRelay) Start() error {
+	go func() {
+		defer close(r.done)
+		for {
+			select {
+			case <-r.closed:
+				return
+			default:
+			}
+			cnt, err := r.relayOnce()
+			if err != nil {
+				r.l.Error("投递本地消息失败", logger.Error(err))
+			}
+			if cnt < r.cfg.BatchSize {
+				// 没有更多的消息了，歇一会
+				select {
+				case <-time.After(r.cfg.Interval):
+				case <-r.closed:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Close 不再抢占新的消息，等正在投递的这一批处理完再返回，
+// 不然抢占了的消息要等一分钟才会被别的实例重新抢占
+// 只能在 Start 之后调用
+func (r *OutboxRelay) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+	<-r.done
+	return nil
+}
+
+// relayOnce 投递一批消息，返回抢占到的消息数量
+func (r *OutboxRelay) relayOnce() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	msgs, err := r.repo.Preempt(ctx, r.cfg.BatchSize)
+	if err != nil {
+		return len(msgs), err
+	}
+	for _, msg := range msgs {
+		r.relay(ctx, msg)
+	}
+	return len(msgs), nil
+}
+
+func (r *OutboxRelay) relay(ctx context.Context, msg domain.OutboxMessage) {
+	_, _, err := r.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: msg.Topic,
+		Key:   sarama.StringEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Payload),
+	})
+	if err == nil {
+		err = r.repo.MarkSent(ctx, msg.Id)
+		if err != nil {
+			// 消息已经发出去了，下次会被重新抢占，重复发送
+			// 下游要保证幂等
+			r.l.Error("标记本地消息已发送失败",
+				logger.Int64("id", msg.Id), logger.Error(err))
+		}
+		return
+	}
+	retries := msg.Retries + 1
+	r.l.Warn("发送本地消息失败",
+		logger.Int64("id", msg.Id),
+		logger.String("topic", msg.Topic),
+		logger.Int("retries", retries),
+		logger.Error(err))
+	if retries >= r.cfg.MaxRetries {
+		err = r.repo.MarkFailed(ctx, msg.Id, retries)
+	} else {
+		nextTime := time.Now().Add(time.Duration(retries) * r.cfg.RetryBackoff)
+		err = r.repo.MarkRetry(ctx, msg.Id, retries, nextTime)
+	}
+	if err != nil {
+		r.l.Error("更新本地消息重试状态失败",
+			logger.Int64("id", msg.Id), logger.Error(err))
+	}
+}
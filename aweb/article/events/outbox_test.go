@@ -0,0 +1,129 @@
+package events
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// fakeProducer 只实现了 SendMessage，err 不为空的时候发送失败
+type fakeProducer struct {
+	sarama.SyncProducer
+	mu   sync.Mutex
+	err  error
+	sent []*sarama.ProducerMessage
+}
+
+func (p *fakeProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return 0, 0, p.err
+	}
+	p.sent = append(p.sent, msg)
+	return 0, int64(len(p.sent)), nil
+}
+
+func (p *fakeProducer) sentCnt() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.sent)
+}
+
+func newTestRelay(t *testing.T, producer sarama.SyncProducer) (*OutboxRelay, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "outbox.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&dao.OutboxMessage{}))
+	repo := repository.NewOutboxRepository(dao.NewGORMOutboxDAO(db))
+	return NewOutboxRelay(repo, producer, logger.NewNopLogger(), OutboxRelayConfig{
+		BatchSize:    10,
+		Interval:     time.Millisecond * 10,
+		MaxRetries:   2,
+		RetryBackoff: time.Minute,
+	}), db
+}
+
+func insertOutbox(t *testing.T, db *gorm.DB, id int64) {
+	now := time.Now().UnixMilli()
+	require.NoError(t, db.Create(&dao.OutboxMessage{Id: id, Topic: "topic", Key: "key",
+		Payload: "payload", NextTime: now, Ctime: now, Utime: now}).Error)
+}
+
+func outboxById(t *testing.T, db *gorm.DB, id int64) dao.OutboxMessage {
+	var msg dao.OutboxMessage
+	require.NoError(t, db.Where("id = ?", id).First(&msg).Error)
+	return msg
+}
+
+func TestOutboxRelay_RelayOnce(t *testing.T) {
+	producer := &fakeProducer{}
+	r, db := newTestRelay(t, producer)
+	insertOutbox(t, db, 1)
+
+	cnt, err := r.relayOnce()
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+	require.Len(t, producer.sent, 1)
+	assert.Equal(t, "topic", producer.sent[0].Topic)
+	assert.Equal(t, sarama.StringEncoder("key"), producer.sent[0].Key)
+	assert.Equal(t, sarama.ByteEncoder("payload"), producer.sent[0].Value)
+	assert.Equal(t, dao.OutboxStatusSent, outboxById(t, db, 1).Status)
+}
+
+func TestOutboxRelay_Retry(t *testing.T) {
+	producer := &fakeProducer{err: errors.New("kafka 挂了")}
+	r, db := newTestRelay(t, producer)
+	insertOutbox(t, db, 1)
+
+	start := time.Now()
+	_, err := r.relayOnce()
+	require.NoError(t, err)
+	msg := outboxById(t, db, 1)
+	assert.Equal(t, dao.OutboxStatusPending, msg.Status)
+	assert.Equal(t, 1, msg.Retries)
+	// 第一次重试等一个 RetryBackoff
+	assert.GreaterOrEqual(t, msg.NextTime, start.Add(time.Minute).UnixMilli())
+
+	// 没到时间不会重试
+	cnt, err := r.relayOnce()
+	require.NoError(t, err)
+	assert.Zero(t, cnt)
+
+	// 到时间了还是失败，次数用完了，标记成失败
+	require.NoError(t, db.Model(&dao.OutboxMessage{}).Where("id = 1").
+		Update("next_time", time.Now().UnixMilli()).Error)
+	cnt, err = r.relayOnce()
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+	msg = outboxById(t, db, 1)
+	assert.Equal(t, dao.OutboxStatusFailed, msg.Status)
+	assert.Equal(t, 2, msg.Retries)
+}
+
+func TestOutboxRelay_Close(t *testing.T) {
+	producer := &fakeProducer{}
+	r, db := newTestRelay(t, producer)
+	insertOutbox(t, db, 1)
+	require.NoError(t, r.Start())
+	assert.Eventually(t, func() bool {
+		return producer.sentCnt() == 1
+	}, time.Second, time.Millisecond*10)
+
+	require.NoError(t, r.Close())
+	// 关闭之后不会再投递新的消息
+	insertOutbox(t, db, 2)
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, 1, producer.sentCnt())
+	assert.Equal(t, dao.OutboxStatusPending, outboxById(t, db, 2).Status)
+}
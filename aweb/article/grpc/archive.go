@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"bufio"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize 导出的时候每个分片的大小
+const exportChunkSize = 32 << 10
+
+func (c *ArticleServiceServer) ExportArticles(request *articlev1.ExportArticlesRequest, stream grpc.ServerStreamingServer[articlev1.ExportArticlesResponse]) error {
+	w := bufio.NewWriterSize(chunkWriter(func(p []byte) error {
+		return stream.Send(&articlev1.ExportArticlesResponse{Chunk: p})
+	}), exportChunkSize)
+	err := c.archiveSvc.Export(stream.Context(), request.GetUid(), w)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (c *ArticleServiceServer) ImportArticles(stream grpc.BidiStreamingServer[articlev1.ImportArticlesRequest, articlev1.ImportArticlesResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := first.GetMeta()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "第一条消息必须是 meta")
+	}
+	r := &chunkReader{recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if req.GetMeta() != nil {
+			return nil, errDuplicateMeta
+		}
+		return req.GetChunk(), nil
+	}}
+	err = c.archiveSvc.Import(stream.Context(), meta.GetUid(), r, func(res service.ImportResult) error {
+		resp := &articlev1.ImportArticlesResponse{
+			Filename: res.Filename,
+			Id:       res.Id,
+			Status:   int32(res.Status),
+		}
+		if res.Err != nil {
+			resp.Error = res.Err.Error()
+		}
+		return stream.Send(resp)
+	})
+	return convertArchiveErr(err)
+}
+
+func convertArchiveErr(err error) error {
+	switch err {
+	case service.ErrArchiveTooLarge, service.ErrTooManyFiles, service.ErrInvalidArchive:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+// chunkWriter 每次 Write 都作为一个分片发出去，配合 bufio 控制分片大小
+type chunkWriter func(p []byte) error
+
+func (f chunkWriter) Write(p []byte) (int, error) {
+	// bufio 会复用缓冲区，Send 返回之后 grpc 还可能用到消息，所以要复制一份
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	if err := f(chunk); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -2,24 +2,37 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/pluckhuang/goweb/aweb/article/domain"
 	"github.com/pluckhuang/goweb/aweb/article/service"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/ecodeclub/ekit/slice"
 	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
 )
 
 type ArticleServiceServer struct {
 	// 组合
 	articlev1.UnimplementedArticleServiceServer
-	svc service.ArticleService
+	svc           service.ArticleService
+	attachmentSvc service.AttachmentService
+	subSvc        service.SubscriptionService
+	archiveSvc    service.ArchiveService
 }
 
-func NewGrpcServer(svc service.ArticleService) *ArticleServiceServer {
+func NewGrpcServer(svc service.ArticleService,
+	attachmentSvc service.AttachmentService,
+	subSvc service.SubscriptionService,
+	archiveSvc service.ArchiveService) *ArticleServiceServer {
 	return &ArticleServiceServer{
-		svc: svc,
+		svc:           svc,
+		attachmentSvc: attachmentSvc,
+		subSvc:        subSvc,
+		archiveSvc:    archiveSvc,
 	}
 }
 func (c *ArticleServiceServer) Register(server grpc.ServiceRegistrar) {
@@ -28,43 +41,81 @@ func (c *ArticleServiceServer) Register(server grpc.ServiceRegistrar) {
 
 func (c *ArticleServiceServer) Save(ctx context.Context, request *articlev1.SaveRequest) (*articlev1.SaveResponse, error) {
 	art := convertToDomain(request.GetArticle())
-	id, err := c.svc.Save(ctx, art)
+	art.Version = request.GetExpectedVersion()
+	id, version, err := c.svc.Save(ctx, art)
 	if err != nil {
-		return nil, err
+		return nil, convertSaveErr(err)
 	}
-	return &articlev1.SaveResponse{Id: id}, nil
+	return &articlev1.SaveResponse{Id: id, Version: version}, nil
+}
+
+// convertSaveErr 版本冲突用单独的错误码，把服务端当前的版本号带回去
+func convertSaveErr(err error) error {
+	var conflict *service.VersionConflictError
+	if !errors.As(err, &conflict) {
+		return convertPermissionErr(err)
+	}
+	st, er := status.New(codes.Aborted, conflict.Error()).
+		WithDetails(&articlev1.VersionConflict{CurrentVersion: conflict.Current})
+	if er != nil {
+		return status.Error(codes.Aborted, conflict.Error())
+	}
+	return st.Err()
+}
+
+// convertNotFoundErr 查询不存在的文章是正常的业务结果，不能算到熔断里面
+func convertNotFoundErr(err error) error {
+	if err == service.ErrArticleNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
 }
 
 func convertToDomain(art *articlev1.Article) domain.Article {
-	return domain.Article{
+	res := domain.Article{
 		Id:      art.Id,
 		Title:   art.Title,
 		Content: art.Content,
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
+		Tags:     art.Tags,
+		Category: art.Category,
+		Access:   domain.ArticleAccess(art.Access),
+	}
+	if art.PublishAt != nil {
+		res.PublishAt = art.PublishAt.AsTime()
 	}
+	return res
 }
 
 func (c *ArticleServiceServer) Publish(ctx context.Context, request *articlev1.PublishRequest) (*articlev1.PublishResponse, error) {
 	art := convertToDomain(request.GetArticle())
-	id, err := c.svc.Publish(ctx, art)
+	id, st, err := c.svc.Publish(ctx, art)
 	if err != nil {
-		return nil, err
+		return nil, convertPermissionErr(err)
 	}
-	return &articlev1.PublishResponse{Id: id}, nil
+	return &articlev1.PublishResponse{Id: id, Status: int32(st)}, nil
 }
 
 func (c *ArticleServiceServer) Withdraw(ctx context.Context, request *articlev1.WithdrawRequest) (*articlev1.WithdrawResponse, error) {
 	err := c.svc.Withdraw(ctx, request.Uid, request.Id)
 	if err != nil {
-		return nil, err
+		return nil, convertPermissionErr(err)
 	}
 	return &articlev1.WithdrawResponse{}, nil
 }
 
+func (c *ArticleServiceServer) CancelScheduledPublish(ctx context.Context, request *articlev1.CancelScheduledPublishRequest) (*articlev1.CancelScheduledPublishResponse, error) {
+	err := c.svc.CancelScheduledPublish(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.CancelScheduledPublishResponse{}, nil
+}
+
 func (c *ArticleServiceServer) GetByAuthor(ctx context.Context, request *articlev1.GetByAuthorRequest) (*articlev1.GetByAuthorResponse, error) {
-	arts, err := c.svc.GetByAuthor(ctx, request.Uid, int(request.Offset), int(request.Limit))
+	arts, err := c.svc.GetByAuthor(ctx, request.Uid, convertFilter(request), int(request.Offset), int(request.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +124,28 @@ func (c *ArticleServiceServer) GetByAuthor(ctx context.Context, request *article
 	}, nil
 }
 
+func (c *ArticleServiceServer) GetByAuthorByCursor(ctx context.Context, request *articlev1.GetByAuthorByCursorRequest) (*articlev1.GetByAuthorByCursorResponse, error) {
+	arts, next, err := c.svc.GetByAuthorByCursor(ctx, request.GetUid(), convertFilter(request),
+		request.GetCursor(), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertCursorErr(err)
+	}
+	return &articlev1.GetByAuthorByCursorResponse{
+		Articles:   convertToProtoList(arts),
+		NextCursor: next,
+	}, nil
+}
+
+// convertCursorErr 游标是调用方传错了，不能算到熔断里面
+func convertCursorErr(err error) error {
+	if err == domain.ErrInvalidCursor {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
 func convertToProto(art domain.Article) *articlev1.Article {
-	return &articlev1.Article{
+	res := &articlev1.Article{
 		Id:       art.Id,
 		Title:    art.Title,
 		Content:  art.Content,
@@ -82,6 +153,40 @@ func convertToProto(art domain.Article) *articlev1.Article {
 		Status:   int32(art.Status.ToUint8()),
 		Ctime:    timestamppb.New(art.Ctime),
 		Utime:    timestamppb.New(art.Utime),
+		Tags:     art.Tags,
+		Category: art.Category,
+		Html:     art.Rendered.HTML,
+		Toc: slice.Map(art.Rendered.TOC, func(idx int, src domain.Heading) *articlev1.Heading {
+			return &articlev1.Heading{
+				Level: int32(src.Level),
+				Id:    src.ID,
+				Text:  src.Text,
+			}
+		}),
+		Abstract: art.Rendered.Abstract,
+		Version:  art.Version,
+		Access:   articlev1.ArticleAccess(art.Access),
+		Locked:   art.Locked,
+	}
+	if !art.Dtime.IsZero() {
+		res.Dtime = timestamppb.New(art.Dtime)
+	}
+	if !art.PublishAt.IsZero() {
+		res.PublishAt = timestamppb.New(art.PublishAt)
+	}
+	return res
+}
+
+// filterRequest 列表请求里面的过滤条件
+type filterRequest interface {
+	GetTag() string
+	GetCategory() string
+}
+
+func convertFilter(request filterRequest) domain.ArticleFilter {
+	return domain.ArticleFilter{
+		Tag:      request.GetTag(),
+		Category: request.GetCategory(),
 	}
 }
 
@@ -97,21 +202,36 @@ func convertToProtoList(arts []domain.Article) []*articlev1.Article {
 func (c *ArticleServiceServer) GetById(ctx context.Context, request *articlev1.GetByIdRequest) (*articlev1.GetByIdResponse, error) {
 	art, err := c.svc.GetById(ctx, request.Id)
 	if err != nil {
-		return nil, err
+		return nil, convertNotFoundErr(err)
 	}
 	return &articlev1.GetByIdResponse{Article: convertToProto(art)}, nil
 }
 
 func (c *ArticleServiceServer) GetPubById(ctx context.Context, request *articlev1.GetPubByIdRequest) (*articlev1.GetPubByIdResponse, error) {
 	art, err := c.svc.GetPubById(ctx, request.Id, request.Uid)
+	if err != nil {
+		return nil, convertNotFoundErr(err)
+	}
+	return &articlev1.GetPubByIdResponse{
+		Article: convertToProto(art),
+		Series:  convertSeriesNavToProto(art.Series),
+	}, nil
+}
+
+func (c *ArticleServiceServer) GetPubByIds(ctx context.Context, request *articlev1.GetPubByIdsRequest) (*articlev1.GetPubByIdsResponse, error) {
+	arts, err := c.svc.GetPubByIds(ctx, request.GetIds())
 	if err != nil {
 		return nil, err
 	}
-	return &articlev1.GetPubByIdResponse{Article: convertToProto(art)}, nil
+	resp := &articlev1.GetPubByIdsResponse{}
+	for _, art := range arts {
+		resp.Articles = append(resp.Articles, convertToProto(art))
+	}
+	return resp, nil
 }
 
 func (c *ArticleServiceServer) ListPub(ctx context.Context, request *articlev1.ListPubRequest) (*articlev1.ListPubResponse, error) {
-	arts, err := c.svc.ListPub(ctx, request.GetStart().AsTime(), int(request.Offset), int(request.Limit))
+	arts, err := c.svc.ListPub(ctx, request.GetStart().AsTime(), convertFilter(request), int(request.Offset), int(request.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -121,3 +241,114 @@ func (c *ArticleServiceServer) ListPub(ctx context.Context, request *articlev1.L
 	}
 	return resp, nil
 }
+
+func (c *ArticleServiceServer) ListPubByCursor(ctx context.Context, request *articlev1.ListPubByCursorRequest) (*articlev1.ListPubByCursorResponse, error) {
+	arts, next, err := c.svc.ListPubByCursor(ctx, request.GetStart().AsTime(), convertFilter(request),
+		request.GetCursor(), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertCursorErr(err)
+	}
+	return &articlev1.ListPubByCursorResponse{
+		Articles:   convertToProtoList(arts),
+		NextCursor: next,
+	}, nil
+}
+
+func (c *ArticleServiceServer) ListRevisions(ctx context.Context, request *articlev1.ListRevisionsRequest) (*articlev1.ListRevisionsResponse, error) {
+	revs, err := c.svc.ListRevisions(ctx, request.GetUid(), request.GetId(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &articlev1.ListRevisionsResponse{}
+	for _, rev := range revs {
+		resp.Revisions = append(resp.Revisions, convertRevisionToProto(rev))
+	}
+	return resp, nil
+}
+
+func (c *ArticleServiceServer) GetRevision(ctx context.Context, request *articlev1.GetRevisionRequest) (*articlev1.GetRevisionResponse, error) {
+	rev, err := c.svc.GetRevision(ctx, request.GetUid(), request.GetId(), request.GetRevisionId())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GetRevisionResponse{Revision: convertRevisionToProto(rev)}, nil
+}
+
+func (c *ArticleServiceServer) DiffRevisions(ctx context.Context, request *articlev1.DiffRevisionsRequest) (*articlev1.DiffRevisionsResponse, error) {
+	lines, err := c.svc.DiffRevisions(ctx, request.GetUid(), request.GetId(),
+		request.GetFromRevisionId(), request.GetToRevisionId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &articlev1.DiffRevisionsResponse{
+		Lines: make([]*articlev1.DiffLine, 0, len(lines)),
+	}
+	for _, line := range lines {
+		resp.Lines = append(resp.Lines, &articlev1.DiffLine{
+			Op:   articlev1.DiffOp(line.Op),
+			Text: line.Text,
+		})
+	}
+	return resp, nil
+}
+
+func (c *ArticleServiceServer) RestoreRevision(ctx context.Context, request *articlev1.RestoreRevisionRequest) (*articlev1.RestoreRevisionResponse, error) {
+	err := c.svc.RestoreRevision(ctx, request.GetUid(), request.GetId(), request.GetRevisionId())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.RestoreRevisionResponse{}, nil
+}
+
+func convertRevisionToProto(rev domain.ArticleRevision) *articlev1.ArticleRevision {
+	return &articlev1.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		AuthorId:  rev.Author.Id,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    int32(rev.Status.ToUint8()),
+		Ctime:     timestamppb.New(rev.Ctime),
+	}
+}
+
+func (c *ArticleServiceServer) Delete(ctx context.Context, request *articlev1.DeleteRequest) (*articlev1.DeleteResponse, error) {
+	err := c.svc.Delete(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, convertPermissionErr(err)
+	}
+	return &articlev1.DeleteResponse{}, nil
+}
+
+func (c *ArticleServiceServer) ListTrash(ctx context.Context, request *articlev1.ListTrashRequest) (*articlev1.ListTrashResponse, error) {
+	arts, err := c.svc.ListTrash(ctx, request.GetUid(), int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.ListTrashResponse{Articles: convertToProtoList(arts)}, nil
+}
+
+func (c *ArticleServiceServer) Restore(ctx context.Context, request *articlev1.RestoreRequest) (*articlev1.RestoreResponse, error) {
+	err := c.svc.Restore(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, convertPermissionErr(err)
+	}
+	return &articlev1.RestoreResponse{}, nil
+}
+
+func (c *ArticleServiceServer) ListExpiredTrash(ctx context.Context, request *articlev1.ListExpiredTrashRequest) (*articlev1.ListExpiredTrashResponse, error) {
+	ids, err := c.svc.ListExpiredTrash(ctx, request.GetBefore().AsTime(), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.ListExpiredTrashResponse{Ids: ids}, nil
+}
+
+func (c *ArticleServiceServer) PurgeTrash(ctx context.Context, request *articlev1.PurgeTrashRequest) (*articlev1.PurgeTrashResponse, error) {
+	ids, err := c.svc.Purge(ctx, request.GetIds(), request.GetBefore().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.PurgeTrashResponse{Ids: ids}, nil
+}
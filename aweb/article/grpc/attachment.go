@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *ArticleServiceServer) UploadAttachment(stream grpc.ClientStreamingServer[articlev1.UploadAttachmentRequest, articlev1.UploadAttachmentResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := first.GetMeta()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "第一条消息必须是 meta")
+	}
+	att, err := c.attachmentSvc.Upload(stream.Context(), meta.GetUid(), meta.GetFilename(),
+		&chunkReader{recv: func() ([]byte, error) {
+			req, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if req.GetMeta() != nil {
+				return nil, errDuplicateMeta
+			}
+			return req.GetChunk(), nil
+		}})
+	if err != nil {
+		return convertAttachmentErr(err)
+	}
+	return stream.SendAndClose(&articlev1.UploadAttachmentResponse{
+		Attachment: convertAttachment(att),
+		Ref:        domain.AttachmentRef(att.Id),
+	})
+}
+
+func (c *ArticleServiceServer) GetAttachment(ctx context.Context, request *articlev1.GetAttachmentRequest) (*articlev1.GetAttachmentResponse, error) {
+	att, err := c.attachmentSvc.Get(ctx, request.GetId())
+	if err != nil {
+		return nil, convertAttachmentErr(err)
+	}
+	return &articlev1.GetAttachmentResponse{
+		Attachment: convertAttachment(att),
+	}, nil
+}
+
+var errDuplicateMeta = status.Error(codes.InvalidArgument, "meta 只能发送一次")
+
+// chunkReader 把客户端发过来的分片当成一个连续的 io.Reader
+type chunkReader struct {
+	// recv 返回下一个分片，客户端发完了会返回 io.EOF
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func convertAttachment(att domain.Attachment) *articlev1.Attachment {
+	return &articlev1.Attachment{
+		Id:          att.Id,
+		Uid:         att.Uid,
+		Filename:    att.Filename,
+		ContentType: att.ContentType,
+		Size:        att.Size,
+		Hash:        att.Hash,
+		Url:         att.URL,
+		Width:       int32(att.Width),
+		Height:      int32(att.Height),
+		ThumbUrl:    att.ThumbURL,
+		Ctime:       timestamppb.New(att.Ctime),
+	}
+}
+
+func convertAttachmentErr(err error) error {
+	switch err {
+	case service.ErrAttachmentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrEmptyAttachment, service.ErrAttachmentTooLarge,
+		service.ErrUnsupportedContentType:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *ArticleServiceServer) InviteCollaborator(ctx context.Context, request *articlev1.InviteCollaboratorRequest) (*articlev1.InviteCollaboratorResponse, error) {
+	err := c.svc.InviteCollaborator(ctx, request.GetUid(), domain.Collaborator{
+		ArticleId: request.GetArticleId(),
+		Uid:       request.GetCollaboratorUid(),
+		Role:      domain.CollaboratorRole(request.GetRole()),
+	})
+	if err != nil {
+		return nil, convertPermissionErr(err)
+	}
+	return &articlev1.InviteCollaboratorResponse{}, nil
+}
+
+func (c *ArticleServiceServer) RemoveCollaborator(ctx context.Context, request *articlev1.RemoveCollaboratorRequest) (*articlev1.RemoveCollaboratorResponse, error) {
+	err := c.svc.RemoveCollaborator(ctx, request.GetUid(), request.GetArticleId(), request.GetCollaboratorUid())
+	if err != nil {
+		return nil, convertPermissionErr(err)
+	}
+	return &articlev1.RemoveCollaboratorResponse{}, nil
+}
+
+func (c *ArticleServiceServer) ListCollaborators(ctx context.Context, request *articlev1.ListCollaboratorsRequest) (*articlev1.ListCollaboratorsResponse, error) {
+	res, err := c.svc.ListCollaborators(ctx, request.GetUid(), request.GetArticleId())
+	if err != nil {
+		return nil, convertPermissionErr(err)
+	}
+	resp := &articlev1.ListCollaboratorsResponse{}
+	for _, collaborator := range res {
+		resp.Collaborators = append(resp.Collaborators, &articlev1.Collaborator{
+			ArticleId: collaborator.ArticleId,
+			Uid:       collaborator.Uid,
+			Role:      articlev1.CollaboratorRole(collaborator.Role),
+			Ctime:     timestamppb.New(collaborator.Ctime),
+			Utime:     timestamppb.New(collaborator.Utime),
+		})
+	}
+	return resp, nil
+}
+
+// convertPermissionErr 没有权限是调用方的问题，不能算到熔断里面
+func convertPermissionErr(err error) error {
+	switch err {
+	case service.ErrPermissionDenied:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case service.ErrInvalidCollaborator, domain.ErrInvalidAccess, domain.ErrContentTooLong:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *ArticleServiceServer) ReviewArticle(ctx context.Context, request *articlev1.ReviewArticleRequest) (*articlev1.ReviewArticleResponse, error) {
+	art, err := c.svc.ReviewArticle(ctx, request.GetUid(), request.GetReviewId(),
+		request.GetApprove(), request.GetReason())
+	if err != nil {
+		return nil, convertReviewErr(err)
+	}
+	return &articlev1.ReviewArticleResponse{Status: int32(art.Status)}, nil
+}
+
+func (c *ArticleServiceServer) ListPendingReviews(ctx context.Context, request *articlev1.ListPendingReviewsRequest) (*articlev1.ListPendingReviewsResponse, error) {
+	res, err := c.svc.ListPendingReviews(ctx, request.GetUid(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertReviewErr(err)
+	}
+	return &articlev1.ListPendingReviewsResponse{
+		Reviews: slice.Map(res, func(idx int, src domain.ArticleReview) *articlev1.ArticleReview {
+			return convertReviewToProto(src)
+		}),
+	}, nil
+}
+
+func (c *ArticleServiceServer) GetArticleReview(ctx context.Context, request *articlev1.GetArticleReviewRequest) (*articlev1.GetArticleReviewResponse, error) {
+	res, err := c.svc.GetLatestReview(ctx, request.GetUid(), request.GetArticleId())
+	if err != nil {
+		return nil, convertReviewErr(err)
+	}
+	return &articlev1.GetArticleReviewResponse{Review: convertReviewToProto(res)}, nil
+}
+
+func convertReviewToProto(r domain.ArticleReview) *articlev1.ArticleReview {
+	return &articlev1.ArticleReview{
+		Id:         r.Id,
+		ArticleId:  r.ArticleId,
+		AuthorId:   r.AuthorId,
+		Version:    r.Version,
+		Hits:       r.Hits,
+		Status:     articlev1.ReviewStatus(r.Status),
+		Reason:     r.Reason,
+		ReviewerId: r.ReviewerId,
+		Ctime:      timestamppb.New(r.Ctime),
+		Utime:      timestamppb.New(r.Utime),
+	}
+}
+
+// convertReviewErr 都是调用方的问题或者正常的业务结果，不能算到熔断里面
+func convertReviewErr(err error) error {
+	switch err {
+	case service.ErrNotReviewer, service.ErrNotAuthor:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case service.ErrEmptyRejectReason:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case service.ErrReviewNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrReviewCanceled:
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return convertNotFoundErr(err)
+	}
+}
@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *ArticleServiceServer) CreateSeries(ctx context.Context, request *articlev1.CreateSeriesRequest) (*articlev1.CreateSeriesResponse, error) {
+	id, err := c.svc.CreateSeries(ctx, convertSeriesToDomain(request.GetSeries()))
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.CreateSeriesResponse{Id: id}, nil
+}
+
+func (c *ArticleServiceServer) UpdateSeries(ctx context.Context, request *articlev1.UpdateSeriesRequest) (*articlev1.UpdateSeriesResponse, error) {
+	err := c.svc.UpdateSeries(ctx, convertSeriesToDomain(request.GetSeries()))
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.UpdateSeriesResponse{}, nil
+}
+
+func (c *ArticleServiceServer) DeleteSeries(ctx context.Context, request *articlev1.DeleteSeriesRequest) (*articlev1.DeleteSeriesResponse, error) {
+	err := c.svc.DeleteSeries(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.DeleteSeriesResponse{}, nil
+}
+
+func (c *ArticleServiceServer) GetSeries(ctx context.Context, request *articlev1.GetSeriesRequest) (*articlev1.GetSeriesResponse, error) {
+	s, err := c.svc.GetSeries(ctx, request.GetId())
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.GetSeriesResponse{Series: convertSeriesToProto(s)}, nil
+}
+
+func (c *ArticleServiceServer) ListSeries(ctx context.Context, request *articlev1.ListSeriesRequest) (*articlev1.ListSeriesResponse, error) {
+	res, err := c.svc.ListSeries(ctx, request.GetUid(), int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &articlev1.ListSeriesResponse{}
+	for _, s := range res {
+		resp.Series = append(resp.Series, convertSeriesToProto(s))
+	}
+	return resp, nil
+}
+
+func (c *ArticleServiceServer) AddSeriesArticle(ctx context.Context, request *articlev1.AddSeriesArticleRequest) (*articlev1.AddSeriesArticleResponse, error) {
+	err := c.svc.AddSeriesArticle(ctx, request.GetUid(), request.GetId(), request.GetArticleId())
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.AddSeriesArticleResponse{}, nil
+}
+
+func (c *ArticleServiceServer) RemoveSeriesArticle(ctx context.Context, request *articlev1.RemoveSeriesArticleRequest) (*articlev1.RemoveSeriesArticleResponse, error) {
+	err := c.svc.RemoveSeriesArticle(ctx, request.GetUid(), request.GetId(), request.GetArticleId())
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.RemoveSeriesArticleResponse{}, nil
+}
+
+func (c *ArticleServiceServer) ReorderSeries(ctx context.Context, request *articlev1.ReorderSeriesRequest) (*articlev1.ReorderSeriesResponse, error) {
+	err := c.svc.ReorderSeries(ctx, request.GetUid(), request.GetId(), request.GetArticleIds())
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	return &articlev1.ReorderSeriesResponse{}, nil
+}
+
+// convertSeriesErr 这些都是调用方传错了参数，不能算到熔断里面
+func convertSeriesErr(err error) error {
+	switch err {
+	case service.ErrSeriesNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrInvalidSeriesArticle, service.ErrEmptySeriesTitle:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+func convertSeriesToDomain(s *articlev1.Series) domain.Series {
+	return domain.Series{
+		Id: s.GetId(),
+		Author: domain.Author{
+			Id: s.GetAuthorId(),
+		},
+		Title:       s.GetTitle(),
+		Description: s.GetDescription(),
+		ArticleIds:  s.GetArticleIds(),
+	}
+}
+
+func convertSeriesToProto(s domain.Series) *articlev1.Series {
+	return &articlev1.Series{
+		Id:          s.Id,
+		AuthorId:    s.Author.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		ArticleIds:  s.ArticleIds,
+		Ctime:       timestamppb.New(s.Ctime),
+		Utime:       timestamppb.New(s.Utime),
+	}
+}
+
+// convertSeriesNavToProto 文章不在系列里面的时候返回 nil
+func convertSeriesNavToProto(nav domain.SeriesNav) *articlev1.SeriesNav {
+	if nav.SeriesId == 0 {
+		return nil
+	}
+	return &articlev1.SeriesNav{
+		SeriesId: nav.SeriesId,
+		Title:    nav.Title,
+		Index:    int32(nav.Index),
+		Total:    int32(nav.Total),
+		PrevId:   nav.Prev,
+		NextId:   nav.Next,
+	}
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *ArticleServiceServer) GrantSubscription(ctx context.Context, request *articlev1.GrantSubscriptionRequest) (*articlev1.GrantSubscriptionResponse, error) {
+	sub := domain.Subscription{
+		Uid:      request.GetSubscriber(),
+		AuthorId: request.GetAuthorId(),
+	}
+	if request.GetExpireAt() != nil {
+		sub.ExpireAt = request.GetExpireAt().AsTime()
+	}
+	err := c.subSvc.Grant(ctx, request.GetUid(), sub)
+	if err == service.ErrNotAdmin {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GrantSubscriptionResponse{}, nil
+}
@@ -0,0 +1,64 @@
+package ioc
+
+import (
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/pkg/storage"
+	"github.com/spf13/viper"
+)
+
+func InitStorage() storage.Storage {
+	type Config struct {
+		Root    string `yaml:"root"`
+		BaseURL string `yaml:"baseURL"`
+	}
+	cfg := Config{
+		Root:    "./data/attachments",
+		BaseURL: "http://localhost:8080/static",
+	}
+	err := viper.UnmarshalKey("storage.local", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	s, err := storage.NewLocalStorage(cfg.Root, cfg.BaseURL)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func InitAttachmentService(repo repository.AttachmentRepository,
+	s storage.Storage, l logger.LoggerV1) service.AttachmentService {
+	cfg := service.AttachmentConfig{
+		MaxSize: 10 << 20,
+		AllowedTypes: []string{
+			"image/png", "image/jpeg", "image/gif", "image/webp",
+			"application/pdf", "application/zip",
+		},
+		ThumbnailSize: 320,
+		MaxPixels:     40_000_000,
+		GCGracePeriod: time.Hour * 24,
+		GCBatchSize:   100,
+	}
+	err := viper.UnmarshalKey("attachment", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewAttachmentService(repo, s, l, cfg)
+}
+
+func InitArchiveService(svc service.ArticleService, repo repository.ArticleRepository) service.ArchiveService {
+	cfg := service.ArchiveConfig{
+		MaxSize:     50 << 20,
+		MaxFiles:    1000,
+		MaxFileSize: 1 << 20,
+	}
+	err := viper.UnmarshalKey("archive", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewArchiveService(svc, repo, cfg)
+}
@@ -0,0 +1,35 @@
+package ioc
+
+import (
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+// InitArticleCache Redis 前面加一层本地缓存，放读得最多的线上文章
+func InitArticleCache(client redis.Cmdable, bus cachex.InvalidationBus) cache.ArticleCache {
+	cfg := cache.HotArticleCacheConfig{
+		Capacity:   1000,
+		Expiration: time.Second * 30,
+		Threshold:  3,
+	}
+	err := viper.UnmarshalKey("cache.hot", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	res, err := cache.NewHotArticleCache(cache.NewArticleRedisCache(client), bus, cfg,
+		prometheus.CounterOpts{
+			Namespace: "goweb",
+			Subsystem: "aweb_article",
+			Name:      "cache_requests_total",
+			Help:      "文章详情缓存的命中情况，tier 是哪一层缓存",
+		})
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
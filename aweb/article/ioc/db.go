@@ -2,10 +2,14 @@ package ioc
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	jobdao "github.com/pluckhuang/goweb/aweb/internal/repository/dao"
 
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/pkg/snowflake"
 	"github.com/spf13/viper"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -28,17 +32,74 @@ func InitDBV2() *gorm.DB {
 	return db
 }
 
+// dbConfig 主库和分库的配置都是这个格式
+type dbConfig struct {
+	DSN string `yaml:"dsn"`
+	// Replicas 从库的 DSN
+	Replicas            []string      `yaml:"replicas"`
+	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
+}
+
 func InitDB(l logger.LoggerV1) *gorm.DB {
-	type Config struct {
-		DSN string `yaml:"dsn"`
-	}
-	c := Config{
+	c := dbConfig{
 		DSN: "root:root@tcp(localhost:3306)/mysql",
 	}
 	err := viper.UnmarshalKey("db", &c)
 	if err != nil {
 		panic(fmt.Errorf("初始化配置失败 %v, 原因 %w", c, err))
 	}
+	db := openDB(c, "aweb")
+	// 定时发表用的是通用的 job 表
+	err = db.AutoMigrate(&jobdao.Job{})
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// ArticleShards 文章分库，下标就是分片号，没有配置的时候不分库
+type ArticleShards []*gorm.DB
+
+func InitArticleShards() ArticleShards {
+	var cfgs []dbConfig
+	err := viper.UnmarshalKey("db.shards", &cfgs)
+	if err != nil {
+		panic(fmt.Errorf("初始化分库配置失败 %w", err))
+	}
+	if len(cfgs) > snowflake.MaxShard+1 {
+		panic(fmt.Errorf("分库数量 %d 超过上限 %d", len(cfgs), snowflake.MaxShard+1))
+	}
+	res := make(ArticleShards, 0, len(cfgs))
+	for i, c := range cfgs {
+		res = append(res, openDB(c, fmt.Sprintf("aweb_article_%d", i)))
+	}
+	return res
+}
+
+// InitIdGenerator 多个实例同时写的时候，每个实例的 workerId 必须不一样
+func InitIdGenerator() *snowflake.Generator {
+	gen, err := snowflake.NewGenerator(viper.GetInt64("db.workerId"))
+	if err != nil {
+		panic(err)
+	}
+	return gen
+}
+
+func InitArticleDAO(db *gorm.DB, shards ArticleShards, gen *snowflake.Generator) dao.ArticleDAO {
+	if len(shards) == 0 {
+		return dao.NewArticleGORMDAO(db)
+	}
+	return dao.NewShardedArticleDAO(shards, gen)
+}
+
+func InitAttachmentDAO(db *gorm.DB, shards ArticleShards) dao.AttachmentDAO {
+	if len(shards) == 0 {
+		return dao.NewGORMAttachmentDAO(db)
+	}
+	return dao.NewShardedAttachmentDAO(db, shards)
+}
+
+func openDB(c dbConfig, name string) *gorm.DB {
 	db, err := gorm.Open(mysql.Open(c.DSN), &gorm.Config{
 		//使用 DEBUG 来打印
 		Logger: glogger.Default.LogMode(glogger.Info),
@@ -49,7 +110,7 @@ func InitDB(l logger.LoggerV1) *gorm.DB {
 
 	// 接入 prometheus
 	err = db.Use(prometheus.New(prometheus.Config{
-		DBName: "aweb",
+		DBName: name,
 		// 每 15 秒采集一些数据
 		RefreshInterval: 15,
 		MetricsCollector: []prometheus.MetricsCollector{
@@ -65,6 +126,14 @@ func InitDB(l logger.LoggerV1) *gorm.DB {
 	if err != nil {
 		panic(err)
 	}
+	err = gormx.UseReplicas(db, gormx.ReplicaConfig{
+		Replicas:            c.Replicas,
+		HealthCheckInterval: c.HealthCheckInterval,
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 每个分库都是完整的表结构，文章相关的事务不用跨库
 	err = dao.InitTables(db)
 	if err != nil {
 		panic(err)
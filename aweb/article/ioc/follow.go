@@ -0,0 +1,47 @@
+package ioc
+
+import (
+	followv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/follow/v1"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"github.com/spf13/viper"
+	etcdv3 "go.etcd.io/etcd/client/v3"
+	resolver "go.etcd.io/etcd/client/v3/naming/resolver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// InitFollowClient 检查读者有没有关注作者
+func InitFollowClient(etcdClient *etcdv3.Client) followv1.FollowServiceClient {
+	type Config struct {
+		Target string `json:"target"`
+		Secure bool   `json:"secure"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("grpc.client.follow", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	rs, err := resolver.NewBuilder(etcdClient)
+	if err != nil {
+		panic(err)
+	}
+	opts := []grpc.DialOption{grpc.WithResolvers(rs)}
+	if !cfg.Secure {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	cc, err := grpc.Dial(cfg.Target, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return followv1.NewFollowServiceClient(cc)
+}
+
+func InitSubscriptionService(repo repository.SubscriptionRepository) service.SubscriptionService {
+	var cfg service.SubscriptionConfig
+	err := viper.UnmarshalKey("subscription", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewSubscriptionService(repo, cfg)
+}
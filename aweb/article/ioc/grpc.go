@@ -2,6 +2,7 @@ package ioc
 
 import (
 	grpc2 "github.com/pluckhuang/goweb/aweb/article/grpc"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"github.com/pluckhuang/goweb/aweb/pkg/grpcx"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/spf13/viper"
@@ -19,7 +20,11 @@ func InitGRPCxServer(l logger.LoggerV1, ecli *clientv3.Client, articleService *g
 	if err != nil {
 		panic(err)
 	}
-	server := grpc.NewServer()
+	// 每个请求一个读写分离的会话，写过之后的查询都走主库
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(gormx.SessionUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(gormx.SessionStreamServerInterceptor()),
+	)
 	articleService.Register(server)
 	return &grpcx.Server{
 		Server:     server,
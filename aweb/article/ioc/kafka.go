@@ -1,9 +1,15 @@
 package ioc
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 	"github.com/pluckhuang/goweb/aweb/article/events"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 func InitKafka() sarama.Client {
@@ -35,3 +41,26 @@ func InitSyncProducer(c sarama.Client) sarama.SyncProducer {
 func InitConsumers() []events.Consumer {
 	return []events.Consumer{}
 }
+
+// InitOutboxRelays 本地消息和文章写在同一个库，每个分库都要有一个 relay
+// 主库上的也要留着，把分库之前没发完的消息发出去
+func InitOutboxRelays(db *gorm.DB, shards ArticleShards,
+	p sarama.SyncProducer, l logger.LoggerV1) []*events.OutboxRelay {
+	cfg := events.OutboxRelayConfig{
+		BatchSize:    100,
+		Interval:     time.Second,
+		MaxRetries:   10,
+		RetryBackoff: time.Second * 5,
+	}
+	err := viper.UnmarshalKey("outbox", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	dbs := append([]*gorm.DB{db}, shards...)
+	res := make([]*events.OutboxRelay, 0, len(dbs))
+	for _, d := range dbs {
+		repo := repository.NewOutboxRepository(dao.NewGORMOutboxDAO(d))
+		res = append(res, events.NewOutboxRelay(repo, p, l, cfg))
+	}
+	return res
+}
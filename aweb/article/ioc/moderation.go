@@ -0,0 +1,45 @@
+package ioc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/service"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/pkg/sensitive"
+	"github.com/spf13/viper"
+)
+
+// InitSensitiveDictionary 启动的时候加载一次，之后文件有修改就自动重新加载
+func InitSensitiveDictionary(l logger.LoggerV1) *sensitive.Dictionary {
+	type Config struct {
+		DictPath       string        `yaml:"dictPath"`
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
+	}
+	cfg := Config{
+		DictPath:       "./config/sensitive_words.txt",
+		ReloadInterval: time.Minute,
+	}
+	err := viper.UnmarshalKey("moderation", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	dict := sensitive.NewDictionary(nil)
+	err = dict.LoadFile(cfg.DictPath)
+	if err != nil {
+		panic(err)
+	}
+	go dict.Watch(context.Background(), cfg.DictPath, cfg.ReloadInterval, l)
+	return dict
+}
+
+func InitModerator(dict *sensitive.Dictionary) service.Moderator {
+	cfg := service.ModerationConfig{
+		MaxHits: 20,
+	}
+	err := viper.UnmarshalKey("moderation", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewModerator(dict, cfg)
+}
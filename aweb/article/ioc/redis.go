@@ -1,13 +1,34 @@
 package ioc
 
 import (
+	"context"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 )
 
-func InitRedis() redis.Cmdable {
-	cmd := redis.NewClient(&redis.Options{
+func InitRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr: viper.GetString("redis.addr"),
 	})
-	return cmd
+}
+
+func InitRedis(client *redis.Client) redis.Cmdable {
+	return client
+}
+
+// InitInvalidationBus 通知别的实例删除本地缓存
+func InitInvalidationBus(client *redis.Client, l logger.LoggerV1) cachex.InvalidationBus {
+	channel := viper.GetString("cache.invalidation.channel")
+	if channel == "" {
+		channel = "article:cache_invalidation"
+	}
+	bus := cachex.NewRedisInvalidationBus(client, channel, l)
+	err := bus.Start(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	return bus
 }
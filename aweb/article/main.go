@@ -1,6 +1,12 @@
 package main
 
 import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pluckhuang/goweb/aweb/article/events"
+	"github.com/pluckhuang/goweb/aweb/article/service"
 	"github.com/pluckhuang/goweb/aweb/pkg/grpcx"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -9,10 +15,31 @@ import (
 func main() {
 	initViperV2Watch()
 	app := Init()
-	err := app.server.Serve()
+	for _, relay := range app.relays {
+		err := relay.Start()
+		if err != nil {
+			panic(err)
+		}
+	}
+	err := app.publisher.Start()
 	if err != nil {
 		panic(err)
 	}
+	go func() {
+		err := app.server.Serve()
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	// 先停掉 grpc 服务，不再写入新的消息，再等 relay 把正在投递的消息处理完
+	_ = app.server.Close()
+	for _, relay := range app.relays {
+		_ = relay.Close()
+	}
 }
 
 func initViperV2Watch() {
@@ -29,5 +56,7 @@ func initViperV2Watch() {
 }
 
 type App struct {
-	server *grpcx.Server
+	server    *grpcx.Server
+	relays    []*events.OutboxRelay
+	publisher *service.ScheduledPublisher
 }
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/ecodeclub/ekit/slice"
@@ -9,24 +11,90 @@ import (
 	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
 	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"golang.org/x/sync/singleflight"
 )
 
+var (
+	ErrVersionConflict = dao.ErrVersionConflict
+	ErrArticleNotFound = dao.ErrArticleNotFound
+)
+
+type VersionConflictError = dao.VersionConflictError
+
 type ArticleRepository interface {
 	Create(ctx context.Context, art domain.Article) (int64, error)
-	Update(ctx context.Context, art domain.Article) error
+	// Update 返回保存之后的版本号
+	Update(ctx context.Context, art domain.Article) (int64, error)
+	// Update 和 Sync 里面 art.Author 是操作的人，可以是有编辑权限的协作者
+	// Sync art.Rendered 要提前渲染好
 	Sync(ctx context.Context, art domain.Article) (int64, error)
 	SyncStatus(ctx context.Context, uid int64, id int64, status domain.ArticleStatus) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthor filter 不为空的时候只返回满足条件的文章
+	GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthorByCursor 按照 (utime, id) 倒序翻页，cursor 是上一页的最后一篇，不走缓存
+	GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, cursor domain.Cursor, limit int) ([]domain.Article, error)
+	// ExportByAuthor 和 GetByAuthorByCursor 一样翻页，不按照标签和分类过滤
+	ExportByAuthor(ctx context.Context, uid int64, cursor domain.Cursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 
-	GetPubById(ctx context.Context, id int64, uid int64) (domain.Article, error)
-	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error)
+	// GetPubById 返回全文，读者的权限由业务层检查
+	GetPubById(ctx context.Context, id int64) (domain.Article, error)
+	// GetPubByIds 先批量查缓存，没有命中的一次查数据库并回写，按照 ids 的顺序返回，没有发表的跳过
+	GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
+	ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error)
+	ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, cursor domain.Cursor, limit int) ([]domain.Article, error)
+
+	Delete(ctx context.Context, uid int64, id int64) error
+	Restore(ctx context.Context, uid int64, id int64) error
+	ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	// Purge 返回真的被删除了的文章 ID，出错的时候也会返回已经删除了的
+	Purge(ctx context.Context, ids []int64, before time.Time) ([]int64, error)
+
+	ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, aid int64, rid int64) (domain.ArticleRevision, error)
+
+	// PublishScheduled render 用来渲染制作库里面的源文件
+	PublishScheduled(ctx context.Context, uid int64, id int64, render func(content string) (domain.RenderedContent, error)) (bool, error)
+	ListScheduled(ctx context.Context, startId int64, limit int) ([]domain.Article, error)
+	CancelScheduled(ctx context.Context, uid int64, id int64) error
+
+	// SubmitForReview 草稿保存成待审核的状态，线上库的文章不受影响
+	SubmitForReview(ctx context.Context, art domain.Article, hits []string) (int64, error)
+	// ApproveReview 和 RejectReview 返回审核之后的文章
+	ApproveReview(ctx context.Context, rid int64, reviewer int64, reason string,
+		render func(content string) (domain.RenderedContent, error)) (domain.Article, error)
+	RejectReview(ctx context.Context, rid int64, reviewer int64, reason string) (domain.Article, error)
+	ListPendingReviews(ctx context.Context, offset int, limit int) ([]domain.ArticleReview, error)
+	GetLatestReview(ctx context.Context, aid int64) (domain.ArticleReview, error)
+
+	CreateSeries(ctx context.Context, s domain.Series) (int64, error)
+	UpdateSeries(ctx context.Context, s domain.Series) error
+	DeleteSeries(ctx context.Context, uid int64, id int64) error
+	// GetSeries onlyPublished 为 true 的时候只返回还在线上的文章
+	GetSeries(ctx context.Context, id int64, onlyPublished bool) (domain.Series, error)
+	// GetSeriesNav 文章不在任何系列里面，或者已经下线了，返回零值
+	GetSeriesNav(ctx context.Context, aid int64) (domain.SeriesNav, error)
+	ListSeriesByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error)
+	AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error
+
+	// UpsertCollaborator uid 是邀请的人
+	UpsertCollaborator(ctx context.Context, uid int64, c domain.Collaborator) error
+	DeleteCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error
+	ListCollaborators(ctx context.Context, uid int64, aid int64) ([]domain.Collaborator, error)
 }
 
+// firstPageSize 作者文章列表缓存的第一页的大小
+const firstPageSize = 100
+
 type CachedArticleRepository struct {
 	dao   dao.ArticleDAO
 	cache cache.ArticleCache
 	l     logger.LoggerV1
+	// group 同一篇文章同时没有命中缓存的时候，只有一个请求去查数据库
+	group singleflight.Group
 }
 
 func NewCachedArticleRepository(dao dao.ArticleDAO,
@@ -46,39 +114,80 @@ func (c *CachedArticleRepository) Create(ctx context.Context, art domain.Article
 		if er != nil {
 			c.l.Error("failed to delete cache", logger.Error(er))
 		}
+		// 创建之前可能有人查过这个 id，缓存了不存在
+		er = c.cache.Del(ctx, id)
+		if er != nil {
+			c.l.Error("failed to delete article cache", logger.Error(er))
+		}
 	}
 	return id, err
 }
 
 func (c *CachedArticleRepository) toEntity(art domain.Article) dao.Article {
+	var publishAt int64
+	if !art.PublishAt.IsZero() {
+		publishAt = art.PublishAt.UnixMilli()
+	}
 	return dao.Article{
-		Id:       art.Id,
-		Title:    art.Title,
-		Content:  art.Content,
-		AuthorId: art.Author.Id,
-		Status:   art.Status.ToUint8(),
+		Id:        art.Id,
+		Title:     art.Title,
+		Content:   art.Content,
+		AuthorId:  art.Author.Id,
+		Status:    art.Status.ToUint8(),
+		Access:    art.Access.ToUint8(),
+		PublishAt: publishAt,
+		Version:   art.Version,
+		Tags:      art.Tags,
+		Category:  art.Category,
 	}
 }
 
-func (c *CachedArticleRepository) Update(ctx context.Context, art domain.Article) error {
-	err := c.dao.UpdateById(ctx, c.toEntity(art))
+func (c *CachedArticleRepository) toPublishedEntity(art domain.Article) dao.PublishedArticle {
+	// 只有基本类型的字段，不会出错
+	toc, _ := json.Marshal(art.Rendered.TOC)
+	return dao.PublishedArticle{
+		Article:  c.toEntity(art),
+		Html:     art.Rendered.HTML,
+		Toc:      string(toc),
+		Abstract: art.Rendered.Abstract,
+	}
+}
+
+func (c *CachedArticleRepository) Update(ctx context.Context, art domain.Article) (int64, error) {
+	owner, version, err := c.dao.UpdateById(ctx, c.toEntity(art))
 	if err == nil {
-		er := c.cache.DelFirstPage(ctx, art.Author.Id)
+		// 协作者修改的，要删的是作者的列表
+		er := c.cache.DelFirstPage(ctx, owner)
 		if er != nil {
 			c.l.Error("failed to delete cache", logger.Error(er))
 		}
+		// 缓存里面的版本号已经过期了，不删掉的话客户端拿到旧版本会一直冲突
+		er = c.cache.Del(ctx, art.Id)
+		if er != nil {
+			c.l.Error("failed to delete article cache", logger.Error(er))
+		}
 	}
-	return err
+	return version, err
 }
 
 func (c *CachedArticleRepository) Sync(ctx context.Context, art domain.Article) (int64, error) {
-	id, err := c.dao.Sync(ctx, c.toEntity(art))
-	if err == nil {
-		er := c.cache.DelFirstPage(ctx, art.Author.Id)
-		if er != nil {
-			c.l.Error("failed to delete cache", logger.Error(er))
-		}
+	id, owner, err := c.dao.Sync(ctx, c.toPublishedEntity(art))
+	if err != nil {
+		return id, err
+	}
+	// 协作者发表的，缓存里面也要是作者的文章
+	art.Id = id
+	art.Author.Id = owner
+	er := c.cache.DelFirstPage(ctx, owner)
+	if er != nil {
+		c.l.Error("failed to delete cache", logger.Error(er))
 	}
+	er = c.cache.Del(ctx, id)
+	if er != nil {
+		c.l.Error("failed to delete article cache", logger.Error(er))
+	}
+	// 下线之后重新发表的文章要重新出现在系列的导航里面
+	c.delArticleSeriesNav(ctx, id)
 	// 在这里尝试，设置缓存
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -88,11 +197,57 @@ func (c *CachedArticleRepository) Sync(ctx context.Context, art domain.Article)
 			c.l.Error("failed to set cache", logger.Error(er))
 		}
 	}()
-	return id, err
+	return id, nil
 }
 
 func (c *CachedArticleRepository) SyncStatus(ctx context.Context, uid int64, id int64, status domain.ArticleStatus) error {
 	err := c.dao.SyncStatus(ctx, uid, id, status.ToUint8())
+	if err == nil {
+		// 下线之后所有实例都不能再返回线上的文章
+		c.delCaches(ctx, uid, id)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) ListScheduled(ctx context.Context, startId int64, limit int) ([]domain.Article, error) {
+	arts, err := c.dao.ListScheduled(ctx, startId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src dao.Article) domain.Article {
+		return c.ToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) PublishScheduled(ctx context.Context, uid int64, id int64,
+	render func(content string) (domain.RenderedContent, error)) (bool, error) {
+	ok, err := c.dao.PublishScheduled(ctx, id, func(art dao.Article) (dao.PublishedArticle, error) {
+		res := c.ToDomain(art)
+		var err error
+		res.Rendered, err = render(art.Content)
+		if err != nil {
+			return dao.PublishedArticle{}, err
+		}
+		return c.toPublishedEntity(res), nil
+	})
+	if err != nil || !ok {
+		return ok, err
+	}
+	er := c.cache.DelFirstPage(ctx, uid)
+	if er != nil {
+		c.l.Error("failed to delete cache", logger.Error(er))
+	}
+	// 可能是定时更新一篇已经发表的文章，旧的缓存要删掉
+	er = c.cache.DelPub(ctx, id)
+	if er != nil {
+		c.l.Error("failed to delete published article cache", logger.Error(er))
+	}
+	c.delArticleSeriesNav(ctx, id)
+	return true, nil
+}
+
+func (c *CachedArticleRepository) CancelScheduled(ctx context.Context, uid int64, id int64) error {
+	err := c.dao.CancelScheduled(ctx, uid, id)
 	if err == nil {
 		er := c.cache.DelFirstPage(ctx, uid)
 		if er != nil {
@@ -102,11 +257,13 @@ func (c *CachedArticleRepository) SyncStatus(ctx context.Context, uid int64, id
 	return err
 }
 
-func (c *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+func (c *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error) {
 	// 首先第一步，判定要不要查询缓存
-	//  limit <= 100 都可以查询缓存
-	if offset == 0 && limit == 100 {
-		//if offset == 0 && limit <= 100 {
+	// 缓存的第一页固定是 100 条，读和写必须是同一个 limit，
+	// 不然 limit 小的结果写进去之后，limit 为 100 的读到的就不完整了
+	// 按照标签或者分类过滤的不走缓存
+	useCache := filter.IsZero() && offset == 0 && limit == firstPageSize
+	if useCache {
 		res, err := c.cache.GetFirstPage(ctx, uid)
 		if err == nil {
 			return res, err
@@ -115,7 +272,7 @@ func (c *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, of
 			// 缓存未命中
 		}
 	}
-	arts, err := c.dao.GetByAuthor(ctx, uid, offset, limit)
+	arts, err := c.dao.GetByAuthor(ctx, uid, filter, offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +283,7 @@ func (c *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, of
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
-		if offset == 0 && limit <= 100 {
+		if useCache {
 			// 缓存回写
 			err = c.cache.SetFirstPage(ctx, uid, res)
 			if err != nil {
@@ -138,69 +295,248 @@ func (c *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, of
 	return res, nil
 }
 
+func (c *CachedArticleRepository) GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, cursor domain.Cursor, limit int) ([]domain.Article, error) {
+	// 第一页也不走缓存，缓存里面只有摘要，而且条数不一定和 limit 一样，
+	// 少了的话调用方会以为已经翻到最后一页了
+	arts, err := c.dao.GetByAuthorByCursor(ctx, uid, filter, cursor.Utime, cursor.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Article, domain.Article](arts, func(idx int, src dao.Article) domain.Article {
+		return c.ToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) ExportByAuthor(ctx context.Context, uid int64, cursor domain.Cursor, limit int) ([]domain.Article, error) {
+	return c.GetByAuthorByCursor(ctx, uid, domain.ArticleFilter{}, cursor, limit)
+}
+
 func (c *CachedArticleRepository) ToDomain(art dao.Article) domain.Article {
-	return domain.Article{
+	res := domain.Article{
 		Id:      art.Id,
 		Title:   art.Title,
 		Content: art.Content,
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
-		Ctime:  time.UnixMilli(art.Ctime),
-		Utime:  time.UnixMilli(art.Utime),
-		Status: domain.ArticleStatus(art.Status),
+		Ctime:    time.UnixMilli(art.Ctime),
+		Utime:    time.UnixMilli(art.Utime),
+		Status:   domain.ArticleStatus(art.Status),
+		Access:   domain.ArticleAccess(art.Access),
+		Tags:     art.Tags,
+		Category: art.Category,
+		Version:  art.Version,
+	}
+	if art.PublishAt > 0 {
+		res.PublishAt = time.UnixMilli(art.PublishAt)
+	}
+	if art.Dtime > 0 {
+		res.Dtime = time.UnixMilli(art.Dtime)
 	}
+	return res
 }
+func (c *CachedArticleRepository) pubToDomain(art dao.PublishedArticle) domain.Article {
+	res := c.ToDomain(art.Article)
+	res.Rendered = domain.RenderedContent{
+		HTML:     art.Html,
+		Abstract: art.Abstract,
+	}
+	if art.Toc != "" {
+		err := json.Unmarshal([]byte(art.Toc), &res.Rendered.TOC)
+		if err != nil {
+			// 目录坏了不影响阅读
+			c.l.Error("failed to unmarshal article toc",
+				logger.Int64("aid", art.Id), logger.Error(err))
+		}
+	}
+	return res
+}
+
 func (c *CachedArticleRepository) GetById(ctx context.Context, id int64) (domain.Article, error) {
 	res, err := c.cache.Get(ctx, id)
-	if err == nil {
+	switch err {
+	case nil:
 		return res, nil
+	case cache.ErrArticleNotFound:
+		return domain.Article{}, ErrArticleNotFound
 	}
-	art, err := c.dao.GetById(ctx, id)
-	if err != nil {
+	return c.load(ctx, fmt.Sprintf("detail:%d", id), func(ctx context.Context) (domain.Article, error) {
+		art, err := c.dao.GetById(ctx, id)
+		switch err {
+		case nil:
+			res := c.ToDomain(art)
+			er := c.cache.Set(ctx, res)
+			if er != nil {
+				c.l.Error("failed to set article cache", logger.Error(er))
+			}
+			return res, nil
+		case dao.ErrArticleNotFound:
+			er := c.cache.SetNotFound(ctx, id)
+			if er != nil {
+				c.l.Error("failed to set article not found cache", logger.Error(er))
+			}
+		}
 		return domain.Article{}, err
+	})
+}
+
+func (c *CachedArticleRepository) GetPubById(ctx context.Context, id int64) (domain.Article, error) {
+	res, err := c.cache.GetPub(ctx, id)
+	switch err {
+	case nil:
+		return res, nil
+	case cache.ErrArticleNotFound:
+		return domain.Article{}, ErrArticleNotFound
 	}
-	res = c.ToDomain(art)
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		defer cancel()
-		er := c.cache.Set(ctx, res)
-		if er != nil {
-			c.l.Error("failed to set article cache", logger.Error(er))
+	return c.load(ctx, fmt.Sprintf("pub:%d", id), func(ctx context.Context) (domain.Article, error) {
+		art, err := c.dao.GetPubById(ctx, id)
+		switch err {
+		case nil:
+			res := c.pubToDomain(art)
+			er := c.cache.SetPub(ctx, res)
+			if er != nil {
+				c.l.Error("failed to set published article cache", logger.Error(er))
+			}
+			return res, nil
+		case dao.ErrArticleNotFound:
+			er := c.cache.SetPubNotFound(ctx, id)
+			if er != nil {
+				c.l.Error("failed to set published article not found cache", logger.Error(er))
+			}
 		}
-	}()
-	return res, nil
+		return domain.Article{}, err
+	})
 }
 
-func (c *CachedArticleRepository) GetPubById(ctx context.Context, id int64, uid int64) (domain.Article, error) {
-	res, err := c.cache.GetPub(ctx, id)
-	if err == nil {
-		return res, err
+func (c *CachedArticleRepository) GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	if len(ids) == 0 {
+		return []domain.Article{}, nil
 	}
-	art, err := c.dao.GetPubById(ctx, id, uid)
+	cached, err := c.cache.GetPubByIds(ctx, ids)
 	if err != nil {
-		return domain.Article{}, err
+		// 缓存出问题了就全部查数据库
+		c.l.Error("failed to get published articles cache", logger.Error(err))
+		cached = map[int64]domain.Article{}
 	}
-	res = c.ToDomain(dao.Article(art))
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		defer cancel()
-		er := c.cache.SetPub(ctx, res)
+	missing := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := cached[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		arts, err := c.dao.GetPubByIds(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		loaded := slice.Map(arts, func(idx int, src dao.PublishedArticle) domain.Article {
+			return c.pubToDomain(src)
+		})
+		for _, art := range loaded {
+			cached[art.Id] = art
+		}
+		er := c.cache.SetPubByIds(ctx, loaded)
 		if er != nil {
-			// 记录日志
-			c.l.Error("failed to set published article cache", logger.Error(er))
+			c.l.Error("failed to set published articles cache", logger.Error(er))
 		}
-	}()
+		// 和 GetPubById 一样，数据库里面也没有的要缓存不存在
+		for _, id := range missing {
+			if _, ok := cached[id]; ok {
+				continue
+			}
+			er = c.cache.SetPubNotFound(ctx, id)
+			if er != nil {
+				c.l.Error("failed to set published article not found cache", logger.Error(er))
+			}
+		}
+	}
+	res := make([]domain.Article, 0, len(ids))
+	for _, id := range ids {
+		// 零值是缓存了不存在
+		if art, ok := cached[id]; ok && art.Id != 0 {
+			res = append(res, art)
+		}
+	}
 	return res, nil
 }
 
-func (c *CachedArticleRepository) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error) {
-	arts, err := c.dao.ListPub(ctx, start, offset, limit)
+// load 缓存没有命中的时候，用 singleflight 合并同时查询同一个 key 的请求
+// 查询用的 ctx 不会因为某一个调用方取消而取消，不然等着的其它请求都会失败
+// 每个调用方依旧只会等到自己的 ctx 结束为止
+func (c *CachedArticleRepository) load(ctx context.Context, key string,
+	fn func(ctx context.Context) (domain.Article, error)) (domain.Article, error) {
+	return share(ctx, &c.group, key, fn)
+}
+
+func share[T any](ctx context.Context, group *singleflight.Group, key string,
+	fn func(ctx context.Context) (T, error)) (T, error) {
+	ch := group.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*3)
+		defer cancel()
+		return fn(ctx)
+	})
+	var zero T
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+func (c *CachedArticleRepository) ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error) {
+	arts, err := c.dao.ListPub(ctx, start, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.PublishedArticle, domain.Article](arts,
+		func(idx int, src dao.PublishedArticle) domain.Article {
+			return c.pubToDomain(src)
+		}), nil
+}
+
+func (c *CachedArticleRepository) ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]domain.ArticleRevision, error) {
+	revs, err := c.dao.ListRevisions(ctx, aid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(revs, func(idx int, src dao.ArticleRevision) domain.ArticleRevision {
+		return c.revisionToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) GetRevision(ctx context.Context, aid int64, rid int64) (domain.ArticleRevision, error) {
+	rev, err := c.dao.GetRevision(ctx, aid, rid)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return c.revisionToDomain(rev), nil
+}
+
+func (c *CachedArticleRepository) revisionToDomain(rev dao.ArticleRevision) domain.ArticleRevision {
+	return domain.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Author: domain.Author{
+			Id: rev.AuthorId,
+		},
+		Title:   rev.Title,
+		Content: rev.Content,
+		Status:  domain.ArticleStatus(rev.Status),
+		Ctime:   time.UnixMilli(rev.Ctime),
+	}
+}
+
+func (c *CachedArticleRepository) ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, cursor domain.Cursor, limit int) ([]domain.Article, error) {
+	arts, err := c.dao.ListPubByCursor(ctx, start, filter, cursor.Utime, cursor.Id, limit)
 	if err != nil {
 		return nil, err
 	}
 	return slice.Map[dao.PublishedArticle, domain.Article](arts,
 		func(idx int, src dao.PublishedArticle) domain.Article {
-			return c.ToDomain(dao.Article(src))
+			return c.pubToDomain(src)
 		}), nil
 }
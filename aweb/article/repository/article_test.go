@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB 每个测试用一个单独的 SQLite 文件，互相不影响
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "article.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, dao.InitTables(db))
+	return db
+}
+
+// fakePubCache 零值表示缓存了不存在，和 Redis 的实现一样
+type fakePubCache struct {
+	cache.ArticleCache
+	pubs map[int64]domain.Article
+}
+
+func (f *fakePubCache) GetPubByIds(ctx context.Context, ids []int64) (map[int64]domain.Article, error) {
+	res := make(map[int64]domain.Article, len(ids))
+	for _, id := range ids {
+		if art, ok := f.pubs[id]; ok {
+			res[id] = art
+		}
+	}
+	return res, nil
+}
+
+func (f *fakePubCache) SetPubByIds(ctx context.Context, arts []domain.Article) error {
+	for _, art := range arts {
+		f.pubs[art.Id] = art
+	}
+	return nil
+}
+
+func (f *fakePubCache) SetPubNotFound(ctx context.Context, id int64) error {
+	f.pubs[id] = domain.Article{}
+	return nil
+}
+
+// batchDAO 记录每次批量查询数据库的 id
+type batchDAO struct {
+	dao.ArticleDAO
+	queries [][]int64
+}
+
+func (b *batchDAO) GetPubByIds(ctx context.Context, ids []int64) ([]dao.PublishedArticle, error) {
+	b.queries = append(b.queries, ids)
+	return b.ArticleDAO.GetPubByIds(ctx, ids)
+}
+
+func TestCachedArticleRepository_GetPubByIds(t *testing.T) {
+	db := newTestDB(t)
+	d := &batchDAO{ArticleDAO: dao.NewArticleGORMDAO(db)}
+	c := &fakePubCache{pubs: map[int64]domain.Article{}}
+	repo := NewCachedArticleRepository(d, c, logger.NewNopLogger())
+	ctx := context.Background()
+
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		id, _, err := d.Sync(ctx, dao.PublishedArticle{Article: dao.Article{
+			Title: "标题", Content: "内容", AuthorId: 1,
+			Status: domain.ArticleStatusPublished.ToUint8(),
+		}})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	// 第一篇已经在缓存里面了
+	c.pubs[ids[0]] = domain.Article{Id: ids[0], Title: "缓存"}
+	const missing = int64(12345)
+
+	arts, err := repo.GetPubByIds(ctx, []int64{ids[2], missing, ids[0], ids[1]})
+	require.NoError(t, err)
+	got := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		got = append(got, art.Id)
+	}
+	// 按照传进来的顺序，不存在的跳过
+	assert.Equal(t, []int64{ids[2], ids[0], ids[1]}, got)
+	assert.Equal(t, "缓存", arts[1].Title)
+	// 没有命中的一次查完
+	assert.Equal(t, [][]int64{{ids[2], missing, ids[1]}}, d.queries)
+
+	// 回写之后，包括不存在的那篇，都不需要再查数据库
+	arts, err = repo.GetPubByIds(ctx, []int64{missing, ids[1], ids[2]})
+	require.NoError(t, err)
+	assert.Len(t, arts, 2)
+	assert.Len(t, d.queries, 1)
+}
+
+// fakeFirstPageCache 第一页缓存里面只有一篇摘要
+type fakeFirstPageCache struct {
+	cache.ArticleCache
+	page []domain.Article
+	sets atomic.Int64
+}
+
+func (f *fakeFirstPageCache) GetFirstPage(ctx context.Context, uid int64) ([]domain.Article, error) {
+	if f.page == nil {
+		return nil, cache.ErrKeyNotExist
+	}
+	return f.page, nil
+}
+
+func (f *fakeFirstPageCache) SetFirstPage(ctx context.Context, uid int64, arts []domain.Article) error {
+	f.sets.Add(1)
+	return nil
+}
+
+func TestCachedArticleRepository_FirstPage(t *testing.T) {
+	db := newTestDB(t)
+	d := dao.NewArticleGORMDAO(db)
+	c := &fakeFirstPageCache{}
+	repo := NewCachedArticleRepository(d, c, logger.NewNopLogger())
+	ctx := context.Background()
+
+	const uid = int64(1)
+	for i := 0; i < 3; i++ {
+		_, err := d.Insert(ctx, dao.Article{Title: "标题", Content: "全文", AuthorId: uid})
+		require.NoError(t, err)
+	}
+
+	// limit 不是缓存的大小，不能回写，不然 limit 为 100 的时候读到的不完整
+	arts, err := repo.GetByAuthor(ctx, uid, domain.ArticleFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Len(t, arts, 2)
+	assert.Never(t, func() bool { return c.sets.Load() > 0 }, time.Millisecond*50, time.Millisecond*5)
+	_, err = repo.GetByAuthor(ctx, uid, domain.ArticleFilter{}, 0, firstPageSize)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return c.sets.Load() == 1 }, time.Second, time.Millisecond*5)
+
+	// 游标的第一页不读缓存
+	c.page = []domain.Article{{Id: 1, Content: "摘要"}}
+	arts, err = repo.GetByAuthorByCursor(ctx, uid, domain.ArticleFilter{}, domain.Cursor{}, firstPageSize)
+	require.NoError(t, err)
+	require.Len(t, arts, 3)
+	for _, art := range arts {
+		assert.Equal(t, "全文", art.Content)
+	}
+}
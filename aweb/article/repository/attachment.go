@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+)
+
+var ErrAttachmentNotFound = dao.ErrAttachmentNotFound
+
+type AttachmentRepository interface {
+	// Create 内容已经存在的话返回已有的附件，created 为 false
+	Create(ctx context.Context, a domain.Attachment) (res domain.Attachment, created bool, err error)
+	FindByHash(ctx context.Context, hash string) (domain.Attachment, error)
+	Touch(ctx context.Context, id int64) error
+	FindById(ctx context.Context, id int64) (domain.Attachment, error)
+	FindByIds(ctx context.Context, ids []int64) ([]domain.Attachment, error)
+	ListOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Attachment, error)
+	DeleteOrphan(ctx context.Context, id int64, before time.Time) (bool, error)
+}
+
+type attachmentRepository struct {
+	dao dao.AttachmentDAO
+}
+
+func NewAttachmentRepository(dao dao.AttachmentDAO) AttachmentRepository {
+	return &attachmentRepository{dao: dao}
+}
+
+func (r *attachmentRepository) Create(ctx context.Context, a domain.Attachment) (domain.Attachment, bool, error) {
+	res, created, err := r.dao.Insert(ctx, r.toEntity(a))
+	return r.toDomain(res), created, err
+}
+
+func (r *attachmentRepository) FindByHash(ctx context.Context, hash string) (domain.Attachment, error) {
+	res, err := r.dao.FindByHash(ctx, hash)
+	return r.toDomain(res), err
+}
+
+func (r *attachmentRepository) Touch(ctx context.Context, id int64) error {
+	return r.dao.Touch(ctx, id)
+}
+
+func (r *attachmentRepository) FindById(ctx context.Context, id int64) (domain.Attachment, error) {
+	res, err := r.dao.FindById(ctx, id)
+	return r.toDomain(res), err
+}
+
+func (r *attachmentRepository) FindByIds(ctx context.Context, ids []int64) ([]domain.Attachment, error) {
+	res, err := r.dao.FindByIds(ctx, ids)
+	return slice.Map(res, func(idx int, src dao.Attachment) domain.Attachment {
+		return r.toDomain(src)
+	}), err
+}
+
+func (r *attachmentRepository) ListOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Attachment, error) {
+	res, err := r.dao.ListOrphans(ctx, before, limit)
+	return slice.Map(res, func(idx int, src dao.Attachment) domain.Attachment {
+		return r.toDomain(src)
+	}), err
+}
+
+func (r *attachmentRepository) DeleteOrphan(ctx context.Context, id int64, before time.Time) (bool, error) {
+	return r.dao.DeleteOrphan(ctx, id, before)
+}
+
+func (r *attachmentRepository) toEntity(a domain.Attachment) dao.Attachment {
+	return dao.Attachment{
+		Id:          a.Id,
+		Uid:         a.Uid,
+		Hash:        a.Hash,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Width:       a.Width,
+		Height:      a.Height,
+		StorageKey:  a.Key,
+		ThumbKey:    a.ThumbKey,
+	}
+}
+
+// toDomain URL 和存储相关，由 service 填充
+func (r *attachmentRepository) toDomain(a dao.Attachment) domain.Attachment {
+	return domain.Attachment{
+		Id:          a.Id,
+		Uid:         a.Uid,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Hash:        a.Hash,
+		Key:         a.StorageKey,
+		Width:       a.Width,
+		Height:      a.Height,
+		ThumbKey:    a.ThumbKey,
+		Ctime:       time.UnixMilli(a.Ctime),
+		Utime:       time.UnixMilli(a.Utime),
+	}
+}
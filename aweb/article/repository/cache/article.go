@@ -3,22 +3,56 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	"github.com/pluckhuang/goweb/aweb/article/domain"
 	"github.com/redis/go-redis/v9"
 )
 
+var (
+	ErrKeyNotExist = redis.Nil
+	// ErrArticleNotFound 缓存了文章不存在，不需要再去查数据库
+	ErrArticleNotFound = errors.New("文章不存在")
+)
+
+const (
+	detailExpiration = time.Minute * 10
+	// notFoundExpiration 不存在的文章缓存得短一点，刚创建的文章很快就能被查到
+	notFoundExpiration = time.Minute
+	// notFoundVal 缓存不存在的文章用的占位符，不可能是合法的 JSON 对象
+	notFoundVal = "-"
+)
+
 type ArticleCache interface {
 	GetFirstPage(ctx context.Context, uid int64) ([]domain.Article, error)
 	SetFirstPage(ctx context.Context, uid int64, res []domain.Article) error
 	DelFirstPage(ctx context.Context, uid int64) error
+	// Get 缓存了文章不存在的话返回 ErrArticleNotFound
 	Get(ctx context.Context, id int64) (domain.Article, error)
 	Set(ctx context.Context, art domain.Article) error
+	// SetNotFound 缓存文章不存在，防止不存在的 id 一直打到数据库上
+	SetNotFound(ctx context.Context, id int64) error
+	// Del 也会删除缓存的不存在
+	Del(ctx context.Context, id int64) error
+	// GetPub 缓存的是全文，读者的权限由业务层检查
+	// 缓存了文章不存在的话返回 ErrArticleNotFound
 	GetPub(ctx context.Context, id int64) (domain.Article, error)
+	// SetPub 会覆盖掉缓存的不存在
 	SetPub(ctx context.Context, res domain.Article) error
+	SetPubNotFound(ctx context.Context, id int64) error
 	DelPub(ctx context.Context, id int64) error
+	// GetPubByIds 一次 MGET 查多篇，缓存里面没有的不在返回的 map 里面
+	// 缓存了文章不存在的，对应的是零值
+	GetPubByIds(ctx context.Context, ids []int64) (map[int64]domain.Article, error)
+	// SetPubByIds 一次 pipeline 写多篇，用来回填没有命中的文章
+	SetPubByIds(ctx context.Context, arts []domain.Article) error
+	// GetSeriesNav 文章不在系列里面也会缓存，这个时候是零值
+	GetSeriesNav(ctx context.Context, aid int64) (domain.SeriesNav, error)
+	SetSeriesNav(ctx context.Context, aid int64, nav domain.SeriesNav) error
+	DelSeriesNav(ctx context.Context, aids ...int64) error
 }
 
 type ArticleRedisCache struct {
@@ -47,15 +81,18 @@ func (a *ArticleRedisCache) firstKey(uid int64) string {
 }
 
 func (a *ArticleRedisCache) SetFirstPage(ctx context.Context, uid int64, arts []domain.Article) error {
+	// 调用方还在用 arts，不能直接改
+	page := make([]domain.Article, len(arts))
 	for i := 0; i < len(arts); i++ {
-		arts[i].Content = arts[i].Abstract()
+		page[i] = arts[i]
+		page[i].Content = arts[i].Abstract()
 	}
 	key := a.firstKey(uid)
-	val, err := json.Marshal(arts)
+	val, err := json.Marshal(page)
 	if err != nil {
 		return err
 	}
-	return a.client.Set(ctx, key, val, time.Minute*10).Err()
+	return a.client.Set(ctx, key, val, jitter(detailExpiration)).Err()
 }
 
 func (a *ArticleRedisCache) DelFirstPage(ctx context.Context, uid int64) error {
@@ -67,6 +104,9 @@ func (a *ArticleRedisCache) Get(ctx context.Context, id int64) (domain.Article,
 	if err != nil {
 		return domain.Article{}, err
 	}
+	if string(val) == notFoundVal {
+		return domain.Article{}, ErrArticleNotFound
+	}
 	var res domain.Article
 	err = json.Unmarshal(val, &res)
 	return res, err
@@ -81,7 +121,15 @@ func (a *ArticleRedisCache) Set(ctx context.Context, art domain.Article) error {
 	if err != nil {
 		return err
 	}
-	return a.client.Set(ctx, a.key(art.Id), val, time.Minute*10).Err()
+	return a.client.Set(ctx, a.key(art.Id), val, jitter(detailExpiration)).Err()
+}
+
+func (a *ArticleRedisCache) SetNotFound(ctx context.Context, id int64) error {
+	return a.client.Set(ctx, a.key(id), notFoundVal, jitter(notFoundExpiration)).Err()
+}
+
+func (a *ArticleRedisCache) Del(ctx context.Context, id int64) error {
+	return a.client.Del(ctx, a.key(id)).Err()
 }
 
 func (a *ArticleRedisCache) GetPub(ctx context.Context, id int64) (domain.Article, error) {
@@ -89,6 +137,9 @@ func (a *ArticleRedisCache) GetPub(ctx context.Context, id int64) (domain.Articl
 	if err != nil {
 		return domain.Article{}, err
 	}
+	if string(val) == notFoundVal {
+		return domain.Article{}, ErrArticleNotFound
+	}
 	var res domain.Article
 	err = json.Unmarshal(val, &res)
 	return res, err
@@ -103,9 +154,99 @@ func (a *ArticleRedisCache) SetPub(ctx context.Context, art domain.Article) erro
 	if err != nil {
 		return err
 	}
-	return a.client.Set(ctx, a.pubKey(art.Id), val, time.Minute*10).Err()
+	return a.client.Set(ctx, a.pubKey(art.Id), val, jitter(detailExpiration)).Err()
+}
+
+func (a *ArticleRedisCache) SetPubNotFound(ctx context.Context, id int64) error {
+	return a.client.Set(ctx, a.pubKey(id), notFoundVal, jitter(notFoundExpiration)).Err()
 }
 
 func (a *ArticleRedisCache) DelPub(ctx context.Context, id int64) error {
 	return a.client.Del(ctx, a.pubKey(id)).Err()
 }
+
+func (a *ArticleRedisCache) GetPubByIds(ctx context.Context, ids []int64) (map[int64]domain.Article, error) {
+	res := make(map[int64]domain.Article, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, a.pubKey(id))
+	}
+	vals, err := a.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, val := range vals {
+		str, ok := val.(string)
+		if !ok {
+			continue
+		}
+		if str == notFoundVal {
+			res[ids[i]] = domain.Article{}
+			continue
+		}
+		var art domain.Article
+		// 坏掉的缓存当作没有命中，让上层重新查数据库
+		if json.Unmarshal([]byte(str), &art) == nil {
+			res[ids[i]] = art
+		}
+	}
+	return res, nil
+}
+
+func (a *ArticleRedisCache) SetPubByIds(ctx context.Context, arts []domain.Article) error {
+	if len(arts) == 0 {
+		return nil
+	}
+	pipe := a.client.Pipeline()
+	for _, art := range arts {
+		val, err := json.Marshal(art)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, a.pubKey(art.Id), val, jitter(detailExpiration))
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (a *ArticleRedisCache) GetSeriesNav(ctx context.Context, aid int64) (domain.SeriesNav, error) {
+	val, err := a.client.Get(ctx, a.seriesNavKey(aid)).Bytes()
+	if err != nil {
+		return domain.SeriesNav{}, err
+	}
+	var res domain.SeriesNav
+	err = json.Unmarshal(val, &res)
+	return res, err
+}
+
+func (a *ArticleRedisCache) seriesNavKey(aid int64) string {
+	return fmt.Sprintf("article:series_nav:%d", aid)
+}
+
+func (a *ArticleRedisCache) SetSeriesNav(ctx context.Context, aid int64, nav domain.SeriesNav) error {
+	val, err := json.Marshal(nav)
+	if err != nil {
+		return err
+	}
+	return a.client.Set(ctx, a.seriesNavKey(aid), val, jitter(detailExpiration)).Err()
+}
+
+func (a *ArticleRedisCache) DelSeriesNav(ctx context.Context, aids ...int64) error {
+	if len(aids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(aids))
+	for _, aid := range aids {
+		keys = append(keys, a.seriesNavKey(aid))
+	}
+	return a.client.Del(ctx, keys...).Err()
+}
+
+// jitter 在过期时间上加上最多 20% 的随机值，
+// 防止同一批写进去的缓存在同一时刻过期，一起打到数据库上
+func jitter(expiration time.Duration) time.Duration {
+	return expiration + rand.N(expiration/5+1)
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type HotArticleCacheConfig struct {
+	// Capacity 本地最多缓存多少篇文章
+	Capacity int `yaml:"capacity"`
+	// Expiration 本地缓存没办法被别的实例删除，所以过期时间要短
+	Expiration time.Duration `yaml:"expiration"`
+	// Threshold 在 Redis 里面命中多少次之后才放进本地，只有热门的文章才值得占用内存
+	Threshold int64 `yaml:"threshold"`
+}
+
+// HotArticleCache 在 Redis 前面加一层进程内的缓存，只缓存读得最多的线上文章
+// 其余的方法直接交给 Redis
+// 线上的文章变了之后，通过 bus 通知所有实例删除本地缓存
+type HotArticleCache struct {
+	ArticleCache
+	bus   cachex.InvalidationBus
+	local *lru.Cache
+	// hits 还没有进入本地缓存的文章在 Redis 里面命中的次数，也用 LRU 限制大小
+	hits   *lru.Cache
+	cfg    HotArticleCacheConfig
+	vector *prometheus.CounterVec
+}
+
+type hotItem struct {
+	art    domain.Article
+	expire time.Time
+}
+
+func NewHotArticleCache(remote ArticleCache, bus cachex.InvalidationBus,
+	cfg HotArticleCacheConfig, opts prometheus.CounterOpts) (*HotArticleCache, error) {
+	local, err := lru.New(cfg.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	// 只统计最近读过的那些文章的次数，太久没读的会被淘汰，也就不算热门了
+	hits, err := lru.New(cfg.Capacity * 4)
+	if err != nil {
+		return nil, err
+	}
+	vector := prometheus.NewCounterVec(opts, []string{"tier", "result"})
+	err = prometheus.Register(vector)
+	if err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return nil, err
+		}
+		vector = are.ExistingCollector.(*prometheus.CounterVec)
+	}
+	res := &HotArticleCache{
+		ArticleCache: remote,
+		bus:          bus,
+		local:        local,
+		hits:         hits,
+		cfg:          cfg,
+		vector:       vector,
+	}
+	bus.Subscribe(hotKeyPrefix, res)
+	return res, nil
+}
+
+// hotKeyPrefix 在 bus 上面的 key，后面跟着文章 ID
+const hotKeyPrefix = "article:pub:"
+
+func (h *HotArticleCache) GetPub(ctx context.Context, id int64) (domain.Article, error) {
+	if val, ok := h.local.Get(id); ok {
+		itm := val.(hotItem)
+		if time.Now().Before(itm.expire) {
+			h.observe("local", "hit")
+			return itm.art, nil
+		}
+		h.local.Remove(id)
+	}
+	h.observe("local", "miss")
+	art, err := h.ArticleCache.GetPub(ctx, id)
+	switch err {
+	case nil:
+		h.observe("redis", "hit")
+		h.promote(art)
+	case ErrArticleNotFound:
+		h.observe("redis", "not_found")
+	default:
+		h.observe("redis", "miss")
+	}
+	return art, err
+}
+
+// GetPubByIds 本地缓存里面没有的才去 Redis 查
+func (h *HotArticleCache) GetPubByIds(ctx context.Context, ids []int64) (map[int64]domain.Article, error) {
+	res := make(map[int64]domain.Article, len(ids))
+	missing := make([]int64, 0, len(ids))
+	now := time.Now()
+	for _, id := range ids {
+		if val, ok := h.local.Get(id); ok {
+			itm := val.(hotItem)
+			if now.Before(itm.expire) {
+				h.observe("local", "hit")
+				res[id] = itm.art
+				continue
+			}
+			h.local.Remove(id)
+		}
+		h.observe("local", "miss")
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return res, nil
+	}
+	remote, err := h.ArticleCache.GetPubByIds(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range missing {
+		art, ok := remote[id]
+		switch {
+		case !ok:
+			h.observe("redis", "miss")
+			continue
+		case art.Id == 0:
+			h.observe("redis", "not_found")
+		default:
+			h.observe("redis", "hit")
+			h.promote(art)
+		}
+		res[id] = art
+	}
+	return res, nil
+}
+
+// promote 命中的次数够了就放进本地缓存
+func (h *HotArticleCache) promote(art domain.Article) {
+	counter := new(atomic.Int64)
+	if prev, ok, _ := h.hits.PeekOrAdd(art.Id, counter); ok {
+		counter = prev.(*atomic.Int64)
+	}
+	cnt := counter.Add(1)
+	if cnt < h.cfg.Threshold {
+		return
+	}
+	h.hits.Remove(art.Id)
+	h.local.Add(art.Id, hotItem{
+		art:    art,
+		expire: time.Now().Add(h.cfg.Expiration),
+	})
+}
+
+func (h *HotArticleCache) SetPub(ctx context.Context, art domain.Article) error {
+	// 本地的已经是旧的了，等它再次变热的时候从 Redis 里面拿新的
+	err := h.ArticleCache.SetPub(ctx, art)
+	if err != nil {
+		return err
+	}
+	return h.invalidate(ctx, art.Id)
+}
+
+// SetPubByIds 只用来回填从数据库里面查出来的文章，内容没有变，不需要通知别的实例
+func (h *HotArticleCache) SetPubByIds(ctx context.Context, arts []domain.Article) error {
+	err := h.ArticleCache.SetPubByIds(ctx, arts)
+	if err != nil {
+		return err
+	}
+	for _, art := range arts {
+		h.local.Remove(art.Id)
+	}
+	return nil
+}
+
+func (h *HotArticleCache) DelPub(ctx context.Context, id int64) error {
+	err := h.ArticleCache.DelPub(ctx, id)
+	if err != nil {
+		return err
+	}
+	return h.invalidate(ctx, id)
+}
+
+// invalidate 先删自己的，再通知别的实例
+// 必须在 Redis 更新之后，不然别的实例可能又从 Redis 里面读到旧的数据
+func (h *HotArticleCache) invalidate(ctx context.Context, id int64) error {
+	h.local.Remove(id)
+	return h.bus.Publish(ctx, hotKeyPrefix+strconv.FormatInt(id, 10))
+}
+
+func (h *HotArticleCache) Evict(key string) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(key, hotKeyPrefix), 10, 64)
+	if err != nil {
+		return
+	}
+	h.local.Remove(id)
+}
+
+func (h *HotArticleCache) EvictAll() {
+	h.local.Purge()
+}
+
+func (h *HotArticleCache) observe(tier, result string) {
+	h.vector.WithLabelValues(tier, result).Inc()
+}
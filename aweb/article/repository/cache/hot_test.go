@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRemote 只实现了 GetPub 相关的方法，记录从 Redis 读了多少篇
+type fakeRemote struct {
+	ArticleCache
+	arts  map[int64]domain.Article
+	calls int
+}
+
+func (f *fakeRemote) GetPub(ctx context.Context, id int64) (domain.Article, error) {
+	f.calls++
+	art, ok := f.arts[id]
+	if !ok {
+		return domain.Article{}, ErrKeyNotExist
+	}
+	return art, nil
+}
+
+func (f *fakeRemote) GetPubByIds(ctx context.Context, ids []int64) (map[int64]domain.Article, error) {
+	res := make(map[int64]domain.Article, len(ids))
+	for _, id := range ids {
+		f.calls++
+		if art, ok := f.arts[id]; ok {
+			res[id] = art
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRemote) SetPub(ctx context.Context, art domain.Article) error {
+	f.arts[art.Id] = art
+	return nil
+}
+
+func (f *fakeRemote) DelPub(ctx context.Context, id int64) error {
+	delete(f.arts, id)
+	return nil
+}
+
+// newTestHotCache 每个用例用不同的指标名字，不然计数会混在一起
+func newTestHotCache(t *testing.T, name string, cfg HotArticleCacheConfig) (*HotArticleCache, *fakeRemote) {
+	remote := &fakeRemote{arts: map[int64]domain.Article{
+		1: {Id: 1, Title: "热门"},
+	}}
+	h, err := NewHotArticleCache(remote, cachex.NewLocalInvalidationBus(), cfg, prometheus.CounterOpts{
+		Namespace: "test",
+		Name:      name,
+	})
+	require.NoError(t, err)
+	return h, remote
+}
+
+func TestHotArticleCache_GetPub(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  HotArticleCacheConfig
+		// 读几次
+		reads     int
+		wantCalls int
+	}{
+		{
+			name:      "达到阈值之后走本地缓存",
+			cfg:       HotArticleCacheConfig{Capacity: 10, Expiration: time.Minute, Threshold: 2},
+			reads:     5,
+			wantCalls: 2,
+		},
+		{
+			name:      "没有达到阈值",
+			cfg:       HotArticleCacheConfig{Capacity: 10, Expiration: time.Minute, Threshold: 10},
+			reads:     5,
+			wantCalls: 5,
+		},
+		{
+			name:      "本地缓存过期了",
+			cfg:       HotArticleCacheConfig{Capacity: 10, Expiration: -time.Second, Threshold: 1},
+			reads:     3,
+			wantCalls: 3,
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, remote := newTestHotCache(t, fmt.Sprintf("hot_article_cache_%d", i), tc.cfg)
+			for i := 0; i < tc.reads; i++ {
+				art, err := h.GetPub(context.Background(), 1)
+				require.NoError(t, err)
+				assert.Equal(t, "热门", art.Title)
+			}
+			assert.Equal(t, tc.wantCalls, remote.calls)
+			assert.Equal(t, float64(tc.reads-tc.wantCalls),
+				testutil.ToFloat64(h.vector.WithLabelValues("local", "hit")))
+		})
+	}
+}
+
+func TestHotArticleCache_GetPubByIds(t *testing.T) {
+	h, remote := newTestHotCache(t, "hot_article_cache_batch", HotArticleCacheConfig{
+		Capacity: 10, Expiration: time.Minute, Threshold: 1,
+	})
+	ctx := context.Background()
+	res, err := h.GetPubByIds(ctx, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]domain.Article{1: {Id: 1, Title: "热门"}}, res)
+	assert.Equal(t, 2, remote.calls)
+
+	// 1 已经进了本地缓存，只有 2 还要去 Redis
+	res, err = h.GetPubByIds(ctx, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, 3, remote.calls)
+}
+
+func TestHotArticleCache_Invalidate(t *testing.T) {
+	h, remote := newTestHotCache(t, "hot_article_cache_invalidate", HotArticleCacheConfig{
+		Capacity: 10, Expiration: time.Minute, Threshold: 1,
+	})
+	ctx := context.Background()
+	_, err := h.GetPub(ctx, 1)
+	require.NoError(t, err)
+
+	// 修改之后本地的旧数据要被清掉
+	require.NoError(t, h.SetPub(ctx, domain.Article{Id: 1, Title: "修改了"}))
+	art, err := h.GetPub(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "修改了", art.Title)
+
+	require.NoError(t, h.DelPub(ctx, 1))
+	_, err = h.GetPub(ctx, 1)
+	assert.Equal(t, ErrKeyNotExist, err)
+	// 每次都穿透到了 Redis
+	assert.Equal(t, 3, remote.calls)
+}
+
+func TestHotArticleCache_CrossInstance(t *testing.T) {
+	// 两个实例共用一个 Redis 和一条总线
+	bus := cachex.NewLocalInvalidationBus()
+	remote := &fakeRemote{arts: map[int64]domain.Article{
+		1: {Id: 1, Title: "旧的"},
+	}}
+	cfg := HotArticleCacheConfig{Capacity: 10, Expiration: time.Minute, Threshold: 1}
+	opts := prometheus.CounterOpts{Namespace: "test", Name: "hot_article_cache_cross_instance"}
+	a, err := NewHotArticleCache(remote, bus, cfg, opts)
+	require.NoError(t, err)
+	b, err := NewHotArticleCache(remote, bus, cfg, opts)
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = b.GetPub(ctx, 1)
+	require.NoError(t, err)
+
+	// 在 a 上面下线，b 的本地缓存也要失效
+	require.NoError(t, a.DelPub(ctx, 1))
+	_, err = b.GetPub(ctx, 1)
+	assert.Equal(t, ErrKeyNotExist, err)
+
+	require.NoError(t, a.SetPub(ctx, domain.Article{Id: 1, Title: "新的"}))
+	art, err := b.GetPub(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "新的", art.Title)
+	b.EvictAll()
+	assert.Zero(t, b.local.Len())
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+)
+
+var (
+	ErrPermissionDenied    = dao.ErrPermissionDenied
+	ErrInvalidCollaborator = dao.ErrInvalidCollaborator
+)
+
+func (c *CachedArticleRepository) UpsertCollaborator(ctx context.Context, uid int64, collaborator domain.Collaborator) error {
+	return c.dao.UpsertCollaborator(ctx, uid, dao.ArticleCollaborator{
+		ArticleId: collaborator.ArticleId,
+		Uid:       collaborator.Uid,
+		Role:      collaborator.Role.ToUint8(),
+	})
+}
+
+func (c *CachedArticleRepository) DeleteCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error {
+	return c.dao.DeleteCollaborator(ctx, uid, aid, collaborator)
+}
+
+func (c *CachedArticleRepository) ListCollaborators(ctx context.Context, uid int64, aid int64) ([]domain.Collaborator, error) {
+	res, err := c.dao.ListCollaborators(ctx, uid, aid)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.ArticleCollaborator) domain.Collaborator {
+		return domain.Collaborator{
+			ArticleId: src.ArticleId,
+			Uid:       src.Uid,
+			Role:      domain.CollaboratorRole(src.Role),
+			Ctime:     time.UnixMilli(src.Ctime),
+			Utime:     time.UnixMilli(src.Utime),
+		}
+	}), nil
+}
@@ -3,26 +3,117 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/pluckhuang/goweb/aweb/article/domain"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+// ErrVersionConflict 草稿已经在别的地方被修改过了
+var ErrVersionConflict = errors.New("文章版本冲突")
+
+// ErrArticleNotFound 线上库里面没有发表的文章也是这个错误，读者不需要区分
+var ErrArticleNotFound = errors.New("文章不存在")
+
+// VersionConflictError 带上服务端当前的版本号，方便客户端合并
+type VersionConflictError struct {
+	Current int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("文章版本冲突，当前版本 %d", e.Current)
+}
+
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
 type ArticleDAO interface {
 	Insert(ctx context.Context, art Article) (int64, error)
-	UpdateById(ctx context.Context, art Article) error
-	Sync(ctx context.Context, art Article) (int64, error)
+	// UpdateById art.AuthorId 是修改的人，要有编辑权限，没有的话返回 ErrPermissionDenied
+	// art.Version 大于 0 的时候会检查版本，不一致返回 *VersionConflictError
+	// 返回文章真正的作者，协作者修改的时候和 art.AuthorId 不一样，以及保存之后的版本号
+	UpdateById(ctx context.Context, art Article) (authorId int64, version int64, err error)
+	// Sync 保存制作库，并且把文章连同渲染结果同步到线上库
+	// 和 UpdateById 一样，art.AuthorId 是发表的人，返回的是文章真正的作者
+	Sync(ctx context.Context, art PublishedArticle) (id int64, authorId int64, err error)
+	// SyncStatus 只有作者本人能修改文章的状态
 	SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error)
+	// GetByAuthor 按照 filter 过滤，条件为空的时候返回作者所有的文章
+	GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]Article, error)
+	// GetByAuthorByCursor 按照 (utime, id) 倒序，返回排在 (utime, id) 后面的文章
+	// utime 和 id 都是 0 的时候从头开始
+	GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]Article, error)
 	GetById(ctx context.Context, id int64) (Article, error)
-	GetPubById(ctx context.Context, id int64, uid int64) (PublishedArticle, error)
-	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error)
+	// GetPubById 不检查读者的权限，没有发表的文章返回 ErrArticleNotFound
+	GetPubById(ctx context.Context, id int64) (PublishedArticle, error)
+	// GetPubByIds 一次查多篇，没有发表的和不存在的不在结果里面，不保证顺序
+	GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error)
+	ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset int, limit int) ([]PublishedArticle, error)
+	ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]PublishedArticle, error)
+	// Delete 把文章放进回收站，线上库的文章也会跟着下线
+	Delete(ctx context.Context, uid int64, id int64) error
+	// Restore 从回收站恢复成草稿，发表过的文章恢复成仅自己可见，要重新发表
+	Restore(ctx context.Context, uid int64, id int64) error
+	ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]Article, error)
+	// ListExpiredTrash 找出在 before 之前放进回收站的文章
+	ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]Article, error)
+	// Purge 彻底删除 ids 里面在 before 之前放进回收站的文章，返回真的被删除的
+	Purge(ctx context.Context, ids []int64, before time.Time) ([]Article, error)
+	ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error)
+	GetRevision(ctx context.Context, aid int64, rid int64) (ArticleRevision, error)
+	// PublishScheduled 发表已经到期的定时文章，返回是否真的发表了
+	// render 用制作库里面的文章生成线上库的文章，返回 error 的话整个发表都会回滚
+	PublishScheduled(ctx context.Context, id int64, render func(art Article) (PublishedArticle, error)) (bool, error)
+	// ListScheduled 按照 id 从小到大找出 id 大于 startId 的定时文章
+	ListScheduled(ctx context.Context, startId int64, limit int) ([]Article, error)
+	CancelScheduled(ctx context.Context, uid int64, id int64) error
+
+	// SubmitForReview 保存草稿并且提交审核，线上库的文章不受影响
+	// 和 Sync 一样，art.AuthorId 是操作的人，返回的是文章真正的作者
+	SubmitForReview(ctx context.Context, art Article, hits []string) (id int64, authorId int64, err error)
+	// ApproveReview publish_at 在未来的文章变成定时状态，否则用 render 渲染之后立刻发表
+	// 返回审核之后的文章，文章在审核之前被修改过的话返回 ErrReviewCanceled
+	ApproveReview(ctx context.Context, rid int64, reviewer int64, reason string,
+		render func(art Article) (PublishedArticle, error)) (Article, error)
+	RejectReview(ctx context.Context, rid int64, reviewer int64, reason string) (Article, error)
+	// ListPendingReviews 按照提交的先后顺序
+	ListPendingReviews(ctx context.Context, offset int, limit int) ([]ArticleReview, error)
+	// GetLatestReview 没有审核过返回 ErrReviewNotFound
+	GetLatestReview(ctx context.Context, aid int64) (ArticleReview, error)
+
+	// UpsertCollaborator uid 是邀请的人，必须是作者本人，已经是协作者的会修改角色
+	UpsertCollaborator(ctx context.Context, uid int64, c ArticleCollaborator) error
+	// DeleteCollaborator 作者可以移除任何协作者，协作者可以自己退出
+	DeleteCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error
+	// ListCollaborators 作者和协作者都可以查看
+	ListCollaborators(ctx context.Context, uid int64, aid int64) ([]ArticleCollaborator, error)
+
+	// InsertSeries s.ArticleIds 里面的文章必须是作者已经发表的
+	InsertSeries(ctx context.Context, s Series) (int64, error)
+	// UpdateSeries 只修改标题和简介
+	UpdateSeries(ctx context.Context, s Series) error
+	DeleteSeries(ctx context.Context, uid int64, id int64) error
+	// GetSeries onlyPublished 为 true 的时候只返回还在线上的文章
+	GetSeries(ctx context.Context, id int64, onlyPublished bool) (Series, error)
+	// GetSeriesByArticle 找出文章所在的系列，只包含还在线上的文章
+	// 文章不在任何系列里面返回 ErrSeriesNotFound
+	GetSeriesByArticle(ctx context.Context, aid int64) (Series, error)
+	ListSeriesByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Series, error)
+	// AddSeriesArticle 加到系列的最后
+	AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	// ReorderSeries aids 是调整之后的顺序，必须刚好是系列里面现有的文章
+	ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error
 }
 
 type ArticleGORMDAO struct {
 	db *gorm.DB
+	// ids 分库之后由它生成文章、系列和审核的 id，nil 的时候用数据库的自增主键
+	ids func() int64
 }
 
 func NewArticleGORMDAO(db *gorm.DB) ArticleDAO {
@@ -31,141 +122,490 @@ func NewArticleGORMDAO(db *gorm.DB) ArticleDAO {
 	}
 }
 
+// withTx 在事务里面复用 a 的 id 生成方式
+func (a *ArticleGORMDAO) withTx(tx *gorm.DB) *ArticleGORMDAO {
+	return &ArticleGORMDAO{db: tx, ids: a.ids}
+}
+
+// nextId 返回 0 的时候由数据库生成
+func (a *ArticleGORMDAO) nextId() int64 {
+	if a.ids == nil {
+		return 0
+	}
+	return a.ids()
+}
+
 type Article struct {
 	Id      int64  `gorm:"primaryKey,autoIncrement"`
 	Title   string `gorm:"type=varchar(4096)"`
 	Content string `gorm:"type=BLOB"`
 	// 我要根据创作者ID来查询
-	AuthorId int64 `gorm:"index"`
+	AuthorId int64 `gorm:"index;index:,composite:author_utime,priority:1"`
 	Status   uint8
-	Ctime    int64
-	Utime    int64
+	// Access 访问级别，线上库的查询不过滤，由业务层决定返回全文还是摘要
+	Access uint8
+	// 定时发表的时间，0 表示不是定时发表
+	PublishAt int64
+	Ctime     int64
+	// 按照 (utime, id) 翻页，索引里面隐含了主键
+	Utime int64 `gorm:"index;index:,composite:author_utime,priority:2"`
+	// Dtime 放进回收站的时间，0 表示没有删除
+	Dtime int64 `gorm:"index"`
+	// Version 每次修改草稿都会加一，用来做乐观锁
+	Version int64
+	// 标签存在单独的表里面
+	Tags []string `gorm:"-"`
+	// Category 一篇文章只有一个分类，直接放在文章上面
+	Category string `gorm:"type:varchar(128);index;not null;default:''"`
 }
 
-type PublishedArticle Article
+// PublishedArticle 线上库的文章，除了源文件还保存了渲染的结果
+type PublishedArticle struct {
+	Article
+	// Html 已经过滤过 XSS 的 HTML
+	Html string `gorm:"type:BLOB"`
+	// Toc 目录，JSON 格式
+	Toc      string `gorm:"type:BLOB"`
+	Abstract string `gorm:"type:varchar(1024)"`
+}
 
 func (a *ArticleGORMDAO) Insert(ctx context.Context, art Article) (int64, error) {
 	now := time.Now().UnixMilli()
 	art.Ctime = now
 	art.Utime = now
-	err := a.db.WithContext(ctx).Create(&art).Error
+	art.Version = 1
+	art.Id = a.nextId()
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&art).Error
+		if err != nil {
+			return err
+		}
+		err = replaceTags(tx, articleTagTable, art.Id, art.Tags)
+		if err != nil {
+			return err
+		}
+		err = addAttachmentRefs(tx, art.Id, domain.AttachmentRefs(art.Content))
+		if err != nil {
+			return err
+		}
+		return insertRevision(tx, art)
+	})
 	return art.Id, err
 }
 
-func (a *ArticleGORMDAO) UpdateById(ctx context.Context, art Article) error {
+func (a *ArticleGORMDAO) UpdateById(ctx context.Context, art Article) (int64, int64, error) {
+	var owner, version int64
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		owner, err = updateById(tx, art)
+		if err != nil {
+			return err
+		}
+		// 没有带版本号的时候不知道加完之后是多少，在事务里面读出来，客户端下次保存要带上
+		var cur Article
+		err = tx.Select("version").Where("id = ?", art.Id).First(&cur).Error
+		version = cur.Version
+		return err
+	})
+	return owner, version, err
+}
+
+// updateById 必须在事务里面调用
+func updateById(tx *gorm.DB, art Article) (int64, error) {
+	owner, err := checkRole(tx, art.Id, art.AuthorId, domain.CollaboratorRole.CanEdit)
+	if err != nil {
+		return 0, err
+	}
 	now := time.Now().UnixMilli()
-	res := a.db.WithContext(ctx).Model(&art).
-		Where("id = ? AND author_id = ?", art.Id, art.AuthorId).Updates(map[string]any{
-		"title":   art.Title,
-		"content": art.Content,
-		"status":  art.Status,
-		"utime":   now,
+	// 回收站里面的文章要先恢复才能修改
+	query := tx.Model(&Article{}).
+		Where("id = ? AND status <> ?",
+			art.Id, domain.ArticleStatusDeleted.ToUint8())
+	if art.Version > 0 {
+		// 客户端拿到的版本已经过期了，不能覆盖别的设备的修改
+		query = query.Where("version = ?", art.Version)
+	}
+	res := query.Updates(map[string]any{
+		"title":      art.Title,
+		"content":    art.Content,
+		"status":     art.Status,
+		"access":     art.Access,
+		"category":   art.Category,
+		"publish_at": art.PublishAt,
+		"version":    gorm.Expr("`version` + 1"),
+		"utime":      now,
 	})
 	if res.Error != nil {
-		return res.Error
+		return 0, res.Error
 	}
 	if res.RowsAffected == 0 {
-		return errors.New("ID 不对或者创作者不对")
+		if art.Version > 0 {
+			var cur Article
+			err = tx.Select("version").
+				Where("id = ? AND status <> ?", art.Id, domain.ArticleStatusDeleted.ToUint8()).
+				First(&cur).Error
+			if err == nil {
+				return 0, &VersionConflictError{Current: cur.Version}
+			}
+		}
+		return 0, errors.New("文章在回收站里面")
 	}
-	return nil
+	err = replaceTags(tx, articleTagTable, art.Id, art.Tags)
+	if err != nil {
+		return 0, err
+	}
+	err = addAttachmentRefs(tx, art.Id, domain.AttachmentRefs(art.Content))
+	if err != nil {
+		return 0, err
+	}
+	// 审核的是旧的内容，作废掉，重新发表的时候会再提交
+	err = cancelReviews(tx, art.Id)
+	if err != nil {
+		return 0, err
+	}
+	// 版本记录里面的是修改的人，不一定是作者
+	return owner, insertRevision(tx, art)
 }
 
-func (a *ArticleGORMDAO) Sync(ctx context.Context, art Article) (int64, error) {
-	var id = art.Id
+func (a *ArticleGORMDAO) Sync(ctx context.Context, art PublishedArticle) (int64, int64, error) {
+	var (
+		id    = art.Id
+		owner = art.AuthorId
+	)
 	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var (
 			err error
 		)
-		dao := NewArticleGORMDAO(tx)
+		dao := a.withTx(tx)
 		if id > 0 {
-			err = dao.UpdateById(ctx, art)
+			owner, err = updateById(tx, art.Article)
 		} else {
-			id, err = dao.Insert(ctx, art)
+			id, err = dao.Insert(ctx, art.Article)
 		}
 		if err != nil {
 			return err
 		}
 		art.Id = id
-		now := time.Now().UnixMilli()
-		pubArt := PublishedArticle(art)
-		pubArt.Ctime = now
-		pubArt.Utime = now
-		err = tx.Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"title":   pubArt.Title,
-				"content": pubArt.Content,
-				"utime":   now,
-				"status":  pubArt.Status,
-			}),
-		}).Create(&pubArt).Error
+		// 协作者发表的文章，线上库里面也还是作者的
+		art.AuthorId = owner
+		return a.syncPublished(tx, art)
+	})
+	return id, owner, err
+}
+
+// syncPublished 把 art 同步到线上库，必须在事务里面调用
+func (a *ArticleGORMDAO) syncPublished(tx *gorm.DB, art PublishedArticle) error {
+	var cnt int64
+	err := tx.Model(&PublishedArticle{}).Where("id = ?", art.Id).Count(&cnt).Error
+	if err != nil {
+		return err
+	}
+	// 以制作库的标签为准，定时发表的时候 art 里面是没有标签的
+	tags, err := findTags(tx, articleTagTable, []int64{art.Id})
+	if err != nil {
 		return err
+	}
+	art.Tags = tags[art.Id]
+	err = replaceTags(tx, publishedArticleTagTable, art.Id, art.Tags)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixMilli()
+	art.Ctime = now
+	art.Utime = now
+	err = tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"title":    art.Title,
+			"content":  art.Content,
+			"html":     art.Html,
+			"toc":      art.Toc,
+			"abstract": art.Abstract,
+			"utime":    now,
+			"status":   art.Status,
+			"access":   art.Access,
+			"category": art.Category,
+		}),
+	}).Create(&art).Error
+	if err != nil {
+		return err
+	}
+	// 和发表在同一个事务里面写入消息，保证一定能通知到搜索和 feed
+	return insertArticleEvents(tx, art, cnt == 0)
+}
+
+func (a *ArticleGORMDAO) PublishScheduled(ctx context.Context, id int64, render func(art Article) (PublishedArticle, error)) (bool, error) {
+	published := false
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		// 只有还处于定时状态，并且已经到期的才能发表
+		// 被取消、被重新调度或者已经被别的节点发表过的，都不会更新成功
+		res := tx.Model(&Article{}).
+			Where("id = ? AND status = ? AND publish_at <= ?",
+				id, domain.ArticleStatusScheduled.ToUint8(), now).
+			Updates(map[string]any{
+				"status":     domain.ArticleStatusPublished.ToUint8(),
+				"publish_at": 0,
+				"utime":      now,
+			})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		var art Article
+		err := tx.Where("id = ?", id).First(&art).Error
+		if err != nil {
+			return err
+		}
+		err = insertRevision(tx, art)
+		if err != nil {
+			return err
+		}
+		pub, err := render(art)
+		if err != nil {
+			return err
+		}
+		published = true
+		return a.syncPublished(tx, pub)
 	})
-	return id, err
+	return published, err
+}
+
+func (a *ArticleGORMDAO) ListScheduled(ctx context.Context, startId int64, limit int) ([]Article, error) {
+	var res []Article
+	err := a.db.WithContext(ctx).
+		Where("id > ? AND status = ?", startId, domain.ArticleStatusScheduled.ToUint8()).
+		Order("id ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (a *ArticleGORMDAO) CancelScheduled(ctx context.Context, uid int64, id int64) error {
+	res := a.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ?",
+			id, uid, domain.ArticleStatusScheduled.ToUint8()).
+		Updates(map[string]any{
+			"status":     domain.ArticleStatusUnpublished.ToUint8(),
+			"publish_at": 0,
+			"utime":      time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.New("ID 不对或者文章不是定时发表")
+	}
+	return nil
 }
 
 func (a *ArticleGORMDAO) SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error {
+	// 回收站里面的文章要先恢复才能修改状态
+	return a.changeStatus(ctx, uid, id,
+		"status <> ?", domain.ArticleStatusDeleted.ToUint8(),
+		map[string]any{"status": status},
+		map[string]any{"status": status})
+}
+
+// changeStatus 同时修改制作库和线上库的文章，线上库有这篇文章的话还要通知出去
+// 制作库的文章要满足 where 条件才会被修改
+func (a *ArticleGORMDAO) changeStatus(ctx context.Context, uid int64, id int64,
+	where string, arg any, draft map[string]any, pub map[string]any) error {
 	now := time.Now().UnixMilli()
+	draft["utime"] = now
+	pub["utime"] = now
 	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := checkRole(tx, id, uid, domain.CollaboratorRole.IsOwner)
+		if err != nil {
+			return err
+		}
 		res := tx.Model(&Article{}).
-			Where("id = ? and author_id = ?", id, uid).
-			Updates(map[string]any{
-				"utime":  now,
-				"status": status,
-			})
+			Where("id = ?", id).
+			Where(where, arg).
+			Updates(draft)
 		if res.Error != nil {
 			return res.Error
 		}
 		if res.RowsAffected != 1 {
-			return errors.New("ID 不对或者创作者不对")
+			return errors.New("文章的状态不对")
 		}
-		return tx.Model(&PublishedArticle{}).
+		res = tx.Model(&PublishedArticle{}).
 			Where("id = ?", id).
-			Updates(map[string]any{
-				"utime":  now,
-				"status": status,
-			}).Error
+			Updates(pub)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 从来没有发表过，不需要通知
+			return nil
+		}
+		var pubArt PublishedArticle
+		err = tx.Where("id = ?", id).First(&pubArt).Error
+		if err != nil {
+			return err
+		}
+		tags, err := findTags(tx, publishedArticleTagTable, []int64{id})
+		if err != nil {
+			return err
+		}
+		pubArt.Tags = tags[id]
+		return insertArticleEvents(tx, pubArt, false)
 	})
 }
 
-func (a *ArticleGORMDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+func (a *ArticleGORMDAO) GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]Article, error) {
+	db := a.db.WithContext(ctx)
 	var arts []Article
-	err := a.db.WithContext(ctx).
-		Where("author_id = ?", uid).
+	err := a.authorQuery(db, uid, filter).
 		Offset(offset).Limit(limit).
-		Order("utime DESC").
+		Order("utime DESC, id DESC").
+		Find(&arts).Error
+	if err != nil {
+		return nil, err
+	}
+	return arts, a.fillTags(db, articleTagTable, arts)
+}
+
+func (a *ArticleGORMDAO) GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]Article, error) {
+	db := a.db.WithContext(ctx)
+	var arts []Article
+	err := afterCursor(a.authorQuery(db, uid, filter), utime, id).
+		Limit(limit).
+		Order("utime DESC, id DESC").
 		Find(&arts).Error
-	return arts, err
+	if err != nil {
+		return nil, err
+	}
+	return arts, a.fillTags(db, articleTagTable, arts)
+}
+
+func (a *ArticleGORMDAO) authorQuery(db *gorm.DB, uid int64, filter domain.ArticleFilter) *gorm.DB {
+	// 回收站里面的文章单独列出来
+	query := db.Where("author_id = ? AND status <> ?", uid, domain.ArticleStatusDeleted.ToUint8())
+	return withFilter(db, query, articleTagTable, filter)
+}
+
+// afterCursor 只保留排在 (utime, id) 后面的记录，配合 utime DESC, id DESC 使用
+// 不用 (utime, id) < (?, ?) 的写法，MySQL 对行比较走索引的支持不好
+func afterCursor(query *gorm.DB, utime int64, id int64) *gorm.DB {
+	if utime == 0 && id == 0 {
+		return query
+	}
+	return query.Where("utime < ? OR (utime = ? AND id < ?)", utime, utime, id)
+}
+
+func (a *ArticleGORMDAO) fillTags(db *gorm.DB, table string, arts []Article) error {
+	tags, err := findTags(db, table, slice.Map(arts, func(idx int, src Article) int64 {
+		return src.Id
+	}))
+	if err != nil {
+		return err
+	}
+	for i := range arts {
+		arts[i].Tags = tags[arts[i].Id]
+	}
+	return nil
 }
+
 func (a *ArticleGORMDAO) GetById(ctx context.Context, id int64) (Article, error) {
+	db := a.db.WithContext(ctx)
 	var art Article
-	err := a.db.WithContext(ctx).
-		Where("id = ?", id).First(&art).Error
+	err := db.Where("id = ?", id).First(&art).Error
+	if err == gorm.ErrRecordNotFound {
+		return art, ErrArticleNotFound
+	}
+	if err != nil {
+		return art, err
+	}
+	tags, err := findTags(db, articleTagTable, []int64{id})
+	art.Tags = tags[id]
 	return art, err
 }
 
-func (a *ArticleGORMDAO) GetPubById(ctx context.Context, id int64, uid int64) (PublishedArticle, error) {
+func (a *ArticleGORMDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
+	db := a.db.WithContext(ctx)
 	var art PublishedArticle
-	err := a.db.WithContext(ctx).
-		Where("id = ? AND author_id = ?", id, uid).
-		First(&art).Error
+	err := db.Where("id = ?", id).First(&art).Error
+	if err == gorm.ErrRecordNotFound {
+		return art, ErrArticleNotFound
+	}
 	if err != nil {
 		return art, err
 	}
 	if art.Status != domain.ArticleStatusPublished.ToUint8() {
-		return art, errors.New("文章未发布")
+		return art, ErrArticleNotFound
 	}
-	return art, nil
+	tags, err := findTags(db, publishedArticleTagTable, []int64{id})
+	art.Tags = tags[id]
+	return art, err
 }
 
-func (a *ArticleGORMDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error) {
+func (a *ArticleGORMDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	db := a.db.WithContext(ctx)
+	var res []PublishedArticle
+	err := db.Where("id IN ? AND status = ?", ids, domain.ArticleStatusPublished.ToUint8()).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, a.fillPubTags(db, res)
+}
+
+func (a *ArticleGORMDAO) ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset int, limit int) ([]PublishedArticle, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
 	defer cancel()
+	db := a.db.WithContext(ctx)
 	var res []PublishedArticle
-	err := a.db.WithContext(ctx).
-		Where("utime < ? AND status = ?",
-			start.UnixMilli(), domain.ArticleStatusPublished.ToUint8()).
+	err := a.pubQuery(db, start, filter).
 		Offset(offset).Limit(limit).
+		Order("utime DESC, id DESC").
 		Find(&res).Error
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+	return res, a.fillPubTags(db, res)
+}
+
+func (a *ArticleGORMDAO) ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]PublishedArticle, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	defer cancel()
+	db := a.db.WithContext(ctx)
+	var res []PublishedArticle
+	err := afterCursor(a.pubQuery(db, start, filter), utime, id).
+		Limit(limit).
+		Order("utime DESC, id DESC").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, a.fillPubTags(db, res)
+}
+
+func (a *ArticleGORMDAO) pubQuery(db *gorm.DB, start time.Time, filter domain.ArticleFilter) *gorm.DB {
+	query := db.Where("utime < ? AND status = ?",
+		start.UnixMilli(), domain.ArticleStatusPublished.ToUint8())
+	return withFilter(db, query, publishedArticleTagTable, filter)
+}
+
+// withFilter 加上 filter 里面不为空的条件，tagTable 是文章对应的标签关系表
+func withFilter(db *gorm.DB, query *gorm.DB, tagTable string, filter domain.ArticleFilter) *gorm.DB {
+	if filter.Tag != "" {
+		query = query.Where("id IN (?)", withTag(db, tagTable, filter.Tag))
+	}
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	return query
+}
+
+func (a *ArticleGORMDAO) fillPubTags(db *gorm.DB, arts []PublishedArticle) error {
+	tags, err := findTags(db, publishedArticleTagTable, slice.Map(arts, func(idx int, src PublishedArticle) int64 {
+		return src.Id
+	}))
+	if err != nil {
+		return err
+	}
+	for i := range arts {
+		arts[i].Tags = tags[arts[i].Id]
+	}
+	return nil
 }
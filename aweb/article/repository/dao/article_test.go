@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleGORMDAO_UpdateById_Version(t *testing.T) {
+	d, _ := newTestDAO(t)
+	ctx := context.Background()
+	id := prepareArticle(t, d)
+
+	// 不带版本号也要返回保存之后的版本号
+	owner, version, err := d.UpdateById(ctx, Article{Id: id, Title: "标题2", AuthorId: testEditor})
+	require.NoError(t, err)
+	assert.Equal(t, testOwner, owner)
+	assert.Equal(t, int64(2), version)
+
+	_, version, err = d.UpdateById(ctx, Article{Id: id, Title: "标题3", AuthorId: testOwner, Version: version})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+
+	// 拿着过期的版本号保存，告诉客户端现在的版本
+	_, _, err = d.UpdateById(ctx, Article{Id: id, Title: "标题4", AuthorId: testOwner, Version: 2})
+	var conflict *VersionConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, int64(3), conflict.Current)
+}
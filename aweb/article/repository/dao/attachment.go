@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrAttachmentNotFound = errors.New("附件不存在")
+
+// Attachment 附件的元数据，文件本身在对象存储里面
+// 内容一样的文件只保存一份，用 Hash 去重
+type Attachment struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	Uid         int64  `gorm:"index"`
+	Hash        string `gorm:"type:char(64);uniqueIndex"`
+	Filename    string `gorm:"type:varchar(256)"`
+	ContentType string `gorm:"type:varchar(128)"`
+	Size        int64
+	Width       int
+	Height      int
+	StorageKey  string `gorm:"type:varchar(512)"`
+	// ThumbKey 空字符串说明没有缩略图
+	ThumbKey string `gorm:"type:varchar(512)"`
+	Ctime    int64
+	// Utime 最后一次上传的时间，回收的时候给刚上传还没保存文章的附件留出时间
+	Utime int64 `gorm:"index"`
+}
+
+// ArticleAttachment 文章引用了哪些附件
+// 只增不减，历史版本里面引用的附件也要留着，不然恢复版本之后图片就没了
+// 文章被彻底删除的时候才会一起删除
+type ArticleAttachment struct {
+	Id           int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId    int64 `gorm:"uniqueIndex:article_attachment"`
+	AttachmentId int64 `gorm:"uniqueIndex:article_attachment;index"`
+	Ctime        int64
+}
+
+type AttachmentDAO interface {
+	// Insert 内容已经存在的话不会插入，返回已有的附件，created 为 false
+	Insert(ctx context.Context, a Attachment) (res Attachment, created bool, err error)
+	FindByHash(ctx context.Context, hash string) (Attachment, error)
+	// Touch 重复上传的时候更新 utime，推迟回收
+	Touch(ctx context.Context, id int64) error
+	FindById(ctx context.Context, id int64) (Attachment, error)
+	FindByIds(ctx context.Context, ids []int64) ([]Attachment, error)
+	// ListOrphans 找出在 before 之前上传，并且没有任何文章引用的附件
+	ListOrphans(ctx context.Context, before time.Time, limit int) ([]Attachment, error)
+	// DeleteOrphan 再确认一次没有被引用才删除，返回是否真的删除了
+	DeleteOrphan(ctx context.Context, id int64, before time.Time) (bool, error)
+}
+
+type GORMAttachmentDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMAttachmentDAO(db *gorm.DB) AttachmentDAO {
+	return &GORMAttachmentDAO{db: db}
+}
+
+func (g *GORMAttachmentDAO) Insert(ctx context.Context, a Attachment) (Attachment, bool, error) {
+	now := time.Now().UnixMilli()
+	a.Ctime = now
+	a.Utime = now
+	db := g.db.WithContext(ctx)
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&a)
+	if res.Error != nil {
+		return Attachment{}, false, res.Error
+	}
+	if res.RowsAffected > 0 {
+		return a, true, nil
+	}
+	// 同样的内容被别人抢先上传了
+	existing, err := g.FindByHash(ctx, a.Hash)
+	return existing, false, err
+}
+
+func (g *GORMAttachmentDAO) FindByHash(ctx context.Context, hash string) (Attachment, error) {
+	var res Attachment
+	err := g.db.WithContext(ctx).Where("hash = ?", hash).First(&res).Error
+	if err == gorm.ErrRecordNotFound {
+		return res, ErrAttachmentNotFound
+	}
+	return res, err
+}
+
+func (g *GORMAttachmentDAO) Touch(ctx context.Context, id int64) error {
+	return g.db.WithContext(ctx).Model(&Attachment{}).Where("id = ?", id).
+		Update("utime", time.Now().UnixMilli()).Error
+}
+
+func (g *GORMAttachmentDAO) FindById(ctx context.Context, id int64) (Attachment, error) {
+	var res Attachment
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	if err == gorm.ErrRecordNotFound {
+		return res, ErrAttachmentNotFound
+	}
+	return res, err
+}
+
+func (g *GORMAttachmentDAO) FindByIds(ctx context.Context, ids []int64) ([]Attachment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var res []Attachment
+	err := g.db.WithContext(ctx).Where("id IN ?", ids).Find(&res).Error
+	return res, err
+}
+
+func (g *GORMAttachmentDAO) ListOrphans(ctx context.Context, before time.Time, limit int) ([]Attachment, error) {
+	var res []Attachment
+	err := orphans(g.db.WithContext(ctx), before).
+		Order("utime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMAttachmentDAO) DeleteOrphan(ctx context.Context, id int64, before time.Time) (bool, error) {
+	// 列出来之后可能又被文章引用或者被重新上传了，所以删除的时候要带上同样的条件
+	res := orphans(g.db.WithContext(ctx), before).
+		Where("id = ?", id).
+		Delete(&Attachment{})
+	return res.RowsAffected > 0, res.Error
+}
+
+// orphans 在 before 之前上传，并且没有被任何文章引用的附件
+func orphans(db *gorm.DB, before time.Time) *gorm.DB {
+	return db.Model(&Attachment{}).
+		Where("utime < ?", before.UnixMilli()).
+		Where("NOT EXISTS (?)",
+			db.Session(&gorm.Session{NewDB: true}).Model(&ArticleAttachment{}).
+				Select("1").
+				Where("article_attachments.attachment_id = attachments.id"))
+}
+
+// addAttachmentRefs 记录文章引用的附件，已经记录过的不管，必须在事务里面调用
+func addAttachmentRefs(tx *gorm.DB, aid int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	refs := slice.Map(ids, func(idx int, src int64) ArticleAttachment {
+		return ArticleAttachment{ArticleId: aid, AttachmentId: src, Ctime: now}
+	})
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&refs).Error
+}
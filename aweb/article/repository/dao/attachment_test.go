@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMAttachmentDAO_Insert(t *testing.T) {
+	_, db := newTestDAO(t)
+	d := NewGORMAttachmentDAO(db)
+	ctx := context.Background()
+	first, created, err := d.Insert(ctx, Attachment{Uid: 1, Hash: "h1", StorageKey: "k1"})
+	require.NoError(t, err)
+	assert.True(t, created)
+	// 内容一样的文件只保存一份，返回先上传的那个
+	second, created, err := d.Insert(ctx, Attachment{Uid: 2, Hash: "h1", StorageKey: "k2"})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, first.Id, second.Id)
+	assert.Equal(t, "k1", second.StorageKey)
+}
+
+func TestGORMAttachmentDAO_DeleteOrphan(t *testing.T) {
+	testCases := []struct {
+		name string
+		// 文章引用附件的方式
+		ref         func(t *testing.T, d *ArticleGORMDAO, aid int64, content string)
+		wantOrphan  bool
+		wantDeleted bool
+	}{
+		{
+			name:        "没有被引用",
+			ref:         func(t *testing.T, d *ArticleGORMDAO, aid int64, content string) {},
+			wantOrphan:  true,
+			wantDeleted: true,
+		},
+		{
+			name: "草稿引用",
+			ref: func(t *testing.T, d *ArticleGORMDAO, aid int64, content string) {
+				_, _, err := d.UpdateById(context.Background(), Article{
+					Id: aid, Title: "标题", Content: content, AuthorId: testOwner,
+				})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "只有历史版本引用",
+			ref: func(t *testing.T, d *ArticleGORMDAO, aid int64, content string) {
+				ctx := context.Background()
+				_, _, err := d.UpdateById(ctx, Article{
+					Id: aid, Title: "标题", Content: content, AuthorId: testOwner,
+				})
+				require.NoError(t, err)
+				_, _, err = d.UpdateById(ctx, Article{
+					Id: aid, Title: "标题", Content: "删掉了图片", AuthorId: testOwner,
+				})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "引用的文章被彻底删除",
+			ref: func(t *testing.T, d *ArticleGORMDAO, aid int64, content string) {
+				ctx := context.Background()
+				_, _, err := d.UpdateById(ctx, Article{
+					Id: aid, Title: "标题", Content: content, AuthorId: testOwner,
+				})
+				require.NoError(t, err)
+				require.NoError(t, d.Delete(ctx, testOwner, aid))
+				purged, err := d.Purge(ctx, []int64{aid}, time.Now().Add(time.Minute))
+				require.NoError(t, err)
+				require.Len(t, purged, 1)
+			},
+			wantOrphan:  true,
+			wantDeleted: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ad, db := newTestDAO(t)
+			d := NewGORMAttachmentDAO(db)
+			ctx := context.Background()
+			att, _, err := d.Insert(ctx, Attachment{Uid: testOwner, Hash: "h"})
+			require.NoError(t, err)
+			aid := prepareArticle(t, ad)
+			tc.ref(t, ad, aid, "![图]("+domain.AttachmentRef(att.Id)+")")
+
+			// 还在宽限期里面的不会被回收
+			orphans, err := d.ListOrphans(ctx, time.UnixMilli(att.Utime), 10)
+			require.NoError(t, err)
+			assert.Empty(t, orphans)
+
+			before := time.Now().Add(time.Minute)
+			orphans, err = d.ListOrphans(ctx, before, 10)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOrphan, len(orphans) == 1)
+			ok, err := d.DeleteOrphan(ctx, att.Id, before)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDeleted, ok)
+			_, err = d.FindById(ctx, att.Id)
+			if tc.wantDeleted {
+				assert.Equal(t, ErrAttachmentNotFound, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
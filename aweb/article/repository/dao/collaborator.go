@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPermissionDenied = errors.New("没有操作这篇文章的权限")
+	// ErrInvalidCollaborator 邀请作者自己，或者角色不对
+	ErrInvalidCollaborator = errors.New("不能这样邀请协作者")
+)
+
+// ArticleCollaborator 文章的协作者，作者本人不在这里面
+type ArticleCollaborator struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:article_uid"`
+	// 按照协作者查找他参与的文章
+	Uid   int64 `gorm:"uniqueIndex:article_uid;index"`
+	Role  uint8
+	Ctime int64
+	Utime int64
+}
+
+// roleOf 查出 uid 在文章上的角色，顺便返回文章的作者
+func roleOf(tx *gorm.DB, aid int64, uid int64) (int64, domain.CollaboratorRole, error) {
+	var art Article
+	err := tx.Select("author_id").Where("id = ?", aid).First(&art).Error
+	if err != nil {
+		return 0, domain.CollaboratorRoleNone, err
+	}
+	if art.AuthorId == uid {
+		return art.AuthorId, domain.CollaboratorRoleOwner, nil
+	}
+	var c ArticleCollaborator
+	err = tx.Select("role").Where("article_id = ? AND uid = ?", aid, uid).First(&c).Error
+	switch err {
+	case nil:
+		return art.AuthorId, domain.CollaboratorRole(c.Role), nil
+	case gorm.ErrRecordNotFound:
+		return art.AuthorId, domain.CollaboratorRoleNone, nil
+	default:
+		return art.AuthorId, domain.CollaboratorRoleNone, err
+	}
+}
+
+// checkRole uid 在文章上的角色满足 allow 才返回文章的作者，否则返回 ErrPermissionDenied
+func checkRole(tx *gorm.DB, aid int64, uid int64, allow func(role domain.CollaboratorRole) bool) (int64, error) {
+	owner, role, err := roleOf(tx, aid, uid)
+	if err != nil {
+		return 0, err
+	}
+	if !allow(role) {
+		return 0, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+func (a *ArticleGORMDAO) UpsertCollaborator(ctx context.Context, uid int64, c ArticleCollaborator) error {
+	if !domain.CollaboratorRole(c.Role).Invitable() {
+		return ErrInvalidCollaborator
+	}
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		owner, err := checkRole(tx, c.ArticleId, uid, domain.CollaboratorRole.IsOwner)
+		if err != nil {
+			return err
+		}
+		if c.Uid == owner {
+			return ErrInvalidCollaborator
+		}
+		now := time.Now().UnixMilli()
+		c.Ctime = now
+		c.Utime = now
+		// 已经是协作者的，就是修改角色
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "article_id"}, {Name: "uid"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"role":  c.Role,
+				"utime": now,
+			}),
+		}).Create(&c).Error
+	})
+}
+
+func (a *ArticleGORMDAO) DeleteCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error {
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 作者可以移除任何人，协作者只能自己退出
+		if uid != collaborator {
+			_, err := checkRole(tx, aid, uid, domain.CollaboratorRole.IsOwner)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Where("article_id = ? AND uid = ?", aid, collaborator).
+			Delete(&ArticleCollaborator{}).Error
+	})
+}
+
+func (a *ArticleGORMDAO) ListCollaborators(ctx context.Context, uid int64, aid int64) ([]ArticleCollaborator, error) {
+	var res []ArticleCollaborator
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := checkRole(tx, aid, uid, domain.CollaboratorRole.CanView)
+		if err != nil {
+			return err
+		}
+		return tx.Where("article_id = ?", aid).Order("id ASC").Find(&res).Error
+	})
+	return res, err
+}
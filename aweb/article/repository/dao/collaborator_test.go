@@ -0,0 +1,328 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	testOwner    int64 = 1
+	testEditor   int64 = 2
+	testViewer   int64 = 3
+	testStranger int64 = 4
+)
+
+// newTestDAO 每个测试用一个单独的 SQLite 文件，互相不影响
+func newTestDAO(t *testing.T) (*ArticleGORMDAO, *gorm.DB) {
+	db := newTestDB(t)
+	return NewArticleGORMDAO(db).(*ArticleGORMDAO), db
+}
+
+// prepareArticle 作者创建一篇文章，并且邀请编辑者和查看者
+func prepareArticle(t *testing.T, d *ArticleGORMDAO) int64 {
+	ctx := context.Background()
+	id, err := d.Insert(ctx, Article{
+		Title:    "标题",
+		Content:  "内容",
+		AuthorId: testOwner,
+		Status:   domain.ArticleStatusUnpublished.ToUint8(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, d.UpsertCollaborator(ctx, testOwner, ArticleCollaborator{
+		ArticleId: id,
+		Uid:       testEditor,
+		Role:      domain.CollaboratorRoleEditor.ToUint8(),
+	}))
+	require.NoError(t, d.UpsertCollaborator(ctx, testOwner, ArticleCollaborator{
+		ArticleId: id,
+		Uid:       testViewer,
+		Role:      domain.CollaboratorRoleViewer.ToUint8(),
+	}))
+	return id
+}
+
+func TestArticleGORMDAO_UpdateById_Permission(t *testing.T) {
+	testCases := []struct {
+		name      string
+		uid       int64
+		wantErr   error
+		wantOwner int64
+	}{
+		{
+			name:      "作者",
+			uid:       testOwner,
+			wantOwner: testOwner,
+		},
+		{
+			name:      "编辑者",
+			uid:       testEditor,
+			wantOwner: testOwner,
+		},
+		{
+			name:    "查看者",
+			uid:     testViewer,
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "无关的人",
+			uid:     testStranger,
+			wantErr: ErrPermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, db := newTestDAO(t)
+			id := prepareArticle(t, d)
+			owner, _, err := d.UpdateById(context.Background(), Article{
+				Id:       id,
+				Title:    "新标题",
+				Content:  "新内容",
+				AuthorId: tc.uid,
+				Status:   domain.ArticleStatusUnpublished.ToUint8(),
+			})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOwner, owner)
+
+			var art Article
+			require.NoError(t, db.Where("id = ?", id).First(&art).Error)
+			// 谁改的都不会改变作者
+			assert.Equal(t, testOwner, art.AuthorId)
+			var rev ArticleRevision
+			require.NoError(t, db.Where("article_id = ?", id).Order("id DESC").First(&rev).Error)
+			if tc.wantErr != nil {
+				assert.Equal(t, "标题", art.Title)
+				assert.Equal(t, testOwner, rev.AuthorId)
+				return
+			}
+			assert.Equal(t, "新标题", art.Title)
+			// 版本记录的是真正修改的人
+			assert.Equal(t, tc.uid, rev.AuthorId)
+		})
+	}
+}
+
+func TestArticleGORMDAO_Sync_Permission(t *testing.T) {
+	testCases := []struct {
+		name    string
+		uid     int64
+		wantErr error
+	}{
+		{
+			name: "作者",
+			uid:  testOwner,
+		},
+		{
+			name: "编辑者",
+			uid:  testEditor,
+		},
+		{
+			name:    "查看者",
+			uid:     testViewer,
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "无关的人",
+			uid:     testStranger,
+			wantErr: ErrPermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, db := newTestDAO(t)
+			id := prepareArticle(t, d)
+			resId, owner, err := d.Sync(context.Background(), PublishedArticle{
+				Article: Article{
+					Id:       id,
+					Title:    "发表的标题",
+					Content:  "发表的内容",
+					AuthorId: tc.uid,
+					Status:   domain.ArticleStatusPublished.ToUint8(),
+				},
+			})
+			assert.Equal(t, tc.wantErr, err)
+			var cnt int64
+			require.NoError(t, db.Model(&PublishedArticle{}).Where("id = ?", id).Count(&cnt).Error)
+			if tc.wantErr != nil {
+				assert.Zero(t, cnt)
+				return
+			}
+			assert.Equal(t, id, resId)
+			assert.Equal(t, testOwner, owner)
+			var pub PublishedArticle
+			require.NoError(t, db.Where("id = ?", id).First(&pub).Error)
+			// 编辑者发表的文章，线上也是作者的
+			assert.Equal(t, testOwner, pub.AuthorId)
+			assert.Equal(t, "发表的标题", pub.Title)
+		})
+	}
+}
+
+func TestArticleGORMDAO_SyncStatus_Permission(t *testing.T) {
+	testCases := []struct {
+		name    string
+		uid     int64
+		wantErr error
+	}{
+		{
+			name: "作者",
+			uid:  testOwner,
+		},
+		{
+			name:    "编辑者也不能修改状态",
+			uid:     testEditor,
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "查看者",
+			uid:     testViewer,
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "无关的人",
+			uid:     testStranger,
+			wantErr: ErrPermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, db := newTestDAO(t)
+			id := prepareArticle(t, d)
+			ctx := context.Background()
+			_, _, err := d.Sync(ctx, PublishedArticle{
+				Article: Article{
+					Id:       id,
+					Title:    "标题",
+					Content:  "内容",
+					AuthorId: testOwner,
+					Status:   domain.ArticleStatusPublished.ToUint8(),
+				},
+			})
+			require.NoError(t, err)
+
+			err = d.SyncStatus(ctx, tc.uid, id, domain.ArticleStatusPrivate.ToUint8())
+			assert.Equal(t, tc.wantErr, err)
+			want := domain.ArticleStatusPrivate.ToUint8()
+			if tc.wantErr != nil {
+				want = domain.ArticleStatusPublished.ToUint8()
+			}
+			var art Article
+			require.NoError(t, db.Where("id = ?", id).First(&art).Error)
+			assert.Equal(t, want, art.Status)
+			var pub PublishedArticle
+			require.NoError(t, db.Where("id = ?", id).First(&pub).Error)
+			assert.Equal(t, want, pub.Status)
+		})
+	}
+}
+
+func TestArticleGORMDAO_UpsertCollaborator(t *testing.T) {
+	testCases := []struct {
+		name string
+		// 邀请的人
+		uid     int64
+		c       func(aid int64) ArticleCollaborator
+		wantErr error
+	}{
+		{
+			name: "修改已有协作者的角色",
+			uid:  testOwner,
+			c: func(aid int64) ArticleCollaborator {
+				return ArticleCollaborator{ArticleId: aid, Uid: testViewer,
+					Role: domain.CollaboratorRoleEditor.ToUint8()}
+			},
+		},
+		{
+			name: "编辑者不能邀请别人",
+			uid:  testEditor,
+			c: func(aid int64) ArticleCollaborator {
+				return ArticleCollaborator{ArticleId: aid, Uid: testStranger,
+					Role: domain.CollaboratorRoleViewer.ToUint8()}
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name: "不能邀请作者自己",
+			uid:  testOwner,
+			c: func(aid int64) ArticleCollaborator {
+				return ArticleCollaborator{ArticleId: aid, Uid: testOwner,
+					Role: domain.CollaboratorRoleEditor.ToUint8()}
+			},
+			wantErr: ErrInvalidCollaborator,
+		},
+		{
+			name: "不能邀请成作者",
+			uid:  testOwner,
+			c: func(aid int64) ArticleCollaborator {
+				return ArticleCollaborator{ArticleId: aid, Uid: testStranger,
+					Role: domain.CollaboratorRoleOwner.ToUint8()}
+			},
+			wantErr: ErrInvalidCollaborator,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, _ := newTestDAO(t)
+			id := prepareArticle(t, d)
+			ctx := context.Background()
+			c := tc.c(id)
+			err := d.UpsertCollaborator(ctx, tc.uid, c)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			_, role, err := roleOf(d.db, id, c.Uid)
+			require.NoError(t, err)
+			assert.Equal(t, domain.CollaboratorRole(c.Role), role)
+		})
+	}
+}
+
+func TestArticleGORMDAO_DeleteCollaborator(t *testing.T) {
+	testCases := []struct {
+		name         string
+		uid          int64
+		collaborator int64
+		wantErr      error
+	}{
+		{
+			name:         "作者移除编辑者",
+			uid:          testOwner,
+			collaborator: testEditor,
+		},
+		{
+			name:         "查看者自己退出",
+			uid:          testViewer,
+			collaborator: testViewer,
+		},
+		{
+			name:         "编辑者不能移除别人",
+			uid:          testEditor,
+			collaborator: testViewer,
+			wantErr:      ErrPermissionDenied,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, _ := newTestDAO(t)
+			id := prepareArticle(t, d)
+			ctx := context.Background()
+			err := d.DeleteCollaborator(ctx, tc.uid, id, tc.collaborator)
+			assert.Equal(t, tc.wantErr, err)
+			_, role, err := roleOf(d.db, id, tc.collaborator)
+			require.NoError(t, err)
+			if tc.wantErr != nil {
+				assert.NotEqual(t, domain.CollaboratorRoleNone, role)
+				return
+			}
+			assert.Equal(t, domain.CollaboratorRoleNone, role)
+			// 被移除之后就不能再修改了
+			_, _, err = d.UpdateById(ctx, Article{Id: id, Title: "x", AuthorId: tc.collaborator})
+			assert.Equal(t, ErrPermissionDenied, err)
+		})
+	}
+}
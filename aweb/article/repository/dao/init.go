@@ -6,5 +6,17 @@ func InitTables(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&Article{},
 		&PublishedArticle{},
+		&OutboxMessage{},
+		&ArticleRevision{},
+		&Tag{},
+		&ArticleTag{},
+		&PublishedArticleTag{},
+		&Series{},
+		&SeriesArticle{},
+		&ArticleCollaborator{},
+		&Attachment{},
+		&ArticleAttachment{},
+		&ArticleReview{},
+		&Subscription{},
 	)
 }
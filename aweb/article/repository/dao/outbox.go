@@ -0,0 +1,192 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
+	"gorm.io/gorm"
+)
+
+const (
+	// TopicSyncArticle 搜索服务监听的 topic
+	TopicSyncArticle = "sync_article_event"
+	// TopicFeedEvent feed 服务监听的 topic
+	TopicFeedEvent = "feed_event"
+
+	// feedArticleEventType 要和 feed 那边的 ArticleEventName 保持一致
+	feedArticleEventType = "article_event"
+)
+
+const (
+	// OutboxStatusPending 等待发送
+	OutboxStatusPending uint8 = iota
+	// OutboxStatusSending 已经被某个实例抢占，正在发送
+	OutboxStatusSending
+	// OutboxStatusSent 发送成功
+	OutboxStatusSent
+	// OutboxStatusFailed 重试次数耗尽，需要人工介入
+	OutboxStatusFailed
+)
+
+// OutboxMessage 本地消息表
+// 和文章的修改在同一个事务里面写入，由 OutboxRelay 异步投递到 kafka
+type OutboxMessage struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Topic string `gorm:"type:varchar(128)"`
+	// kafka 的 key，同一篇文章的消息落在同一个分区，保证顺序
+	Key     string `gorm:"type:varchar(128)"`
+	Payload string `gorm:"type:BLOB"`
+	Status  uint8  `gorm:"index:status_next_time"`
+	Retries int
+	// 下一次可以发送的时间
+	NextTime int64 `gorm:"index:status_next_time"`
+	Ctime    int64
+	Utime    int64
+}
+
+type OutboxDAO interface {
+	// Preempt 抢占最多 limit 条待发送的消息
+	Preempt(ctx context.Context, limit int) ([]OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	// MarkRetry 发送失败，等到 nextTime 再重试
+	MarkRetry(ctx context.Context, id int64, retries int, nextTime time.Time) error
+	MarkFailed(ctx context.Context, id int64, retries int) error
+}
+
+type GORMOutboxDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMOutboxDAO(db *gorm.DB) OutboxDAO {
+	return &GORMOutboxDAO{db: db}
+}
+
+func (dao *GORMOutboxDAO) Preempt(ctx context.Context, limit int) ([]OutboxMessage, error) {
+	// 从库上的 utime 是旧的，CAS 一定失败，新写入的消息也看不到，所以要查主库
+	db := dao.db.WithContext(gormx.ForcePrimary(ctx))
+	now := time.Now().UnixMilli()
+	// 抢占了但是一分钟都没发完，认为那个实例已经崩溃了
+	ddl := now - time.Minute.Milliseconds()
+	var candidates []OutboxMessage
+	err := db.Where("(status = ? AND next_time <= ?) OR (status = ? AND utime < ?)",
+		OutboxStatusPending, now, OutboxStatusSending, ddl).
+		Order("id ASC").Limit(limit).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make([]OutboxMessage, 0, len(candidates))
+	for _, msg := range candidates {
+		// 和 job 的抢占一样，用 CAS 的方式避免多个实例重复发送
+		ret := db.Model(&OutboxMessage{}).
+			Where("id = ? AND status = ? AND utime = ?", msg.Id, msg.Status, msg.Utime).
+			Updates(map[string]any{
+				"status": OutboxStatusSending,
+				"utime":  now,
+			})
+		if ret.Error != nil {
+			return res, ret.Error
+		}
+		if ret.RowsAffected == 0 {
+			// 没抢到
+			continue
+		}
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+func (dao *GORMOutboxDAO) MarkSent(ctx context.Context, id int64) error {
+	return dao.db.WithContext(ctx).Model(&OutboxMessage{}).
+		Where("id = ?", id).Updates(map[string]any{
+		"status": OutboxStatusSent,
+		"utime":  time.Now().UnixMilli(),
+	}).Error
+}
+
+func (dao *GORMOutboxDAO) MarkRetry(ctx context.Context, id int64, retries int, nextTime time.Time) error {
+	return dao.db.WithContext(ctx).Model(&OutboxMessage{}).
+		Where("id = ?", id).Updates(map[string]any{
+		"status":    OutboxStatusPending,
+		"retries":   retries,
+		"next_time": nextTime.UnixMilli(),
+		"utime":     time.Now().UnixMilli(),
+	}).Error
+}
+
+func (dao *GORMOutboxDAO) MarkFailed(ctx context.Context, id int64, retries int) error {
+	return dao.db.WithContext(ctx).Model(&OutboxMessage{}).
+		Where("id = ?", id).Updates(map[string]any{
+		"status":  OutboxStatusFailed,
+		"retries": retries,
+		"utime":   time.Now().UnixMilli(),
+	}).Error
+}
+
+// syncArticleEvent 字段要和 search 那边的 ArticleEvent 保持一致
+type syncArticleEvent struct {
+	Id       int64    `json:"id"`
+	Title    string   `json:"title"`
+	Status   int32    `json:"status"`
+	Content  string   `json:"content"`
+	Tags     []string `json:"tags"`
+	Category string   `json:"category"`
+}
+
+// feedEvent 字段要和 feed 那边的 FeedEvent 保持一致
+type feedEvent struct {
+	Type     string
+	Metadata map[string]string
+}
+
+// insertArticleEvents 在事务 tx 里面写入文章变更要通知出去的消息
+// firstPublish 为 true 的时候，才会通知 feed，避免每次修改都刷粉丝的 feed 流
+func insertArticleEvents(tx *gorm.DB, art PublishedArticle, firstPublish bool) error {
+	now := time.Now().UnixMilli()
+	key := strconv.FormatInt(art.Id, 10)
+	val, err := json.Marshal(syncArticleEvent{
+		Id:       art.Id,
+		Title:    art.Title,
+		Status:   int32(art.Status),
+		Content:  art.Content,
+		Tags:     art.Tags,
+		Category: art.Category,
+	})
+	if err != nil {
+		return err
+	}
+	msgs := []OutboxMessage{{
+		Topic:    TopicSyncArticle,
+		Key:      key,
+		Payload:  string(val),
+		NextTime: now,
+		Ctime:    now,
+		Utime:    now,
+	}}
+	if firstPublish && art.Status == domain.ArticleStatusPublished.ToUint8() {
+		val, err = json.Marshal(feedEvent{
+			Type: feedArticleEventType,
+			Metadata: map[string]string{
+				"uid":   strconv.FormatInt(art.AuthorId, 10),
+				"aid":   key,
+				"title": art.Title,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, OutboxMessage{
+			Topic:    TopicFeedEvent,
+			Key:      key,
+			Payload:  string(val),
+			NextTime: now,
+			Ctime:    now,
+			Utime:    now,
+		})
+	}
+	return tx.Create(&msgs).Error
+}
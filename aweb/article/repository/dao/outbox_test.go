@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestGORMOutboxDAO_PreemptWithLaggingReplica(t *testing.T) {
+	dir := t.TempDir()
+	primaryFile := filepath.Join(dir, "primary.db")
+	replicaFile := filepath.Join(dir, "replica.db")
+	open := func(file string) *gorm.DB {
+		db, err := gorm.Open(sqlite.Open(file), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(&OutboxMessage{}))
+		return db
+	}
+	primary, replica := open(primaryFile), open(replicaFile)
+	db, err := gorm.Open(sqlite.Open(primaryFile), &gorm.Config{})
+	require.NoError(t, err)
+	r := gormx.NewResolver([]gorm.Dialector{sqlite.Open(replicaFile)}, gormx.ResolverConfig{})
+	require.NoError(t, db.Use(r))
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+
+	now := time.Now().UnixMilli()
+	// 从库还没有同步到这两条消息
+	require.NoError(t, primary.Create(&[]OutboxMessage{
+		{Id: 1, Topic: "a", NextTime: now, Ctime: now, Utime: now},
+		{Id: 2, Topic: "b", NextTime: now, Ctime: now, Utime: now},
+	}).Error)
+	// 从库上已经发送过的消息还是等待状态
+	require.NoError(t, replica.Create(&OutboxMessage{Id: 3, Topic: "c", NextTime: now, Ctime: now, Utime: now - 1}).Error)
+	require.NoError(t, primary.Create(&OutboxMessage{Id: 3, Topic: "c", Status: OutboxStatusSent, NextTime: now, Ctime: now, Utime: now}).Error)
+
+	msgs, err := NewGORMOutboxDAO(db).Preempt(context.Background(), 10)
+	require.NoError(t, err)
+	ids := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.Id)
+	}
+	assert.Equal(t, []int64{1, 2}, ids)
+}
+
+func newTestOutboxDAO(t *testing.T) (OutboxDAO, *gorm.DB) {
+	db := newTestDB(t)
+	return NewGORMOutboxDAO(db), db
+}
+
+func outboxById(t *testing.T, db *gorm.DB, id int64) OutboxMessage {
+	var msg OutboxMessage
+	require.NoError(t, db.Where("id = ?", id).First(&msg).Error)
+	return msg
+}
+
+func TestGORMOutboxDAO_Preempt(t *testing.T) {
+	d, db := newTestOutboxDAO(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	stale := now - (time.Minute * 2).Milliseconds()
+	require.NoError(t, db.Create(&[]OutboxMessage{
+		{Id: 1, Topic: "a", NextTime: now, Ctime: now, Utime: now},
+		// 还没到重试的时间
+		{Id: 2, Topic: "a", NextTime: now + time.Hour.Milliseconds(), Ctime: now, Utime: now},
+		// 别的实例正在发送
+		{Id: 3, Topic: "a", Status: OutboxStatusSending, NextTime: now, Ctime: now, Utime: now},
+		// 抢占的实例崩溃了，超时之后可以重新抢占
+		{Id: 4, Topic: "a", Status: OutboxStatusSending, NextTime: now, Ctime: stale, Utime: stale},
+		{Id: 5, Topic: "a", Status: OutboxStatusSent, NextTime: now, Ctime: now, Utime: now},
+		{Id: 6, Topic: "a", Status: OutboxStatusFailed, NextTime: now, Ctime: now, Utime: now},
+	}).Error)
+
+	msgs, err := d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, int64(1), msgs[0].Id)
+	assert.Equal(t, int64(4), msgs[1].Id)
+	assert.Equal(t, OutboxStatusSending, outboxById(t, db, 1).Status)
+	assert.Greater(t, outboxById(t, db, 4).Utime, stale)
+
+	// 已经抢占了的，别的实例抢不到
+	msgs, err = d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+// TestGORMOutboxDAO_PreemptCAS 查出来之后被别的实例抢走了，CAS 失败，不能重复发送
+func TestGORMOutboxDAO_PreemptCAS(t *testing.T) {
+	d, db := newTestOutboxDAO(t)
+	now := time.Now().UnixMilli()
+	require.NoError(t, db.Create(&[]OutboxMessage{
+		{Id: 1, Topic: "a", NextTime: now, Ctime: now, Utime: now},
+		{Id: 2, Topic: "a", NextTime: now, Ctime: now, Utime: now},
+	}).Error)
+	// 在查询和更新之间，别的实例抢走了第二条
+	require.NoError(t, db.Callback().Query().After("gorm:query").
+		Register("test:steal", func(tx *gorm.DB) {
+			tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+				Exec("UPDATE outbox_messages SET status = ?, utime = ? WHERE id = 2",
+					OutboxStatusSending, now+1)
+		}))
+
+	msgs, err := d.Preempt(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, int64(1), msgs[0].Id)
+}
+
+func TestGORMOutboxDAO_MarkRetry(t *testing.T) {
+	d, db := newTestOutboxDAO(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	require.NoError(t, db.Create(&OutboxMessage{Id: 1, Topic: "a", NextTime: now, Ctime: now, Utime: now}).Error)
+	msgs, err := d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	next := time.Now().Add(time.Second * 5)
+	require.NoError(t, d.MarkRetry(ctx, 1, 1, next))
+	msg := outboxById(t, db, 1)
+	assert.Equal(t, OutboxStatusPending, msg.Status)
+	assert.Equal(t, 1, msg.Retries)
+	assert.Equal(t, next.UnixMilli(), msg.NextTime)
+	// 没到重试的时间不会被抢占
+	msgs, err = d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	// 到时间了
+	require.NoError(t, db.Model(&OutboxMessage{}).Where("id = 1").
+		Update("next_time", now-1).Error)
+	msgs, err = d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, 1, msgs[0].Retries)
+}
+
+func TestGORMOutboxDAO_MarkFailed(t *testing.T) {
+	d, db := newTestOutboxDAO(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	require.NoError(t, db.Create(&OutboxMessage{Id: 1, Topic: "a", NextTime: now, Ctime: now, Utime: now}).Error)
+	_, err := d.Preempt(ctx, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, d.MarkFailed(ctx, 1, 10))
+	msg := outboxById(t, db, 1)
+	assert.Equal(t, OutboxStatusFailed, msg.Status)
+	assert.Equal(t, 10, msg.Retries)
+	// 失败的消息要人工处理，不会再被抢占
+	msgs, err := d.Preempt(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrReviewNotFound 审核不存在，或者已经被处理过了
+	ErrReviewNotFound = errors.New("审核不存在或者已经处理过了")
+	// ErrReviewCanceled 审核之前作者又修改了文章，这次审核作废
+	ErrReviewCanceled = errors.New("文章已经被修改，审核已经作废")
+)
+
+// ArticleReview 发表审核的记录，审核队列按照提交的先后顺序处理
+type ArticleReview struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index"`
+	AuthorId  int64
+	// Version 提交审核的时候草稿的版本
+	Version int64
+	// Hits 命中的敏感词，JSON 数组
+	Hits       string `gorm:"type:varchar(1024)"`
+	Status     uint8  `gorm:"index:status_ctime,priority:1"`
+	Reason     string `gorm:"type:varchar(1024)"`
+	ReviewerId int64
+	Ctime      int64 `gorm:"index:status_ctime,priority:2"`
+	Utime      int64
+}
+
+func (a *ArticleGORMDAO) SubmitForReview(ctx context.Context, art Article, hits []string) (int64, int64, error) {
+	var (
+		id    = art.Id
+		owner = art.AuthorId
+	)
+	val, err := json.Marshal(hits)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if id > 0 {
+			owner, err = updateById(tx, art)
+		} else {
+			id, err = a.withTx(tx).Insert(ctx, art)
+		}
+		if err != nil {
+			return err
+		}
+		var cur Article
+		err = tx.Select("version").Where("id = ?", id).First(&cur).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		return tx.Create(&ArticleReview{
+			Id:        a.nextId(),
+			ArticleId: id,
+			AuthorId:  owner,
+			Version:   cur.Version,
+			Hits:      string(val),
+			Status:    domain.ReviewStatusPending.ToUint8(),
+			Ctime:     now,
+			Utime:     now,
+		}).Error
+	})
+	return id, owner, err
+}
+
+// cancelReviews 草稿被修改了，还没有处理的审核都作废，必须在事务里面调用
+func cancelReviews(tx *gorm.DB, aid int64) error {
+	return tx.Model(&ArticleReview{}).
+		Where("article_id = ? AND status = ?", aid, domain.ReviewStatusPending.ToUint8()).
+		Updates(map[string]any{
+			"status": domain.ReviewStatusCanceled.ToUint8(),
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+func (a *ArticleGORMDAO) ApproveReview(ctx context.Context, rid int64, reviewer int64, reason string,
+	render func(art Article) (PublishedArticle, error)) (Article, error) {
+	return a.review(ctx, rid, reviewer, reason, domain.ReviewStatusApproved,
+		func(tx *gorm.DB, art Article) (Article, error) {
+			now := time.Now().UnixMilli()
+			if art.PublishAt > now {
+				// 定时发表的文章，审核通过之后还是等到时间再发表
+				art.Status = domain.ArticleStatusScheduled.ToUint8()
+			} else {
+				art.Status = domain.ArticleStatusPublished.ToUint8()
+				art.PublishAt = 0
+			}
+			err := tx.Model(&Article{}).Where("id = ?", art.Id).
+				Updates(map[string]any{
+					"status":     art.Status,
+					"publish_at": art.PublishAt,
+					"utime":      now,
+				}).Error
+			if err != nil || art.Status == domain.ArticleStatusScheduled.ToUint8() {
+				return art, err
+			}
+			err = insertRevision(tx, art)
+			if err != nil {
+				return art, err
+			}
+			pub, err := render(art)
+			if err != nil {
+				return art, err
+			}
+			return art, a.syncPublished(tx, pub)
+		})
+}
+
+func (a *ArticleGORMDAO) RejectReview(ctx context.Context, rid int64, reviewer int64, reason string) (Article, error) {
+	return a.review(ctx, rid, reviewer, reason, domain.ReviewStatusRejected,
+		func(tx *gorm.DB, art Article) (Article, error) {
+			art.Status = domain.ArticleStatusRejected.ToUint8()
+			return art, tx.Model(&Article{}).Where("id = ?", art.Id).
+				Updates(map[string]any{
+					"status": art.Status,
+					"utime":  time.Now().UnixMilli(),
+				}).Error
+		})
+}
+
+// review 锁住还没有处理的审核，文章依旧是提交审核时候的版本才会调用 fn
+// 否则这次审核作废，返回 ErrReviewCanceled
+func (a *ArticleGORMDAO) review(ctx context.Context, rid int64, reviewer int64, reason string,
+	status domain.ReviewStatus, fn func(tx *gorm.DB, art Article) (Article, error)) (Article, error) {
+	var (
+		art      Article
+		canceled bool
+	)
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var r ArticleReview
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", rid, domain.ReviewStatusPending.ToUint8()).
+			First(&r).Error
+		if err == gorm.ErrRecordNotFound {
+			return ErrReviewNotFound
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Where("id = ?", r.ArticleId).First(&art).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		if art.Status != domain.ArticleStatusPendingReview.ToUint8() || art.Version != r.Version {
+			// 作者撤回或者删除了文章，作废之后提交事务
+			canceled = true
+			return tx.Model(&r).Updates(map[string]any{
+				"status": domain.ReviewStatusCanceled.ToUint8(),
+				"utime":  now,
+			}).Error
+		}
+		art, err = fn(tx, art)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&r).Updates(map[string]any{
+			"status":      status.ToUint8(),
+			"reviewer_id": reviewer,
+			"reason":      reason,
+			"utime":       now,
+		}).Error
+	})
+	if err == nil && canceled {
+		err = ErrReviewCanceled
+	}
+	return art, err
+}
+
+func (a *ArticleGORMDAO) ListPendingReviews(ctx context.Context, offset int, limit int) ([]ArticleReview, error) {
+	var res []ArticleReview
+	err := a.db.WithContext(ctx).
+		Where("status = ?", domain.ReviewStatusPending.ToUint8()).
+		Order("ctime ASC, id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (a *ArticleGORMDAO) GetLatestReview(ctx context.Context, aid int64) (ArticleReview, error) {
+	var res ArticleReview
+	err := a.db.WithContext(ctx).
+		Where("article_id = ?", aid).
+		Order("id DESC").
+		First(&res).Error
+	if err == gorm.ErrRecordNotFound {
+		return res, ErrReviewNotFound
+	}
+	return res, err
+}
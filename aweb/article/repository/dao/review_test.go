@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func renderForTest(art Article) (PublishedArticle, error) {
+	return PublishedArticle{Article: art, Html: "<p>" + art.Content + "</p>"}, nil
+}
+
+// submitForTest 编辑者把 prepareArticle 的文章提交审核
+func submitForTest(t *testing.T, d *ArticleGORMDAO, publishAt int64) (int64, int64) {
+	id := prepareArticle(t, d)
+	resId, owner, err := d.SubmitForReview(context.Background(), Article{
+		Id:        id,
+		Title:     "可疑的标题",
+		Content:   "可疑的内容",
+		AuthorId:  testEditor,
+		Status:    domain.ArticleStatusPendingReview.ToUint8(),
+		PublishAt: publishAt,
+	}, []string{"可疑"})
+	require.NoError(t, err)
+	assert.Equal(t, id, resId)
+	assert.Equal(t, testOwner, owner)
+	r, err := d.GetLatestReview(context.Background(), id)
+	require.NoError(t, err)
+	return id, r.Id
+}
+
+func TestArticleGORMDAO_SubmitForReview(t *testing.T) {
+	d, db := newTestDAO(t)
+	id, _ := submitForTest(t, d, 0)
+
+	r, err := d.GetLatestReview(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, testOwner, r.AuthorId)
+	assert.Equal(t, int64(2), r.Version)
+	assert.Equal(t, `["可疑"]`, r.Hits)
+	assert.Equal(t, domain.ReviewStatusPending.ToUint8(), r.Status)
+	// 审核通过之前不能进线上库
+	var cnt int64
+	require.NoError(t, db.Model(&PublishedArticle{}).Where("id = ?", id).Count(&cnt).Error)
+	assert.Zero(t, cnt)
+
+	reviews, err := d.ListPendingReviews(context.Background(), 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, reviews, 1)
+}
+
+func TestArticleGORMDAO_ApproveReview(t *testing.T) {
+	testCases := []struct {
+		name       string
+		publishAt  int64
+		wantStatus domain.ArticleStatus
+		wantPub    int64
+	}{
+		{
+			name:       "立刻发表",
+			wantStatus: domain.ArticleStatusPublished,
+			wantPub:    1,
+		},
+		{
+			name:       "还没到定时发表的时间",
+			publishAt:  time.Now().Add(time.Hour).UnixMilli(),
+			wantStatus: domain.ArticleStatusScheduled,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, db := newTestDAO(t)
+			id, rid := submitForTest(t, d, tc.publishAt)
+			art, err := d.ApproveReview(context.Background(), rid, 100, "", renderForTest)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus.ToUint8(), art.Status)
+			var cnt int64
+			require.NoError(t, db.Model(&PublishedArticle{}).Where("id = ?", id).Count(&cnt).Error)
+			assert.Equal(t, tc.wantPub, cnt)
+
+			r, err := d.GetLatestReview(context.Background(), id)
+			require.NoError(t, err)
+			assert.Equal(t, domain.ReviewStatusApproved.ToUint8(), r.Status)
+			assert.Equal(t, int64(100), r.ReviewerId)
+			// 处理过的不能再处理
+			_, err = d.RejectReview(context.Background(), rid, 100, "重复处理")
+			assert.Equal(t, ErrReviewNotFound, err)
+		})
+	}
+}
+
+func TestArticleGORMDAO_RejectReview(t *testing.T) {
+	d, db := newTestDAO(t)
+	id, rid := submitForTest(t, d, 0)
+	art, err := d.RejectReview(context.Background(), rid, 100, "包含广告")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ArticleStatusRejected.ToUint8(), art.Status)
+
+	var cur Article
+	require.NoError(t, db.Where("id = ?", id).First(&cur).Error)
+	assert.Equal(t, domain.ArticleStatusRejected.ToUint8(), cur.Status)
+	r, err := d.GetLatestReview(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReviewStatusRejected.ToUint8(), r.Status)
+	assert.Equal(t, "包含广告", r.Reason)
+}
+
+func TestArticleGORMDAO_Review_Canceled(t *testing.T) {
+	testCases := []struct {
+		name    string
+		change  func(t *testing.T, d *ArticleGORMDAO, db *gorm.DB, id int64)
+		wantErr error
+	}{
+		{
+			// 保存的时候就作废了
+			name:    "审核之前又保存了",
+			wantErr: ErrReviewNotFound,
+			change: func(t *testing.T, d *ArticleGORMDAO, db *gorm.DB, id int64) {
+				_, _, err := d.UpdateById(context.Background(), Article{
+					Id:       id,
+					Title:    "改过的标题",
+					Content:  "改过的内容",
+					AuthorId: testOwner,
+					Status:   domain.ArticleStatusUnpublished.ToUint8(),
+				})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "审核之前放进了回收站",
+			wantErr: ErrReviewCanceled,
+			change: func(t *testing.T, d *ArticleGORMDAO, db *gorm.DB, id int64) {
+				require.NoError(t, d.Delete(context.Background(), testOwner, id))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, db := newTestDAO(t)
+			id, rid := submitForTest(t, d, 0)
+			tc.change(t, d, db, id)
+			_, err := d.ApproveReview(context.Background(), rid, 100, "", renderForTest)
+			assert.Equal(t, tc.wantErr, err)
+
+			r, err := d.GetLatestReview(context.Background(), id)
+			require.NoError(t, err)
+			assert.Equal(t, domain.ReviewStatusCanceled.ToUint8(), r.Status)
+			var cnt int64
+			require.NoError(t, db.Model(&PublishedArticle{}).Where("id = ?", id).Count(&cnt).Error)
+			assert.Zero(t, cnt)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ArticleRevision 文章的历史版本，只插入，不修改
+type ArticleRevision struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index:article_id_ctime"`
+	// 保存这个版本的人
+	AuthorId int64
+	Title    string `gorm:"type=varchar(4096)"`
+	Content  string `gorm:"type=BLOB"`
+	Status   uint8
+	Ctime    int64 `gorm:"index:article_id_ctime"`
+}
+
+// insertRevision 在 tx 里面记录一个版本，要和文章本身的修改在同一个事务里面
+func insertRevision(tx *gorm.DB, art Article) error {
+	return tx.Create(&ArticleRevision{
+		ArticleId: art.Id,
+		AuthorId:  art.AuthorId,
+		Title:     art.Title,
+		Content:   art.Content,
+		Status:    art.Status,
+		Ctime:     time.Now().UnixMilli(),
+	}).Error
+}
+
+func (a *ArticleGORMDAO) ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error) {
+	var res []ArticleRevision
+	// 列表页不需要内容
+	err := a.db.WithContext(ctx).
+		Select("id", "article_id", "author_id", "title", "status", "ctime").
+		Where("article_id = ?", aid).
+		Order("id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (a *ArticleGORMDAO) GetRevision(ctx context.Context, aid int64, rid int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := a.db.WithContext(ctx).
+		Where("id = ? AND article_id = ?", rid, aid).
+		First(&res).Error
+	return res, err
+}
@@ -0,0 +1,288 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrSeriesNotFound = errors.New("系列不存在或者不是作者")
+	// ErrInvalidSeriesArticle 文章没有发表、不是作者的，或者已经在别的系列里面了
+	ErrInvalidSeriesArticle = errors.New("文章不能放进这个系列")
+)
+
+// Series 系列本身，章节在 SeriesArticle 里面
+type Series struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	AuthorId    int64  `gorm:"index"`
+	Title       string `gorm:"type=varchar(1024)"`
+	Description string `gorm:"type=varchar(4096)"`
+	// ArticleIds 按照章节顺序，从 SeriesArticle 里面查出来的
+	ArticleIds []int64 `gorm:"-"`
+	Ctime      int64
+	Utime      int64
+}
+
+// SeriesArticle 系列里面的一章，一篇文章最多只能属于一个系列
+type SeriesArticle struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	SeriesId  int64 `gorm:"index:series_position,priority:1"`
+	ArticleId int64 `gorm:"uniqueIndex"`
+	Position  int   `gorm:"index:series_position,priority:2"`
+	Ctime     int64
+}
+
+func (a *ArticleGORMDAO) InsertSeries(ctx context.Context, s Series) (int64, error) {
+	now := time.Now().UnixMilli()
+	s.Ctime = now
+	s.Utime = now
+	s.Id = a.nextId()
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&s).Error
+		if err != nil {
+			return err
+		}
+		for _, aid := range s.ArticleIds {
+			err = appendSeriesArticle(tx, s.AuthorId, s.Id, aid)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return s.Id, err
+}
+
+func (a *ArticleGORMDAO) UpdateSeries(ctx context.Context, s Series) error {
+	res := a.db.WithContext(ctx).Model(&Series{}).
+		Where("id = ? AND author_id = ?", s.Id, s.AuthorId).
+		Updates(map[string]any{
+			"title":       s.Title,
+			"description": s.Description,
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrSeriesNotFound
+	}
+	return nil
+}
+
+func (a *ArticleGORMDAO) DeleteSeries(ctx context.Context, uid int64, id int64) error {
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND author_id = ?", id, uid).Delete(&Series{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrSeriesNotFound
+		}
+		return tx.Where("series_id = ?", id).Delete(&SeriesArticle{}).Error
+	})
+}
+
+func (a *ArticleGORMDAO) GetSeries(ctx context.Context, id int64, onlyPublished bool) (Series, error) {
+	db := a.db.WithContext(ctx)
+	var s Series
+	err := db.Where("id = ?", id).First(&s).Error
+	if err == gorm.ErrRecordNotFound {
+		return s, ErrSeriesNotFound
+	}
+	if err != nil {
+		return s, err
+	}
+	res := []Series{s}
+	err = fillSeriesArticles(db, res, onlyPublished)
+	return res[0], err
+}
+
+func (a *ArticleGORMDAO) GetSeriesByArticle(ctx context.Context, aid int64) (Series, error) {
+	var sa SeriesArticle
+	err := a.db.WithContext(ctx).Where("article_id = ?", aid).First(&sa).Error
+	if err == gorm.ErrRecordNotFound {
+		return Series{}, ErrSeriesNotFound
+	}
+	if err != nil {
+		return Series{}, err
+	}
+	// 导航只能跳到还在线上的文章
+	return a.GetSeries(ctx, sa.SeriesId, true)
+}
+
+func (a *ArticleGORMDAO) ListSeriesByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Series, error) {
+	db := a.db.WithContext(ctx)
+	var res []Series
+	err := db.Where("author_id = ?", uid).
+		Order("utime DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	// 作者自己管理系列，下线了的文章也要看到
+	return res, fillSeriesArticles(db, res, false)
+}
+
+func (a *ArticleGORMDAO) AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := lockSeries(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		return appendSeriesArticle(tx, uid, id, aid)
+	})
+}
+
+func (a *ArticleGORMDAO) RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := lockSeries(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		res := tx.Where("series_id = ? AND article_id = ?", id, aid).Delete(&SeriesArticle{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrInvalidSeriesArticle
+		}
+		// 后面的章节留下一个空位也不影响顺序，不需要重新编号
+		return touchSeries(tx, id)
+	})
+}
+
+func (a *ArticleGORMDAO) ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error {
+	return a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := lockSeries(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		var cur []SeriesArticle
+		err = tx.Where("series_id = ?", id).Find(&cur).Error
+		if err != nil {
+			return err
+		}
+		// 只能调整顺序，文章必须和现在的一模一样
+		if len(cur) != len(aids) {
+			return ErrInvalidSeriesArticle
+		}
+		existing := make(map[int64]struct{}, len(cur))
+		for _, sa := range cur {
+			existing[sa.ArticleId] = struct{}{}
+		}
+		for _, aid := range aids {
+			if _, ok := existing[aid]; !ok {
+				return ErrInvalidSeriesArticle
+			}
+			// 重复的 ID 也会在这里被发现
+			delete(existing, aid)
+		}
+		for i, aid := range aids {
+			err = tx.Model(&SeriesArticle{}).
+				Where("series_id = ? AND article_id = ?", id, aid).
+				Update("position", i).Error
+			if err != nil {
+				return err
+			}
+		}
+		return touchSeries(tx, id)
+	})
+}
+
+// lockSeries 锁住系列，同一个系列的章节修改要排队，不然位置会乱
+func lockSeries(tx *gorm.DB, uid int64, id int64) error {
+	var s Series
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND author_id = ?", id, uid).
+		First(&s).Error
+	if err == gorm.ErrRecordNotFound {
+		return ErrSeriesNotFound
+	}
+	return err
+}
+
+func touchSeries(tx *gorm.DB, id int64) error {
+	return tx.Model(&Series{}).Where("id = ?", id).
+		Update("utime", time.Now().UnixMilli()).Error
+}
+
+// appendSeriesArticle 把作者已经发表的文章加到系列的最后
+func appendSeriesArticle(tx *gorm.DB, uid int64, id int64, aid int64) error {
+	var cnt int64
+	err := tx.Model(&PublishedArticle{}).
+		Where("id = ? AND author_id = ? AND status = ?",
+			aid, uid, domain.ArticleStatusPublished.ToUint8()).
+		Count(&cnt).Error
+	if err != nil {
+		return err
+	}
+	if cnt == 0 {
+		return ErrInvalidSeriesArticle
+	}
+	err = tx.Model(&SeriesArticle{}).Where("article_id = ?", aid).Count(&cnt).Error
+	if err != nil {
+		return err
+	}
+	if cnt > 0 {
+		return ErrInvalidSeriesArticle
+	}
+	var pos struct {
+		Max *int
+	}
+	err = tx.Model(&SeriesArticle{}).Select("MAX(position) AS max").
+		Where("series_id = ?", id).Scan(&pos).Error
+	if err != nil {
+		return err
+	}
+	position := 0
+	if pos.Max != nil {
+		position = *pos.Max + 1
+	}
+	err = tx.Create(&SeriesArticle{
+		SeriesId:  id,
+		ArticleId: aid,
+		Position:  position,
+		Ctime:     time.Now().UnixMilli(),
+	}).Error
+	if err != nil {
+		return err
+	}
+	return touchSeries(tx, id)
+}
+
+// fillSeriesArticles onlyPublished 为 true 的时候跳过已经不在线上的文章
+func fillSeriesArticles(db *gorm.DB, series []Series, onlyPublished bool) error {
+	if len(series) == 0 {
+		return nil
+	}
+	ids := slice.Map(series, func(idx int, src Series) int64 {
+		return src.Id
+	})
+	query := db.Model(&SeriesArticle{}).Where("series_id IN ?", ids)
+	if onlyPublished {
+		query = query.Where("article_id IN (?)",
+			db.Model(&PublishedArticle{}).Select("id").
+				Where("status = ?", domain.ArticleStatusPublished.ToUint8()))
+	}
+	var sas []SeriesArticle
+	err := query.Order("position ASC").Find(&sas).Error
+	if err != nil {
+		return err
+	}
+	m := make(map[int64][]int64, len(series))
+	for _, sa := range sas {
+		m[sa.SeriesId] = append(m[sa.SeriesId], sa.ArticleId)
+	}
+	for i := range series {
+		series[i].ArticleIds = m[series[i].Id]
+	}
+	return nil
+}
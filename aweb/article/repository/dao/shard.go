@@ -0,0 +1,538 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/snowflake"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+// ShardedArticleDAO 按照作者把文章分到多个库
+// 一篇文章的所有数据，包括标签、历史版本、审核、协作者、附件引用和本地消息表，
+// 都和文章在同一个库，所以事务不会跨库。系列跟着作者走。
+// 文章、系列和审核的 id 里面编码了分片号，只知道 id 也能找到分片；
+// 分库之前的老数据是自增 id，要挨个分片去找，找到之后记下来
+type ShardedArticleDAO struct {
+	shards []*ArticleGORMDAO
+	// located 老数据在哪个分片，key 是 locateKey
+	located sync.Map
+}
+
+type locateKey struct {
+	table string
+	id    int64
+}
+
+// NewShardedArticleDAO dbs 的下标就是分片号，顺序不能变
+// 分片数量变了要先用迁移工具把数据搬到新的分片
+func NewShardedArticleDAO(dbs []*gorm.DB, ids *snowflake.Generator) ArticleDAO {
+	shards := make([]*ArticleGORMDAO, 0, len(dbs))
+	for i, db := range dbs {
+		shard := int64(i)
+		shards = append(shards, &ArticleGORMDAO{
+			db: db,
+			ids: func() int64 {
+				return ids.Next(shard)
+			},
+		})
+	}
+	return &ShardedArticleDAO{shards: shards}
+}
+
+// ShardOfAuthor 作者的数据在哪个分片
+func ShardOfAuthor(uid int64, n int) int {
+	return int(uid % int64(n))
+}
+
+func (s *ShardedArticleDAO) byAuthor(uid int64) *ArticleGORMDAO {
+	return s.shards[ShardOfAuthor(uid, len(s.shards))]
+}
+
+// byId 找到 model 对应的表里面 id 所在的分片
+// 哪个分片都没有的话交给第一个分片，这样返回的错误和不分库的时候一样
+func (s *ShardedArticleDAO) byId(ctx context.Context, model any, id int64) (*ArticleGORMDAO, error) {
+	if shard, ok := snowflake.ShardOf(id); ok {
+		if shard >= int64(len(s.shards)) {
+			return s.shards[0], nil
+		}
+		return s.shards[shard], nil
+	}
+	key := locateKey{table: fmt.Sprintf("%T", model), id: id}
+	if val, ok := s.located.Load(key); ok {
+		return s.shards[val.(int)], nil
+	}
+	for i, shard := range s.shards {
+		var cnt int64
+		err := shard.db.WithContext(ctx).Model(model).Where("id = ?", id).Count(&cnt).Error
+		if err != nil {
+			return nil, err
+		}
+		if cnt > 0 {
+			s.located.Store(key, i)
+			return shard, nil
+		}
+	}
+	return s.shards[0], nil
+}
+
+// gather 在所有分片上并发执行 fn，结果按照分片的顺序拼在一起
+func gather[T any](ctx context.Context, shards []*ArticleGORMDAO,
+	fn func(ctx context.Context, shard *ArticleGORMDAO) ([]T, error)) ([]T, error) {
+	res := make([][]T, len(shards))
+	eg, ctx := errgroup.WithContext(ctx)
+	for i, shard := range shards {
+		eg.Go(func() error {
+			var err error
+			res[i], err = fn(ctx, shard)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	var all []T
+	for _, r := range res {
+		all = append(all, r...)
+	}
+	return all, nil
+}
+
+// window 排序之后取 [offset, offset+limit)
+func window[T any](all []T, less func(a, b T) bool, offset int, limit int) []T {
+	sort.SliceStable(all, func(i, j int) bool {
+		return less(all[i], all[j])
+	})
+	if offset >= len(all) {
+		return []T{}
+	}
+	return all[offset:min(offset+limit, len(all))]
+}
+
+// newerFirst 和单库的 utime DESC, id DESC 一致
+func newerFirst(a, b Article) bool {
+	if a.Utime != b.Utime {
+		return a.Utime > b.Utime
+	}
+	return a.Id > b.Id
+}
+
+func (s *ShardedArticleDAO) Insert(ctx context.Context, art Article) (int64, error) {
+	return s.byAuthor(art.AuthorId).Insert(ctx, art)
+}
+
+func (s *ShardedArticleDAO) UpdateById(ctx context.Context, art Article) (int64, int64, error) {
+	// art.AuthorId 可能是协作者，只能按照文章 id 找
+	shard, err := s.byId(ctx, &Article{}, art.Id)
+	if err != nil {
+		return 0, 0, err
+	}
+	return shard.UpdateById(ctx, art)
+}
+
+func (s *ShardedArticleDAO) Sync(ctx context.Context, art PublishedArticle) (int64, int64, error) {
+	shard := s.byAuthor(art.AuthorId)
+	if art.Id > 0 {
+		var err error
+		shard, err = s.byId(ctx, &Article{}, art.Id)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return shard.Sync(ctx, art)
+}
+
+func (s *ShardedArticleDAO) SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return err
+	}
+	return shard.SyncStatus(ctx, uid, id, status)
+}
+
+func (s *ShardedArticleDAO) GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]Article, error) {
+	return s.byAuthor(uid).GetByAuthor(ctx, uid, filter, offset, limit)
+}
+
+func (s *ShardedArticleDAO) GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]Article, error) {
+	return s.byAuthor(uid).GetByAuthorByCursor(ctx, uid, filter, utime, id, limit)
+}
+
+func (s *ShardedArticleDAO) GetById(ctx context.Context, id int64) (Article, error) {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return Article{}, err
+	}
+	return shard.GetById(ctx, id)
+}
+
+func (s *ShardedArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return PublishedArticle{}, err
+	}
+	return shard.GetPubById(ctx, id)
+}
+
+// GetPubByIds 按照 id 里面的分片分组，每个分片只查一次
+// 迁移之前的老 id 看不出分片，只能每个分片都查一下
+func (s *ShardedArticleDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	groups := make(map[*ArticleGORMDAO][]int64, len(s.shards))
+	var unknown []int64
+	for _, id := range ids {
+		if shard, ok := snowflake.ShardOf(id); ok && shard < int64(len(s.shards)) {
+			groups[s.shards[shard]] = append(groups[s.shards[shard]], id)
+			continue
+		}
+		unknown = append(unknown, id)
+	}
+	if len(unknown) > 0 {
+		for _, shard := range s.shards {
+			groups[shard] = append(groups[shard], unknown...)
+		}
+	}
+	return gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]PublishedArticle, error) {
+		if len(groups[shard]) == 0 {
+			return nil, nil
+		}
+		return shard.GetPubByIds(ctx, groups[shard])
+	})
+}
+
+// ListPub 每个分片都要查 offset+limit 条再归并，offset 越大越慢，翻页尽量用游标
+func (s *ShardedArticleDAO) ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset int, limit int) ([]PublishedArticle, error) {
+	all, err := gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]PublishedArticle, error) {
+		return shard.ListPub(ctx, start, filter, 0, offset+limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window(all, func(a, b PublishedArticle) bool {
+		return newerFirst(a.Article, b.Article)
+	}, offset, limit), nil
+}
+
+func (s *ShardedArticleDAO) ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, utime int64, id int64, limit int) ([]PublishedArticle, error) {
+	all, err := gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]PublishedArticle, error) {
+		return shard.ListPubByCursor(ctx, start, filter, utime, id, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window(all, func(a, b PublishedArticle) bool {
+		return newerFirst(a.Article, b.Article)
+	}, 0, limit), nil
+}
+
+func (s *ShardedArticleDAO) Delete(ctx context.Context, uid int64, id int64) error {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return err
+	}
+	return shard.Delete(ctx, uid, id)
+}
+
+func (s *ShardedArticleDAO) Restore(ctx context.Context, uid int64, id int64) error {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return err
+	}
+	return shard.Restore(ctx, uid, id)
+}
+
+func (s *ShardedArticleDAO) ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+	return s.byAuthor(uid).ListTrash(ctx, uid, offset, limit)
+}
+
+func (s *ShardedArticleDAO) ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]Article, error) {
+	all, err := gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]Article, error) {
+		return shard.ListExpiredTrash(ctx, before, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window(all, func(a, b Article) bool {
+		return a.Dtime < b.Dtime
+	}, 0, limit), nil
+}
+
+func (s *ShardedArticleDAO) Purge(ctx context.Context, ids []int64, before time.Time) ([]Article, error) {
+	byShard := make([][]int64, len(s.shards))
+	for _, id := range ids {
+		shard, ok := snowflake.ShardOf(id)
+		if !ok {
+			// 老数据不知道在哪个分片，每个分片都删一遍，不在的删不到
+			for i := range byShard {
+				byShard[i] = append(byShard[i], id)
+			}
+			continue
+		}
+		if shard < int64(len(s.shards)) {
+			byShard[shard] = append(byShard[shard], id)
+		}
+	}
+	var res []Article
+	for i, shard := range s.shards {
+		if len(byShard[i]) == 0 {
+			continue
+		}
+		arts, err := shard.Purge(ctx, byShard[i], before)
+		if err != nil {
+			// 前面分片已经删掉的也要返回，调用方要据此清理别的服务的数据
+			return res, err
+		}
+		res = append(res, arts...)
+	}
+	return res, nil
+}
+
+func (s *ShardedArticleDAO) ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error) {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return nil, err
+	}
+	return shard.ListRevisions(ctx, aid, offset, limit)
+}
+
+func (s *ShardedArticleDAO) GetRevision(ctx context.Context, aid int64, rid int64) (ArticleRevision, error) {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return ArticleRevision{}, err
+	}
+	return shard.GetRevision(ctx, aid, rid)
+}
+
+func (s *ShardedArticleDAO) ListScheduled(ctx context.Context, startId int64, limit int) ([]Article, error) {
+	all, err := gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]Article, error) {
+		return shard.ListScheduled(ctx, startId, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window(all, func(a, b Article) bool {
+		return a.Id < b.Id
+	}, 0, limit), nil
+}
+
+func (s *ShardedArticleDAO) PublishScheduled(ctx context.Context, id int64, render func(art Article) (PublishedArticle, error)) (bool, error) {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return false, err
+	}
+	return shard.PublishScheduled(ctx, id, render)
+}
+
+func (s *ShardedArticleDAO) CancelScheduled(ctx context.Context, uid int64, id int64) error {
+	shard, err := s.byId(ctx, &Article{}, id)
+	if err != nil {
+		return err
+	}
+	return shard.CancelScheduled(ctx, uid, id)
+}
+
+func (s *ShardedArticleDAO) SubmitForReview(ctx context.Context, art Article, hits []string) (int64, int64, error) {
+	shard := s.byAuthor(art.AuthorId)
+	if art.Id > 0 {
+		var err error
+		shard, err = s.byId(ctx, &Article{}, art.Id)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return shard.SubmitForReview(ctx, art, hits)
+}
+
+func (s *ShardedArticleDAO) ApproveReview(ctx context.Context, rid int64, reviewer int64, reason string,
+	render func(art Article) (PublishedArticle, error)) (Article, error) {
+	shard, err := s.byId(ctx, &ArticleReview{}, rid)
+	if err != nil {
+		return Article{}, err
+	}
+	return shard.ApproveReview(ctx, rid, reviewer, reason, render)
+}
+
+func (s *ShardedArticleDAO) RejectReview(ctx context.Context, rid int64, reviewer int64, reason string) (Article, error) {
+	shard, err := s.byId(ctx, &ArticleReview{}, rid)
+	if err != nil {
+		return Article{}, err
+	}
+	return shard.RejectReview(ctx, rid, reviewer, reason)
+}
+
+func (s *ShardedArticleDAO) ListPendingReviews(ctx context.Context, offset int, limit int) ([]ArticleReview, error) {
+	all, err := gather(ctx, s.shards, func(ctx context.Context, shard *ArticleGORMDAO) ([]ArticleReview, error) {
+		return shard.ListPendingReviews(ctx, 0, offset+limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window(all, func(a, b ArticleReview) bool {
+		if a.Ctime != b.Ctime {
+			return a.Ctime < b.Ctime
+		}
+		return a.Id < b.Id
+	}, offset, limit), nil
+}
+
+func (s *ShardedArticleDAO) GetLatestReview(ctx context.Context, aid int64) (ArticleReview, error) {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return ArticleReview{}, err
+	}
+	return shard.GetLatestReview(ctx, aid)
+}
+
+func (s *ShardedArticleDAO) UpsertCollaborator(ctx context.Context, uid int64, c ArticleCollaborator) error {
+	shard, err := s.byId(ctx, &Article{}, c.ArticleId)
+	if err != nil {
+		return err
+	}
+	return shard.UpsertCollaborator(ctx, uid, c)
+}
+
+func (s *ShardedArticleDAO) DeleteCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return err
+	}
+	return shard.DeleteCollaborator(ctx, uid, aid, collaborator)
+}
+
+func (s *ShardedArticleDAO) ListCollaborators(ctx context.Context, uid int64, aid int64) ([]ArticleCollaborator, error) {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return nil, err
+	}
+	return shard.ListCollaborators(ctx, uid, aid)
+}
+
+func (s *ShardedArticleDAO) InsertSeries(ctx context.Context, series Series) (int64, error) {
+	return s.byAuthor(series.AuthorId).InsertSeries(ctx, series)
+}
+
+func (s *ShardedArticleDAO) UpdateSeries(ctx context.Context, series Series) error {
+	return s.byAuthor(series.AuthorId).UpdateSeries(ctx, series)
+}
+
+func (s *ShardedArticleDAO) DeleteSeries(ctx context.Context, uid int64, id int64) error {
+	return s.byAuthor(uid).DeleteSeries(ctx, uid, id)
+}
+
+func (s *ShardedArticleDAO) GetSeries(ctx context.Context, id int64, onlyPublished bool) (Series, error) {
+	shard, err := s.byId(ctx, &Series{}, id)
+	if err != nil {
+		return Series{}, err
+	}
+	return shard.GetSeries(ctx, id, onlyPublished)
+}
+
+func (s *ShardedArticleDAO) GetSeriesByArticle(ctx context.Context, aid int64) (Series, error) {
+	shard, err := s.byId(ctx, &Article{}, aid)
+	if err != nil {
+		return Series{}, err
+	}
+	return shard.GetSeriesByArticle(ctx, aid)
+}
+
+func (s *ShardedArticleDAO) ListSeriesByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Series, error) {
+	return s.byAuthor(uid).ListSeriesByAuthor(ctx, uid, offset, limit)
+}
+
+func (s *ShardedArticleDAO) AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return s.byAuthor(uid).AddSeriesArticle(ctx, uid, id, aid)
+}
+
+func (s *ShardedArticleDAO) RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return s.byAuthor(uid).RemoveSeriesArticle(ctx, uid, id, aid)
+}
+
+func (s *ShardedArticleDAO) ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error {
+	return s.byAuthor(uid).ReorderSeries(ctx, uid, id, aids)
+}
+
+// ShardedAttachmentDAO 附件本身不分库，但是文章对附件的引用跟着文章分到了各个分片
+// 判断附件有没有被引用要把所有分片都查一遍
+type ShardedAttachmentDAO struct {
+	*GORMAttachmentDAO
+	shards []*gorm.DB
+}
+
+func NewShardedAttachmentDAO(db *gorm.DB, shards []*gorm.DB) AttachmentDAO {
+	return &ShardedAttachmentDAO{
+		GORMAttachmentDAO: &GORMAttachmentDAO{db: db},
+		shards:            shards,
+	}
+}
+
+// ListOrphans 被分片引用的附件会排在前面挡住后面的孤儿，所以要一直往后翻，
+// 直到凑够 limit 个或者翻完
+func (s *ShardedAttachmentDAO) ListOrphans(ctx context.Context, before time.Time, limit int) ([]Attachment, error) {
+	res := make([]Attachment, 0, limit)
+	for offset := 0; len(res) < limit; offset += limit {
+		var candidates []Attachment
+		err := orphans(s.db.WithContext(ctx), before).
+			Order("utime ASC, id ASC").
+			Offset(offset).Limit(limit).
+			Find(&candidates).Error
+		if err != nil {
+			return nil, err
+		}
+		refs, err := s.referenced(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+		for _, att := range candidates {
+			if _, ok := refs[att.Id]; !ok && len(res) < limit {
+				res = append(res, att)
+			}
+		}
+		if len(candidates) < limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+// DeleteOrphan 先确认所有分片都没有引用再删
+// 检查和删除不在同一个事务里面，中间被引用的概率靠回收的宽限期来兜底
+func (s *ShardedAttachmentDAO) DeleteOrphan(ctx context.Context, id int64, before time.Time) (bool, error) {
+	refs, err := s.referenced(ctx, []Attachment{{Id: id}})
+	if err != nil {
+		return false, err
+	}
+	if len(refs) > 0 {
+		return false, nil
+	}
+	return s.GORMAttachmentDAO.DeleteOrphan(ctx, id, before)
+}
+
+// referenced 返回 atts 里面被任何一个分片引用了的附件
+func (s *ShardedAttachmentDAO) referenced(ctx context.Context, atts []Attachment) (map[int64]struct{}, error) {
+	res := make(map[int64]struct{})
+	if len(atts) == 0 {
+		return res, nil
+	}
+	ids := make([]int64, 0, len(atts))
+	for _, att := range atts {
+		ids = append(ids, att.Id)
+	}
+	for _, shard := range s.shards {
+		var found []int64
+		err := shard.WithContext(ctx).Model(&ArticleAttachment{}).
+			Distinct("attachment_id").
+			Where("attachment_id IN ?", ids).
+			Pluck("attachment_id", &found).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			res[id] = struct{}{}
+		}
+	}
+	return res, nil
+}
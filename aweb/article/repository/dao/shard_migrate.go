@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MigrateToShards 把 src 里面的文章搬到 dsts，按照作者分片，id 保持不变
+// 按照 id 从 fromId 开始一批一批地搬，每批结束调用 progress 汇报最后一个 id，
+// 中断之后可以从汇报的 id 继续。重复搬同一篇文章会覆盖，所以可以反复执行。
+// 搬迁期间不要写入，本地消息表也不搬，开始之前要等它发完
+func MigrateToShards(ctx context.Context, src *gorm.DB, dsts []*gorm.DB,
+	batch int, fromId int64, progress func(lastId int64)) error {
+	for {
+		var arts []Article
+		err := src.WithContext(ctx).Where("id > ?", fromId).
+			Order("id ASC").Limit(batch).Find(&arts).Error
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			dst := dsts[ShardOfAuthor(art.AuthorId, len(dsts))]
+			err = dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				return migrateArticle(src.WithContext(ctx), tx, art)
+			})
+			if err != nil {
+				return err
+			}
+			fromId = art.Id
+		}
+		if len(arts) > 0 && progress != nil {
+			progress(fromId)
+		}
+		if len(arts) < batch {
+			break
+		}
+	}
+	return migrateSeries(ctx, src, dsts, batch)
+}
+
+func migrateArticle(src *gorm.DB, tx *gorm.DB, art Article) error {
+	err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&art).Error
+	if err != nil {
+		return err
+	}
+	tags, err := findTags(src, articleTagTable, []int64{art.Id})
+	if err != nil {
+		return err
+	}
+	err = replaceTags(tx, articleTagTable, art.Id, tags[art.Id])
+	if err != nil {
+		return err
+	}
+
+	var pub PublishedArticle
+	err = src.Where("id = ?", art.Id).Limit(1).Find(&pub).Error
+	if err != nil {
+		return err
+	}
+	if pub.Id > 0 {
+		err = tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&pub).Error
+		if err != nil {
+			return err
+		}
+	}
+	tags, err = findTags(src, publishedArticleTagTable, []int64{art.Id})
+	if err != nil {
+		return err
+	}
+	err = replaceTags(tx, publishedArticleTagTable, art.Id, tags[art.Id])
+	if err != nil {
+		return err
+	}
+
+	return copyRows(src, tx, art.Id,
+		&[]ArticleRevision{}, &[]ArticleReview{},
+		&[]ArticleCollaborator{}, &[]ArticleAttachment{})
+}
+
+// copyRows 把文章的附属数据原样复制过去，已经复制过的跳过
+func copyRows(src *gorm.DB, tx *gorm.DB, aid int64, rows ...any) error {
+	for _, row := range rows {
+		err := src.Where("article_id = ?", aid).Find(row).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(row, 100).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateSeries 系列和作者的文章在同一个分片
+func migrateSeries(ctx context.Context, src *gorm.DB, dsts []*gorm.DB, batch int) error {
+	var fromId int64
+	for {
+		var series []Series
+		err := src.WithContext(ctx).Where("id > ?", fromId).
+			Order("id ASC").Limit(batch).Find(&series).Error
+		if err != nil {
+			return err
+		}
+		for _, s := range series {
+			dst := dsts[ShardOfAuthor(s.AuthorId, len(dsts))]
+			err = dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&s).Error
+				if err != nil {
+					return err
+				}
+				err = tx.Where("series_id = ?", s.Id).Delete(&SeriesArticle{}).Error
+				if err != nil {
+					return err
+				}
+				var chapters []SeriesArticle
+				err = src.WithContext(ctx).Where("series_id = ?", s.Id).Find(&chapters).Error
+				if err != nil || len(chapters) == 0 {
+					return err
+				}
+				return tx.Create(&chapters).Error
+			})
+			if err != nil {
+				return err
+			}
+			fromId = s.Id
+		}
+		if len(series) < batch {
+			return nil
+		}
+	}
+}
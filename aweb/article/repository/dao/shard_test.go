@@ -0,0 +1,410 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/snowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB 每次调用都是一个单独的 SQLite 文件，建好所有的表
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "article.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, InitTables(db))
+	return db
+}
+
+func newTestShards(t *testing.T, n int) (*ShardedArticleDAO, []*gorm.DB) {
+	dbs := make([]*gorm.DB, 0, n)
+	for i := 0; i < n; i++ {
+		dbs = append(dbs, newTestDB(t))
+	}
+	gen, err := snowflake.NewGenerator(1)
+	require.NoError(t, err)
+	return NewShardedArticleDAO(dbs, gen).(*ShardedArticleDAO), dbs
+}
+
+func countIn(t *testing.T, db *gorm.DB, model any, id int64) int64 {
+	var cnt int64
+	require.NoError(t, db.Model(model).Where("id = ?", id).Count(&cnt).Error)
+	return cnt
+}
+
+func TestShardedArticleDAO_RouteByAuthor(t *testing.T) {
+	d, dbs := newTestShards(t, 2)
+	ctx := context.Background()
+	for uid := int64(1); uid <= 4; uid++ {
+		id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: uid, Tags: []string{"go"}})
+		require.NoError(t, err)
+		shard, ok := snowflake.ShardOf(id)
+		require.True(t, ok)
+		assert.Equal(t, int64(uid%2), shard)
+		assert.Equal(t, int64(1), countIn(t, dbs[uid%2], &Article{}, id))
+		assert.Zero(t, countIn(t, dbs[(uid+1)%2], &Article{}, id))
+
+		// 只知道 id 也能找到
+		art, err := d.GetById(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, uid, art.AuthorId)
+		assert.Equal(t, []string{"go"}, art.Tags)
+
+		arts, err := d.GetByAuthor(ctx, uid, domain.ArticleFilter{}, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, arts, 1)
+	}
+	_, err := d.GetById(ctx, 12345)
+	assert.ErrorIs(t, err, ErrArticleNotFound)
+}
+
+func TestShardedArticleDAO_Collaborator(t *testing.T) {
+	d, _ := newTestShards(t, 3)
+	ctx := context.Background()
+	// 作者和协作者不在同一个分片，修改要落在作者的分片上
+	id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: testOwner})
+	require.NoError(t, err)
+	require.NoError(t, d.UpsertCollaborator(ctx, testOwner, ArticleCollaborator{
+		ArticleId: id,
+		Uid:       testEditor,
+		Role:      domain.CollaboratorRoleEditor.ToUint8(),
+	}))
+	owner, _, err := d.UpdateById(ctx, Article{Id: id, Title: "新标题", Content: "新内容", AuthorId: testEditor})
+	require.NoError(t, err)
+	assert.Equal(t, testOwner, owner)
+
+	_, owner, err = d.Sync(ctx, PublishedArticle{Article: Article{
+		Id: id, Title: "新标题", Content: "新内容", AuthorId: testEditor,
+		Status: domain.ArticleStatusPublished.ToUint8(),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, testOwner, owner)
+	pub, err := d.GetPubById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "新标题", pub.Title)
+	assert.Equal(t, testOwner, pub.AuthorId)
+}
+
+func TestShardedArticleDAO_ListPub(t *testing.T) {
+	d, _ := newTestShards(t, 3)
+	ctx := context.Background()
+	var ids []int64
+	for i := int64(0); i < 7; i++ {
+		id, _, err := d.Sync(ctx, PublishedArticle{Article: Article{
+			Title: "标题", Content: "内容", AuthorId: i + 1,
+			Status: domain.ArticleStatusPublished.ToUint8(),
+		}})
+		require.NoError(t, err)
+		ids = append(ids, id)
+		// 保证 utime 不一样
+		time.Sleep(time.Millisecond * 2)
+	}
+	start := time.Now().Add(time.Second)
+
+	var got []int64
+	for offset := 0; offset < 8; offset += 3 {
+		arts, err := d.ListPub(ctx, start, domain.ArticleFilter{}, offset, 3)
+		require.NoError(t, err)
+		for _, art := range arts {
+			got = append(got, art.Id)
+		}
+	}
+	want := make([]int64, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		want = append(want, ids[i])
+	}
+	assert.Equal(t, want, got)
+
+	got = got[:0]
+	var utime, id int64
+	for {
+		arts, err := d.ListPubByCursor(ctx, start, domain.ArticleFilter{}, utime, id, 3)
+		require.NoError(t, err)
+		if len(arts) == 0 {
+			break
+		}
+		for _, art := range arts {
+			got = append(got, art.Id)
+		}
+		last := arts[len(arts)-1]
+		utime, id = last.Utime, last.Id
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestShardedArticleDAO_GetPubByIds(t *testing.T) {
+	d, dbs := newTestShards(t, 3)
+	ctx := context.Background()
+	var ids []int64
+	for uid := int64(1); uid <= 4; uid++ {
+		id, _, err := d.Sync(ctx, PublishedArticle{Article: Article{
+			Title: "标题", Content: "内容", AuthorId: uid, Tags: []string{"go"},
+			Status: domain.ArticleStatusPublished.ToUint8(),
+		}})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	// 下线了的不返回
+	require.NoError(t, d.SyncStatus(ctx, 4, ids[3], domain.ArticleStatusPrivate.ToUint8()))
+	// 迁移之前的老 id，看不出在哪个分片
+	const legacy = int64(42)
+	require.NoError(t, dbs[2].Create(&PublishedArticle{Article: Article{
+		Id: legacy, Title: "老文章", Content: "内容", AuthorId: 5,
+		Status: domain.ArticleStatusPublished.ToUint8(),
+	}}).Error)
+
+	arts, err := d.GetPubByIds(ctx, append(ids, legacy, 12345))
+	require.NoError(t, err)
+	got := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		got = append(got, art.Id)
+		if art.Id != legacy {
+			assert.Equal(t, []string{"go"}, art.Tags)
+		}
+	}
+	assert.ElementsMatch(t, []int64{ids[0], ids[1], ids[2], legacy}, got)
+}
+
+func TestShardedArticleDAO_ListPubFilter(t *testing.T) {
+	d, _ := newTestShards(t, 2)
+	ctx := context.Background()
+	arts := []Article{
+		{Title: "a", Content: "内容", AuthorId: 1, Tags: []string{"go"}, Category: "backend"},
+		{Title: "b", Content: "内容", AuthorId: 2, Tags: []string{"go"}, Category: "frontend"},
+		{Title: "c", Content: "内容", AuthorId: 1, Tags: []string{"rust"}, Category: "backend"},
+	}
+	ids := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		art.Status = domain.ArticleStatusPublished.ToUint8()
+		id, _, err := d.Sync(ctx, PublishedArticle{Article: art})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	start := time.Now().Add(time.Second)
+	testCases := []struct {
+		name   string
+		filter domain.ArticleFilter
+		want   []int64
+	}{
+		{name: "分类", filter: domain.ArticleFilter{Category: "backend"}, want: []int64{ids[0], ids[2]}},
+		{name: "标签", filter: domain.ArticleFilter{Tag: "go"}, want: []int64{ids[0], ids[1]}},
+		{name: "标签和分类", filter: domain.ArticleFilter{Tag: "go", Category: "frontend"}, want: []int64{ids[1]}},
+		{name: "没有命中", filter: domain.ArticleFilter{Category: "mobile"}, want: []int64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := d.ListPub(ctx, start, tc.filter, 0, 10)
+			require.NoError(t, err)
+			got := make([]int64, 0, len(res))
+			for _, art := range res {
+				got = append(got, art.Id)
+			}
+			assert.ElementsMatch(t, tc.want, got)
+
+			mine, err := d.GetByAuthor(ctx, 1, tc.filter, 0, 10)
+			require.NoError(t, err)
+			for _, art := range mine {
+				assert.Equal(t, int64(1), art.AuthorId)
+				if tc.filter.Category != "" {
+					assert.Equal(t, tc.filter.Category, art.Category)
+				}
+			}
+		})
+	}
+}
+
+func TestShardedArticleDAO_Series(t *testing.T) {
+	d, _ := newTestShards(t, 2)
+	ctx := context.Background()
+	aid, _, err := d.Sync(ctx, PublishedArticle{Article: Article{
+		Title: "标题", Content: "内容", AuthorId: testOwner,
+		Status: domain.ArticleStatusPublished.ToUint8(),
+	}})
+	require.NoError(t, err)
+	sid, err := d.InsertSeries(ctx, Series{AuthorId: testOwner, Title: "系列"})
+	require.NoError(t, err)
+	require.NoError(t, d.AddSeriesArticle(ctx, testOwner, sid, aid))
+
+	s, err := d.GetSeries(ctx, sid, true)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{aid}, s.ArticleIds)
+	s, err = d.GetSeriesByArticle(ctx, aid)
+	require.NoError(t, err)
+	assert.Equal(t, sid, s.Id)
+}
+
+func TestShardedArticleDAO_Review(t *testing.T) {
+	d, _ := newTestShards(t, 2)
+	ctx := context.Background()
+	var aids []int64
+	for uid := int64(1); uid <= 2; uid++ {
+		aid, _, err := d.SubmitForReview(ctx, Article{
+			Title: "可疑的标题", Content: "可疑的内容", AuthorId: uid,
+			Status: domain.ArticleStatusPendingReview.ToUint8(),
+		}, []string{"可疑"})
+		require.NoError(t, err)
+		aids = append(aids, aid)
+		time.Sleep(time.Millisecond * 2)
+	}
+	reviews, err := d.ListPendingReviews(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, aids[0], reviews[0].ArticleId)
+	assert.Equal(t, aids[1], reviews[1].ArticleId)
+
+	art, err := d.ApproveReview(ctx, reviews[1].Id, testStranger, "", renderForTest)
+	require.NoError(t, err)
+	assert.Equal(t, aids[1], art.Id)
+	_, err = d.GetPubById(ctx, aids[1])
+	require.NoError(t, err)
+}
+
+func TestShardedArticleDAO_Purge(t *testing.T) {
+	d, dbs := newTestShards(t, 2)
+	ctx := context.Background()
+	var ids []int64
+	for uid := int64(1); uid <= 2; uid++ {
+		id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: uid})
+		require.NoError(t, err)
+		require.NoError(t, d.Delete(ctx, uid, id))
+		ids = append(ids, id)
+	}
+	before := time.Now().Add(time.Second)
+	arts, err := d.ListExpiredTrash(ctx, before, 10)
+	require.NoError(t, err)
+	assert.Len(t, arts, 2)
+	arts, err = d.Purge(ctx, ids, before)
+	require.NoError(t, err)
+	assert.Len(t, arts, 2)
+	for i, id := range ids {
+		assert.Zero(t, countIn(t, dbs[(i+1)%2], &Article{}, id))
+	}
+}
+
+func TestShardedArticleDAO_ListScheduled(t *testing.T) {
+	d, _ := newTestShards(t, 2)
+	ctx := context.Background()
+	var ids []int64
+	for uid := int64(1); uid <= 4; uid++ {
+		id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: uid,
+			Status: domain.ArticleStatusScheduled.ToUint8()})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	_, err := d.Insert(ctx, Article{Title: "草稿", Content: "内容", AuthorId: 1,
+		Status: domain.ArticleStatusUnpublished.ToUint8()})
+	require.NoError(t, err)
+
+	// 分两页从所有分片里面按照 id 取
+	var got []int64
+	var startId int64
+	for i := 0; i < 3; i++ {
+		arts, err := d.ListScheduled(ctx, startId, 3)
+		require.NoError(t, err)
+		for _, art := range arts {
+			got = append(got, art.Id)
+		}
+		if len(arts) < 3 {
+			break
+		}
+		startId = arts[len(arts)-1].Id
+	}
+	// 同一毫秒里面不同分片的 id 不一定和插入的顺序一致
+	slices.Sort(ids)
+	assert.Equal(t, ids, got)
+}
+
+func TestMigrateToShards(t *testing.T) {
+	src := newTestDB(t)
+	ctx := context.Background()
+	legacy := NewArticleGORMDAO(src).(*ArticleGORMDAO)
+	var ids []int64
+	for uid := int64(1); uid <= 3; uid++ {
+		id, _, err := legacy.Sync(ctx, PublishedArticle{Article: Article{
+			Title: "标题", Content: "内容", AuthorId: uid, Tags: []string{"go", "mysql"},
+			Status: domain.ArticleStatusPublished.ToUint8(),
+		}})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	sid, err := legacy.InsertSeries(ctx, Series{AuthorId: 2, Title: "系列"})
+	require.NoError(t, err)
+	require.NoError(t, legacy.AddSeriesArticle(ctx, 2, sid, ids[1]))
+
+	d, dbs := newTestShards(t, 2)
+	var last int64
+	// 一批只搬两篇，检查分批和重复执行
+	require.NoError(t, MigrateToShards(ctx, src, dbs, 2, 0, func(lastId int64) {
+		last = lastId
+	}))
+	assert.Equal(t, ids[2], last)
+	require.NoError(t, MigrateToShards(ctx, src, dbs, 2, 0, nil))
+
+	for i, id := range ids {
+		uid := int64(i + 1)
+		assert.Equal(t, int64(1), countIn(t, dbs[uid%2], &Article{}, id))
+		// 老数据的 id 里面没有分片，要挨个分片找
+		art, err := d.GetById(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, uid, art.AuthorId)
+		assert.Equal(t, []string{"go", "mysql"}, art.Tags)
+		pub, err := d.GetPubById(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "mysql"}, pub.Tags)
+		revs, err := d.ListRevisions(ctx, id, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, revs, 1)
+	}
+	s, err := d.GetSeries(ctx, sid, true)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{ids[1]}, s.ArticleIds)
+
+	// 搬过来之后新写入的文章用新的 id
+	id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: 1})
+	require.NoError(t, err)
+	_, ok := snowflake.ShardOf(id)
+	assert.True(t, ok)
+}
+
+func TestShardedAttachmentDAO_ListOrphans(t *testing.T) {
+	main := newTestDB(t)
+	d, dbs := newTestShards(t, 2)
+	ctx := context.Background()
+	atts := NewShardedAttachmentDAO(main, dbs)
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		att, _, err := atts.Insert(ctx, Attachment{Uid: testOwner, Hash: fmt.Sprintf("hash_%d", i), StorageKey: fmt.Sprintf("key_%d", i)})
+		require.NoError(t, err)
+		ids = append(ids, att.Id)
+	}
+	// 前三个被分片上的文章引用了
+	for i := 0; i < 3; i++ {
+		_, err := d.Insert(ctx, Article{
+			Title:    "标题",
+			Content:  fmt.Sprintf("![](%s)", domain.AttachmentRef(ids[i])),
+			AuthorId: int64(i + 1),
+		})
+		require.NoError(t, err)
+	}
+	before := time.Now().Add(time.Second)
+	orphans, err := atts.ListOrphans(ctx, before, 2)
+	require.NoError(t, err)
+	require.Len(t, orphans, 2)
+	assert.Equal(t, ids[3], orphans[0].Id)
+	assert.Equal(t, ids[4], orphans[1].Id)
+
+	ok, err := atts.DeleteOrphan(ctx, ids[0], before)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = atts.DeleteOrphan(ctx, ids[3], before)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
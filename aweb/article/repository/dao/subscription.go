@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrSubscriptionNotFound = errors.New("没有订阅")
+
+// Subscription 读者对作者的订阅，过期了也不删除，续订的时候直接覆盖
+type Subscription struct {
+	Id       int64 `gorm:"primaryKey,autoIncrement"`
+	Uid      int64 `gorm:"uniqueIndex:uid_author"`
+	AuthorId int64 `gorm:"uniqueIndex:uid_author"`
+	// ExpireAt 0 表示永远不过期
+	ExpireAt int64
+	Ctime    int64
+	Utime    int64
+}
+
+type SubscriptionDAO interface {
+	// Upsert 已经订阅过的会覆盖过期时间
+	Upsert(ctx context.Context, s Subscription) error
+	// Get 不检查过期时间，没有订阅过返回 ErrSubscriptionNotFound
+	Get(ctx context.Context, uid int64, authorId int64) (Subscription, error)
+}
+
+type GORMSubscriptionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMSubscriptionDAO(db *gorm.DB) SubscriptionDAO {
+	return &GORMSubscriptionDAO{db: db}
+}
+
+func (d *GORMSubscriptionDAO) Upsert(ctx context.Context, s Subscription) error {
+	now := time.Now().UnixMilli()
+	s.Ctime = now
+	s.Utime = now
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "uid"}, {Name: "author_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"expire_at": s.ExpireAt,
+			"utime":     now,
+		}),
+	}).Create(&s).Error
+}
+
+func (d *GORMSubscriptionDAO) Get(ctx context.Context, uid int64, authorId int64) (Subscription, error) {
+	var res Subscription
+	err := d.db.WithContext(ctx).
+		Where("uid = ? AND author_id = ?", uid, authorId).
+		First(&res).Error
+	if err == gorm.ErrRecordNotFound {
+		return res, ErrSubscriptionNotFound
+	}
+	return res, err
+}
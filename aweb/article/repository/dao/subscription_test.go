@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMSubscriptionDAO_Upsert(t *testing.T) {
+	_, db := newTestDAO(t)
+	d := NewGORMSubscriptionDAO(db)
+	ctx := context.Background()
+
+	_, err := d.Get(ctx, 2, testOwner)
+	assert.Equal(t, ErrSubscriptionNotFound, err)
+
+	expire := time.Now().Add(time.Hour).UnixMilli()
+	require.NoError(t, d.Upsert(ctx, Subscription{Uid: 2, AuthorId: testOwner, ExpireAt: expire}))
+	s, err := d.Get(ctx, 2, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, expire, s.ExpireAt)
+
+	// 续订覆盖过期时间，不会多出一条
+	require.NoError(t, d.Upsert(ctx, Subscription{Uid: 2, AuthorId: testOwner}))
+	s, err = d.Get(ctx, 2, testOwner)
+	require.NoError(t, err)
+	assert.Zero(t, s.ExpireAt)
+	var cnt int64
+	require.NoError(t, db.Model(&Subscription{}).Count(&cnt).Error)
+	assert.Equal(t, int64(1), cnt)
+}
@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	articleTagTable          = "article_tags"
+	publishedArticleTagTable = "published_article_tags"
+)
+
+// Tag 标签本身，名字是规范化之后的
+type Tag struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Name  string `gorm:"type:varchar(128);uniqueIndex"`
+	Ctime int64
+}
+
+// ArticleTag 文章和标签的多对多关系
+type ArticleTag struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:,composite:article_tag"`
+	// 按照标签过滤的时候，从这个索引反查文章
+	TagId int64 `gorm:"uniqueIndex:,composite:article_tag;index"`
+	Ctime int64
+}
+
+// PublishedArticleTag 线上库的文章和标签的关系
+type PublishedArticleTag ArticleTag
+
+// replaceTags 把文章的标签整体替换成 names，必须在事务里面调用
+func replaceTags(tx *gorm.DB, table string, aid int64, names []string) error {
+	err := tx.Table(table).Where("article_id = ?", aid).Delete(&ArticleTag{}).Error
+	if err != nil || len(names) == 0 {
+		return err
+	}
+	now := time.Now().UnixMilli()
+	tags := slice.Map(names, func(idx int, src string) Tag {
+		return Tag{Name: src, Ctime: now}
+	})
+	// 已经存在的标签就不管了
+	err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error
+	if err != nil {
+		return err
+	}
+	var res []Tag
+	err = tx.Where("name IN ?", names).Find(&res).Error
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]int64, len(res))
+	for _, tag := range res {
+		ids[tag.Name] = tag.Id
+	}
+	// 按照 names 的顺序插入，查询的时候按照 id 排序就能保持作者给的顺序
+	mappings := slice.Map(names, func(idx int, src string) ArticleTag {
+		return ArticleTag{ArticleId: aid, TagId: ids[src], Ctime: now}
+	})
+	return tx.Table(table).Create(&mappings).Error
+}
+
+// findTags 批量查询文章的标签
+func findTags(db *gorm.DB, table string, aids []int64) (map[int64][]string, error) {
+	if len(aids) == 0 {
+		return map[int64][]string{}, nil
+	}
+	type row struct {
+		ArticleId int64
+		Name      string
+	}
+	var rows []row
+	err := db.Table(table).
+		Select(table+".article_id", "tags.name").
+		Joins("JOIN tags ON tags.id = "+table+".tag_id").
+		Where(table+".article_id IN ?", aids).
+		Order(table + ".id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]string, len(aids))
+	for _, r := range rows {
+		res[r.ArticleId] = append(res[r.ArticleId], r.Name)
+	}
+	return res, nil
+}
+
+// withTag 返回打了 tag 这个标签的文章 id 子查询
+func withTag(db *gorm.DB, table string, tag string) *gorm.DB {
+	return db.Table(table).
+		Select(table+".article_id").
+		Joins("JOIN tags ON tags.id = "+table+".tag_id").
+		Where("tags.name = ?", tag)
+}
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (a *ArticleGORMDAO) Delete(ctx context.Context, uid int64, id int64) error {
+	now := time.Now().UnixMilli()
+	deleted := domain.ArticleStatusDeleted.ToUint8()
+	return a.changeStatus(ctx, uid, id,
+		"status <> ?", deleted,
+		map[string]any{"status": deleted, "dtime": now},
+		map[string]any{"status": deleted, "dtime": now})
+}
+
+func (a *ArticleGORMDAO) Restore(ctx context.Context, uid int64, id int64) error {
+	return a.changeStatus(ctx, uid, id,
+		"status = ?", domain.ArticleStatusDeleted.ToUint8(),
+		map[string]any{"status": domain.ArticleStatusUnpublished.ToUint8(), "dtime": 0},
+		map[string]any{"status": domain.ArticleStatusPrivate.ToUint8(), "dtime": 0})
+}
+
+func (a *ArticleGORMDAO) ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+	var res []Article
+	err := a.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", uid, domain.ArticleStatusDeleted.ToUint8()).
+		Order("dtime DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (a *ArticleGORMDAO) ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]Article, error) {
+	var res []Article
+	err := a.db.WithContext(ctx).
+		Where("status = ? AND dtime < ?", domain.ArticleStatusDeleted.ToUint8(), before.UnixMilli()).
+		Order("dtime ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (a *ArticleGORMDAO) Purge(ctx context.Context, ids []int64, before time.Time) ([]Article, error) {
+	var res []Article
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 在列出来之后被恢复了的文章不能删，锁住防止同时被恢复
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ? AND status = ? AND dtime < ?",
+			ids, domain.ArticleStatusDeleted.ToUint8(), before.UnixMilli()).
+			Find(&res).Error
+		if err != nil || len(res) == 0 {
+			return err
+		}
+		aids := slice.Map(res, func(idx int, src Article) int64 {
+			return src.Id
+		})
+		for _, table := range []string{articleTagTable, publishedArticleTagTable} {
+			err = tx.Table(table).Where("article_id IN ?", aids).Delete(&ArticleTag{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		// 附件本身由附件服务在之后回收
+		err = tx.Where("article_id IN ?", aids).Delete(&ArticleAttachment{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", aids).Delete(&ArticleCollaborator{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", aids).Delete(&SeriesArticle{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", aids).Delete(&ArticleReview{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id IN ?", aids).Delete(&ArticleRevision{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("id IN ?", aids).Delete(&PublishedArticle{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", aids).Delete(&Article{}).Error
+	})
+	return res, err
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleGORMDAO_Trash(t *testing.T) {
+	d, db := newTestDAO(t)
+	ctx := context.Background()
+	id, _, err := d.Sync(ctx, PublishedArticle{Article: Article{
+		Title: "标题", Content: "内容", AuthorId: testOwner, Tags: []string{"go"},
+		Status: domain.ArticleStatusPublished.ToUint8(),
+	}})
+	require.NoError(t, err)
+
+	// 只有作者能删除
+	assert.Error(t, d.Delete(ctx, testStranger, id))
+	require.NoError(t, d.Delete(ctx, testOwner, id))
+	// 已经在回收站里面的不能再删一次
+	assert.Error(t, d.Delete(ctx, testOwner, id))
+	_, err = d.GetPubById(ctx, id)
+	assert.ErrorIs(t, err, ErrArticleNotFound)
+	arts, err := d.ListTrash(ctx, testOwner, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, arts, 1)
+	assert.Equal(t, id, arts[0].Id)
+
+	// 恢复之后回到草稿，线上的变成仅自己可见，要作者重新发表
+	require.NoError(t, d.Restore(ctx, testOwner, id))
+	assert.Error(t, d.Restore(ctx, testOwner, id))
+	art, err := d.GetById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ArticleStatusUnpublished.ToUint8(), art.Status)
+	assert.Zero(t, art.Dtime)
+	var pub PublishedArticle
+	require.NoError(t, db.Where("id = ?", id).First(&pub).Error)
+	assert.Equal(t, domain.ArticleStatusPrivate.ToUint8(), pub.Status)
+	arts, err = d.ListTrash(ctx, testOwner, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, arts)
+
+	// 彻底删除
+	require.NoError(t, d.Delete(ctx, testOwner, id))
+	before := time.Now().Add(time.Second)
+	expired, err := d.ListExpiredTrash(ctx, before, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	purged, err := d.Purge(ctx, []int64{id}, before)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	_, err = d.GetById(ctx, id)
+	assert.ErrorIs(t, err, ErrArticleNotFound)
+	assert.Zero(t, countIn(t, db, &PublishedArticle{}, id))
+	var tags int64
+	require.NoError(t, db.Table(publishedArticleTagTable).Where("article_id = ?", id).Count(&tags).Error)
+	assert.Zero(t, tags)
+}
+
+func TestArticleGORMDAO_PurgeRestored(t *testing.T) {
+	d, _ := newTestDAO(t)
+	ctx := context.Background()
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		id, err := d.Insert(ctx, Article{Title: "标题", Content: "内容", AuthorId: testOwner})
+		require.NoError(t, err)
+		require.NoError(t, d.Delete(ctx, testOwner, id))
+		ids = append(ids, id)
+	}
+	before := time.Now().Add(time.Second)
+	expired, err := d.ListExpiredTrash(ctx, before, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 3)
+
+	// 列出来之后，作者恢复了一篇
+	require.NoError(t, d.Restore(ctx, testOwner, ids[0]))
+	// 又有一篇被恢复之后再删掉，已经不算过期了
+	require.NoError(t, d.Restore(ctx, testOwner, ids[1]))
+	time.Sleep(time.Millisecond * 2)
+	cutoff := time.Now()
+	time.Sleep(time.Millisecond * 2)
+	require.NoError(t, d.Delete(ctx, testOwner, ids[1]))
+
+	purged, err := d.Purge(ctx, ids, cutoff)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	assert.Equal(t, ids[2], purged[0].Id)
+
+	art, err := d.GetById(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.ArticleStatusUnpublished.ToUint8(), art.Status)
+	art, err = d.GetById(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, domain.ArticleStatusDeleted.ToUint8(), art.Status)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+)
+
+type OutboxRepository interface {
+	Preempt(ctx context.Context, limit int) ([]domain.OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, retries int, nextTime time.Time) error
+	MarkFailed(ctx context.Context, id int64, retries int) error
+}
+
+type outboxRepository struct {
+	dao dao.OutboxDAO
+}
+
+func NewOutboxRepository(dao dao.OutboxDAO) OutboxRepository {
+	return &outboxRepository{dao: dao}
+}
+
+func (o *outboxRepository) Preempt(ctx context.Context, limit int) ([]domain.OutboxMessage, error) {
+	msgs, err := o.dao.Preempt(ctx, limit)
+	return slice.Map(msgs, func(idx int, src dao.OutboxMessage) domain.OutboxMessage {
+		return domain.OutboxMessage{
+			Id:      src.Id,
+			Topic:   src.Topic,
+			Key:     src.Key,
+			Payload: []byte(src.Payload),
+			Retries: src.Retries,
+		}
+	}), err
+}
+
+func (o *outboxRepository) MarkSent(ctx context.Context, id int64) error {
+	return o.dao.MarkSent(ctx, id)
+}
+
+func (o *outboxRepository) MarkRetry(ctx context.Context, id int64, retries int, nextTime time.Time) error {
+	return o.dao.MarkRetry(ctx, id, retries, nextTime)
+}
+
+func (o *outboxRepository) MarkFailed(ctx context.Context, id int64, retries int) error {
+	return o.dao.MarkFailed(ctx, id, retries)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+var (
+	ErrReviewNotFound = dao.ErrReviewNotFound
+	ErrReviewCanceled = dao.ErrReviewCanceled
+)
+
+func (c *CachedArticleRepository) SubmitForReview(ctx context.Context, art domain.Article, hits []string) (int64, error) {
+	id, owner, err := c.dao.SubmitForReview(ctx, c.toEntity(art), hits)
+	if err == nil {
+		c.delCaches(ctx, owner, id)
+	}
+	return id, err
+}
+
+func (c *CachedArticleRepository) ApproveReview(ctx context.Context, rid int64, reviewer int64, reason string,
+	render func(content string) (domain.RenderedContent, error)) (domain.Article, error) {
+	art, err := c.dao.ApproveReview(ctx, rid, reviewer, reason, func(art dao.Article) (dao.PublishedArticle, error) {
+		res := c.ToDomain(art)
+		var err error
+		res.Rendered, err = render(art.Content)
+		if err != nil {
+			return dao.PublishedArticle{}, err
+		}
+		return c.toPublishedEntity(res), nil
+	})
+	return c.afterReview(ctx, art, err)
+}
+
+func (c *CachedArticleRepository) RejectReview(ctx context.Context, rid int64, reviewer int64, reason string) (domain.Article, error) {
+	art, err := c.dao.RejectReview(ctx, rid, reviewer, reason)
+	return c.afterReview(ctx, art, err)
+}
+
+func (c *CachedArticleRepository) afterReview(ctx context.Context, art dao.Article, err error) (domain.Article, error) {
+	if err != nil {
+		return domain.Article{}, err
+	}
+	c.delCaches(ctx, art.AuthorId, art.Id)
+	return c.ToDomain(art), nil
+}
+
+func (c *CachedArticleRepository) ListPendingReviews(ctx context.Context, offset int, limit int) ([]domain.ArticleReview, error) {
+	res, err := c.dao.ListPendingReviews(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.ArticleReview) domain.ArticleReview {
+		return c.reviewToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) GetLatestReview(ctx context.Context, aid int64) (domain.ArticleReview, error) {
+	res, err := c.dao.GetLatestReview(ctx, aid)
+	if err != nil {
+		return domain.ArticleReview{}, err
+	}
+	return c.reviewToDomain(res), nil
+}
+
+func (c *CachedArticleRepository) reviewToDomain(r dao.ArticleReview) domain.ArticleReview {
+	res := domain.ArticleReview{
+		Id:         r.Id,
+		ArticleId:  r.ArticleId,
+		AuthorId:   r.AuthorId,
+		Version:    r.Version,
+		Status:     domain.ReviewStatus(r.Status),
+		Reason:     r.Reason,
+		ReviewerId: r.ReviewerId,
+		Ctime:      time.UnixMilli(r.Ctime),
+		Utime:      time.UnixMilli(r.Utime),
+	}
+	err := json.Unmarshal([]byte(r.Hits), &res.Hits)
+	if err != nil {
+		// 只是给审核的人参考，坏了也不影响审核
+		c.l.Error("failed to unmarshal review hits",
+			logger.Int64("rid", r.Id), logger.Error(err))
+	}
+	return res
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+var (
+	ErrSeriesNotFound       = dao.ErrSeriesNotFound
+	ErrInvalidSeriesArticle = dao.ErrInvalidSeriesArticle
+)
+
+func (c *CachedArticleRepository) CreateSeries(ctx context.Context, s domain.Series) (int64, error) {
+	id, err := c.dao.InsertSeries(ctx, c.seriesToEntity(s))
+	if err == nil {
+		// 这些文章之前缓存的是不在系列里面
+		c.delNav(ctx, s.ArticleIds...)
+	}
+	return id, err
+}
+
+func (c *CachedArticleRepository) UpdateSeries(ctx context.Context, s domain.Series) error {
+	err := c.dao.UpdateSeries(ctx, c.seriesToEntity(s))
+	if err == nil {
+		// 导航里面有系列的标题
+		c.delSeriesNav(ctx, s.Id)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) DeleteSeries(ctx context.Context, uid int64, id int64) error {
+	// 删掉之后就查不到里面有哪些文章了
+	s, err := c.dao.GetSeries(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	err = c.dao.DeleteSeries(ctx, uid, id)
+	if err == nil {
+		c.delNav(ctx, s.ArticleIds...)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) GetSeries(ctx context.Context, id int64, onlyPublished bool) (domain.Series, error) {
+	s, err := c.dao.GetSeries(ctx, id, onlyPublished)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return c.seriesToDomain(s), nil
+}
+
+// GetSeriesNav 每次读线上的文章都要用到，所以和文章一样先查缓存，没命中的时候合并并发的查询
+func (c *CachedArticleRepository) GetSeriesNav(ctx context.Context, aid int64) (domain.SeriesNav, error) {
+	nav, err := c.cache.GetSeriesNav(ctx, aid)
+	if err == nil {
+		return nav, nil
+	}
+	return share(ctx, &c.group, fmt.Sprintf("series_nav:%d", aid), func(ctx context.Context) (domain.SeriesNav, error) {
+		s, err := c.dao.GetSeriesByArticle(ctx, aid)
+		switch err {
+		case nil:
+		case dao.ErrSeriesNotFound:
+			// 不在系列里面的文章占了大多数，也要缓存，不然每次都会打到数据库上
+		default:
+			return domain.SeriesNav{}, err
+		}
+		// 文章已经下线了的话也不在 s.ArticleIds 里面，得到的是零值
+		nav, _ := c.seriesToDomain(s).Nav(aid)
+		er := c.cache.SetSeriesNav(ctx, aid, nav)
+		if er != nil {
+			c.l.Error("failed to set series nav cache", logger.Error(er))
+		}
+		return nav, nil
+	})
+}
+
+func (c *CachedArticleRepository) ListSeriesByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error) {
+	res, err := c.dao.ListSeriesByAuthor(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Series) domain.Series {
+		return c.seriesToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	err := c.dao.AddSeriesArticle(ctx, uid, id, aid)
+	if err == nil {
+		// 从库可能还查不到刚加进去的文章
+		c.delSeriesNav(ctx, id, aid)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	err := c.dao.RemoveSeriesArticle(ctx, uid, id, aid)
+	if err == nil {
+		c.delSeriesNav(ctx, id, aid)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error {
+	err := c.dao.ReorderSeries(ctx, uid, id, aids)
+	if err == nil {
+		c.delNav(ctx, aids...)
+	}
+	return err
+}
+
+// delSeriesNav 系列变了，里面所有文章的导航都要删掉，包括已经下线的
+// aids 是额外要删的文章，比如刚刚移出系列的
+func (c *CachedArticleRepository) delSeriesNav(ctx context.Context, id int64, aids ...int64) {
+	s, err := c.dao.GetSeries(ctx, id, false)
+	if err != nil {
+		c.l.Error("failed to find series articles",
+			logger.Int64("sid", id),
+			logger.Error(err))
+	}
+	c.delNav(ctx, append(aids, s.ArticleIds...)...)
+}
+
+// delArticleSeriesNav 文章上线或者下线之后，前后两篇的导航也变了
+func (c *CachedArticleRepository) delArticleSeriesNav(ctx context.Context, aid int64) {
+	s, err := c.dao.GetSeriesByArticle(ctx, aid)
+	if err != nil && err != dao.ErrSeriesNotFound {
+		c.l.Error("failed to find series by article",
+			logger.Int64("aid", aid),
+			logger.Error(err))
+	}
+	c.delNav(ctx, append(s.ArticleIds, aid)...)
+}
+
+func (c *CachedArticleRepository) delNav(ctx context.Context, aids ...int64) {
+	er := c.cache.DelSeriesNav(ctx, aids...)
+	if er != nil {
+		c.l.Error("failed to delete series nav cache", logger.Error(er))
+	}
+}
+
+func (c *CachedArticleRepository) seriesToEntity(s domain.Series) dao.Series {
+	return dao.Series{
+		Id:          s.Id,
+		AuthorId:    s.Author.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		ArticleIds:  s.ArticleIds,
+	}
+}
+
+func (c *CachedArticleRepository) seriesToDomain(s dao.Series) domain.Series {
+	return domain.Series{
+		Id: s.Id,
+		Author: domain.Author{
+			Id: s.AuthorId,
+		},
+		Title:       s.Title,
+		Description: s.Description,
+		ArticleIds:  s.ArticleIds,
+		Ctime:       time.UnixMilli(s.Ctime),
+		Utime:       time.UnixMilli(s.Utime),
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNavCache 只实现了导航和删除缓存用到的方法
+type fakeNavCache struct {
+	cache.ArticleCache
+	navs map[int64]domain.SeriesNav
+}
+
+func (f *fakeNavCache) GetSeriesNav(ctx context.Context, aid int64) (domain.SeriesNav, error) {
+	nav, ok := f.navs[aid]
+	if !ok {
+		return domain.SeriesNav{}, cache.ErrKeyNotExist
+	}
+	return nav, nil
+}
+
+func (f *fakeNavCache) SetSeriesNav(ctx context.Context, aid int64, nav domain.SeriesNav) error {
+	f.navs[aid] = nav
+	return nil
+}
+
+func (f *fakeNavCache) DelSeriesNav(ctx context.Context, aids ...int64) error {
+	for _, aid := range aids {
+		delete(f.navs, aid)
+	}
+	return nil
+}
+
+func (f *fakeNavCache) DelFirstPage(ctx context.Context, uid int64) error { return nil }
+func (f *fakeNavCache) Del(ctx context.Context, id int64) error           { return nil }
+func (f *fakeNavCache) DelPub(ctx context.Context, id int64) error        { return nil }
+
+// countingDAO 统计导航查了多少次数据库
+type countingDAO struct {
+	dao.ArticleDAO
+	cnt atomic.Int64
+}
+
+func (c *countingDAO) GetSeriesByArticle(ctx context.Context, aid int64) (dao.Series, error) {
+	c.cnt.Add(1)
+	return c.ArticleDAO.GetSeriesByArticle(ctx, aid)
+}
+
+func TestCachedArticleRepository_GetSeriesNav(t *testing.T) {
+	db := newTestDB(t)
+	d := &countingDAO{ArticleDAO: dao.NewArticleGORMDAO(db)}
+	c := &fakeNavCache{navs: map[int64]domain.SeriesNav{}}
+	repo := NewCachedArticleRepository(d, c, logger.NewNopLogger())
+	ctx := context.Background()
+
+	const uid = int64(1)
+	var aids []int64
+	for i := 0; i < 3; i++ {
+		id, _, err := d.Sync(ctx, dao.PublishedArticle{Article: dao.Article{
+			Title: "章节", Content: "内容", AuthorId: uid,
+			Status: domain.ArticleStatusPublished.ToUint8(),
+		}})
+		require.NoError(t, err)
+		aids = append(aids, id)
+	}
+	sid, err := repo.CreateSeries(ctx, domain.Series{
+		Author: domain.Author{Id: uid}, Title: "系列", ArticleIds: aids,
+	})
+	require.NoError(t, err)
+
+	nav, err := repo.GetSeriesNav(ctx, aids[1])
+	require.NoError(t, err)
+	assert.Equal(t, domain.SeriesNav{SeriesId: sid, Title: "系列", Index: 1, Total: 3,
+		Prev: aids[0], Next: aids[2]}, nav)
+	// 第二次直接从缓存拿
+	_, err = repo.GetSeriesNav(ctx, aids[1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), d.cnt.Load())
+
+	// 不在系列里面的也缓存起来
+	nav, err = repo.GetSeriesNav(ctx, 12345)
+	require.NoError(t, err)
+	assert.Zero(t, nav)
+	_, err = repo.GetSeriesNav(ctx, 12345)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), d.cnt.Load())
+
+	// 调整顺序之后，导航要跟着变
+	require.NoError(t, repo.ReorderSeries(ctx, uid, sid, []int64{aids[1], aids[0], aids[2]}))
+	nav, err = repo.GetSeriesNav(ctx, aids[1])
+	require.NoError(t, err)
+	assert.Equal(t, 0, nav.Index)
+	assert.Equal(t, aids[0], nav.Next)
+
+	// 中间那篇下线了，后面那篇的导航直接跳过它
+	_, err = repo.GetSeriesNav(ctx, aids[2])
+	require.NoError(t, err)
+	require.NoError(t, repo.SyncStatus(ctx, uid, aids[0], domain.ArticleStatusPrivate))
+	nav, err = repo.GetSeriesNav(ctx, aids[2])
+	require.NoError(t, err)
+	assert.Equal(t, aids[1], nav.Prev)
+	assert.Equal(t, 2, nav.Total)
+
+	// 改了标题
+	require.NoError(t, repo.UpdateSeries(ctx, domain.Series{Id: sid, Author: domain.Author{Id: uid}, Title: "新系列"}))
+	nav, err = repo.GetSeriesNav(ctx, aids[1])
+	require.NoError(t, err)
+	assert.Equal(t, "新系列", nav.Title)
+
+	// 移出系列
+	require.NoError(t, repo.RemoveSeriesArticle(ctx, uid, sid, aids[2]))
+	nav, err = repo.GetSeriesNav(ctx, aids[2])
+	require.NoError(t, err)
+	assert.Zero(t, nav)
+
+	// 删掉整个系列
+	require.NoError(t, repo.DeleteSeries(ctx, uid, sid))
+	nav, err = repo.GetSeriesNav(ctx, aids[1])
+	require.NoError(t, err)
+	assert.Zero(t, nav)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+)
+
+var ErrSubscriptionNotFound = dao.ErrSubscriptionNotFound
+
+type SubscriptionRepository interface {
+	Upsert(ctx context.Context, s domain.Subscription) error
+	// Get 不检查过期时间，没有订阅过返回 ErrSubscriptionNotFound
+	Get(ctx context.Context, uid int64, authorId int64) (domain.Subscription, error)
+}
+
+type subscriptionRepository struct {
+	dao dao.SubscriptionDAO
+}
+
+func NewSubscriptionRepository(dao dao.SubscriptionDAO) SubscriptionRepository {
+	return &subscriptionRepository{dao: dao}
+}
+
+func (r *subscriptionRepository) Upsert(ctx context.Context, s domain.Subscription) error {
+	var expireAt int64
+	if !s.ExpireAt.IsZero() {
+		expireAt = s.ExpireAt.UnixMilli()
+	}
+	return r.dao.Upsert(ctx, dao.Subscription{
+		Uid:      s.Uid,
+		AuthorId: s.AuthorId,
+		ExpireAt: expireAt,
+	})
+}
+
+func (r *subscriptionRepository) Get(ctx context.Context, uid int64, authorId int64) (domain.Subscription, error) {
+	s, err := r.dao.Get(ctx, uid, authorId)
+	if err != nil {
+		return domain.Subscription{}, err
+	}
+	res := domain.Subscription{
+		Uid:      s.Uid,
+		AuthorId: s.AuthorId,
+		Ctime:    time.UnixMilli(s.Ctime),
+		Utime:    time.UnixMilli(s.Utime),
+	}
+	if s.ExpireAt > 0 {
+		res.ExpireAt = time.UnixMilli(s.ExpireAt)
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+func (c *CachedArticleRepository) Delete(ctx context.Context, uid int64, id int64) error {
+	err := c.dao.Delete(ctx, uid, id)
+	if err == nil {
+		c.delCaches(ctx, uid, id)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) Restore(ctx context.Context, uid int64, id int64) error {
+	err := c.dao.Restore(ctx, uid, id)
+	if err == nil {
+		c.delCaches(ctx, uid, id)
+	}
+	return err
+}
+
+func (c *CachedArticleRepository) ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	arts, err := c.dao.ListTrash(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src dao.Article) domain.Article {
+		return c.ToDomain(src)
+	}), nil
+}
+
+func (c *CachedArticleRepository) ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	arts, err := c.dao.ListExpiredTrash(ctx, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(arts, func(idx int, src dao.Article) int64 {
+		return src.Id
+	}), nil
+}
+
+func (c *CachedArticleRepository) Purge(ctx context.Context, ids []int64, before time.Time) ([]int64, error) {
+	// 分库的时候部分分片失败了，前面分片已经删掉的文章也会和 err 一起返回
+	arts, err := c.dao.Purge(ctx, ids, before)
+	for _, art := range arts {
+		c.delCaches(ctx, art.AuthorId, art.Id)
+	}
+	return slice.Map(arts, func(idx int, src dao.Article) int64 {
+		return src.Id
+	}), err
+}
+
+// delCaches 文章的状态变了，和它有关的缓存都要删掉
+func (c *CachedArticleRepository) delCaches(ctx context.Context, uid int64, id int64) {
+	er := c.cache.DelFirstPage(ctx, uid)
+	if er != nil {
+		c.l.Error("failed to delete cache", logger.Error(er))
+	}
+	er = c.cache.Del(ctx, id)
+	if er != nil {
+		c.l.Error("failed to delete article cache", logger.Error(er))
+	}
+	er = c.cache.DelPub(ctx, id)
+	if er != nil {
+		c.l.Error("failed to delete published article cache", logger.Error(er))
+	}
+	c.delArticleSeriesNav(ctx, id)
+}
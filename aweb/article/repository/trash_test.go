@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// partialPurgeDAO 模拟分库的时候第二个分片失败了
+type partialPurgeDAO struct {
+	dao.ArticleDAO
+}
+
+func (p *partialPurgeDAO) Purge(ctx context.Context, ids []int64, before time.Time) ([]dao.Article, error) {
+	return []dao.Article{{Id: ids[0], AuthorId: 1}}, errors.New("分片不可用")
+}
+
+func (p *partialPurgeDAO) GetSeriesByArticle(ctx context.Context, aid int64) (dao.Series, error) {
+	return dao.Series{}, dao.ErrSeriesNotFound
+}
+
+// delCache 记录删掉了哪些文章的缓存
+type delCache struct {
+	cache.ArticleCache
+	pubs []int64
+	navs []int64
+}
+
+func (d *delCache) DelFirstPage(ctx context.Context, uid int64) error { return nil }
+func (d *delCache) Del(ctx context.Context, id int64) error           { return nil }
+
+func (d *delCache) DelPub(ctx context.Context, id int64) error {
+	d.pubs = append(d.pubs, id)
+	return nil
+}
+
+func (d *delCache) DelSeriesNav(ctx context.Context, aids ...int64) error {
+	d.navs = append(d.navs, aids...)
+	return nil
+}
+
+func TestCachedArticleRepository_PurgePartial(t *testing.T) {
+	c := &delCache{}
+	repo := NewCachedArticleRepository(&partialPurgeDAO{}, c, logger.NewNopLogger())
+	ids, err := repo.Purge(context.Background(), []int64{1, 2}, time.Now())
+	assert.Error(t, err)
+	// 已经删掉的要返回给调用方，缓存也要删掉
+	assert.Equal(t, []int64{1}, ids)
+	assert.Equal(t, []int64{1}, c.pubs)
+	assert.Equal(t, []int64{1}, c.navs)
+}
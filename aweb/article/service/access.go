@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	followv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/follow/v1"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccessChecker 检查读者能不能看文章的全文
+type AccessChecker interface {
+	CanRead(ctx context.Context, uid int64, art domain.Article) (bool, error)
+}
+
+type accessChecker struct {
+	followClient followv1.FollowServiceClient
+	subSvc       SubscriptionService
+}
+
+func NewAccessChecker(followClient followv1.FollowServiceClient, subSvc SubscriptionService) AccessChecker {
+	return &accessChecker{
+		followClient: followClient,
+		subSvc:       subSvc,
+	}
+}
+
+func (c *accessChecker) CanRead(ctx context.Context, uid int64, art domain.Article) (bool, error) {
+	if art.Access == domain.ArticleAccessPublic || uid == art.Author.Id {
+		return true, nil
+	}
+	// 没有登录的读者
+	if uid <= 0 {
+		return false, nil
+	}
+	switch art.Access {
+	case domain.ArticleAccessFollowers:
+		_, err := c.followClient.FollowInfo(ctx, &followv1.FollowInfoRequest{
+			Follower: uid,
+			Followee: art.Author.Id,
+		})
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return err == nil, err
+	case domain.ArticleAccessSubscribers:
+		return c.subSvc.IsSubscribed(ctx, uid, art.Author.Id)
+	default:
+		return false, nil
+	}
+}
+
+// restrict 读者没有权限的话只返回摘要
+// 查不到关注或者订阅关系的时候也只给摘要，宁可让读者重试也不能泄露付费的内容
+func (a *articleService) restrict(ctx context.Context, uid int64, art domain.Article) domain.Article {
+	ok, err := a.access.CanRead(ctx, uid, art)
+	if err != nil {
+		a.l.Error("检查文章的访问权限失败",
+			logger.Int64("aid", art.Id),
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	if ok {
+		return art
+	}
+	return art.Preview()
+}
+
+// previewRestricted 列表不区分读者，不是公开的文章都只给摘要
+func previewRestricted(arts []domain.Article) []domain.Article {
+	for i := range arts {
+		if arts[i].Access != domain.ArticleAccessPublic {
+			arts[i] = arts[i].Preview()
+		}
+	}
+	return arts
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/markdown"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrArchiveTooLarge = errors.New("压缩包太大了")
+	ErrTooManyFiles    = errors.New("压缩包里面的文章太多了")
+	ErrInvalidArchive  = errors.New("不是合法的 zip 包")
+	ErrFileTooLarge    = errors.New("文章太大了")
+)
+
+// ArchiveService 导出和导入作者的文章
+// 格式是 zip 包，每篇文章一个 Markdown 文件，标题、标签这些放在 YAML 的 front matter 里面
+type ArchiveService interface {
+	// Export 把作者的文章写成 zip 包，回收站里面的除外
+	// 导出的是制作库的草稿，也就是最新的内容
+	Export(ctx context.Context, uid int64, w io.Writer) error
+	// Import 读取 zip 包里面的 .md 文件，每个都走正常的保存或者发表流程，都是新建文章
+	// 每处理完一个文件就调用一次 report，单个文件失败不影响别的文件
+	// 只有整个包不合法，或者 report 返回 error 的时候才会中断
+	// 压缩包超过大小限制返回 ErrArchiveTooLarge
+	Import(ctx context.Context, uid int64, r io.Reader, report func(res ImportResult) error) error
+}
+
+// ImportResult 一个文件的导入结果
+type ImportResult struct {
+	Filename string
+	// Id 新建的文章，失败的时候是 0
+	Id int64
+	// Status 导入之后的状态，命中敏感词的文章会是待审核
+	Status domain.ArticleStatus
+	Err    error
+}
+
+type ArchiveConfig struct {
+	// MaxSize 导入的压缩包最多多少字节，zip 要随机读，整个包会放在内存里面
+	MaxSize int64
+	// MaxFiles 一个压缩包里面最多多少篇文章
+	MaxFiles int
+	// MaxFileSize 一篇文章最多多少字节
+	MaxFileSize int64
+}
+
+// articleMeta 文章文件的 front matter
+type articleMeta struct {
+	Title     string     `yaml:"title"`
+	Tags      []string   `yaml:"tags,omitempty"`
+	Category  string     `yaml:"category,omitempty"`
+	Status    string     `yaml:"status,omitempty"`
+	Access    string     `yaml:"access,omitempty"`
+	PublishAt *time.Time `yaml:"publish_at,omitempty"`
+	Created   *time.Time `yaml:"created,omitempty"`
+	Updated   *time.Time `yaml:"updated,omitempty"`
+}
+
+// 导出文件里面的状态，导入的时候只区分要不要发表
+var statusNames = map[domain.ArticleStatus]string{
+	domain.ArticleStatusUnpublished:   "draft",
+	domain.ArticleStatusPublished:     "published",
+	domain.ArticleStatusPrivate:       "private",
+	domain.ArticleStatusScheduled:     "scheduled",
+	domain.ArticleStatusPendingReview: "pending_review",
+	domain.ArticleStatusRejected:      "rejected",
+}
+
+var accessNames = map[domain.ArticleAccess]string{
+	domain.ArticleAccessPublic:      "public",
+	domain.ArticleAccessFollowers:   "followers",
+	domain.ArticleAccessSubscribers: "subscribers",
+}
+
+const exportBatchSize = 50
+
+type archiveService struct {
+	svc  ArticleService
+	repo repository.ArticleRepository
+	cfg  ArchiveConfig
+}
+
+func NewArchiveService(svc ArticleService, repo repository.ArticleRepository, cfg ArchiveConfig) ArchiveService {
+	return &archiveService{
+		svc:  svc,
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+func (s *archiveService) Export(ctx context.Context, uid int64, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	var cursor domain.Cursor
+	for {
+		arts, err := s.repo.ExportByAuthor(ctx, uid, cursor, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			err = s.writeArticle(zw, art)
+			if err != nil {
+				return err
+			}
+		}
+		if len(arts) < exportBatchSize {
+			break
+		}
+		cursor = domain.CursorOf(arts[len(arts)-1])
+	}
+	return zw.Close()
+}
+
+func (s *archiveService) writeArticle(zw *zip.Writer, art domain.Article) error {
+	meta := articleMeta{
+		Title:    art.Title,
+		Tags:     art.Tags,
+		Category: art.Category,
+		Status:   statusNames[art.Status],
+		Access:   accessNames[art.Access],
+		Created:  &art.Ctime,
+		Updated:  &art.Utime,
+	}
+	if !art.PublishAt.IsZero() {
+		meta.PublishAt = &art.PublishAt
+	}
+	val, err := yaml.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		// 带上 ID，标题一样的文章不会互相覆盖
+		Name:     fmt.Sprintf("%d-%s.md", art.Id, slugify(art.Title)),
+		Method:   zip.Deflate,
+		Modified: art.Utime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, markdown.JoinFrontMatter(string(val), art.Content))
+	return err
+}
+
+// slugify 只保留文字和数字，别的字符都换成 -，用来做文件名
+func slugify(title string) string {
+	var sb strings.Builder
+	cnt := 0
+	dash := false
+	for _, r := range title {
+		if cnt >= 50 {
+			break
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+			dash = false
+			cnt++
+			continue
+		}
+		if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+			cnt++
+		}
+	}
+	res := strings.TrimSuffix(sb.String(), "-")
+	if res == "" {
+		return "untitled"
+	}
+	return res
+}
+
+func (s *archiveService) Import(ctx context.Context, uid int64, r io.Reader,
+	report func(res ImportResult) error) error {
+	val, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxSize+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(val)) > s.cfg.MaxSize {
+		return ErrArchiveTooLarge
+	}
+	zr, err := zip.NewReader(bytes.NewReader(val), int64(len(val)))
+	if err != nil {
+		return ErrInvalidArchive
+	}
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, f := range zr.File {
+		if isArticleFile(f.Name) {
+			files = append(files, f)
+		}
+	}
+	if len(files) > s.cfg.MaxFiles {
+		return ErrTooManyFiles
+	}
+	for _, f := range files {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		res := ImportResult{Filename: f.Name}
+		res.Id, res.Status, res.Err = s.importFile(ctx, uid, f)
+		err = report(res)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isArticleFile 跳过目录、隐藏文件和 macOS 压缩的时候带上的元数据
+func isArticleFile(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+		return false
+	}
+	return strings.EqualFold(path.Ext(name), ".md")
+}
+
+func (s *archiveService) importFile(ctx context.Context, uid int64, f *zip.File) (int64, domain.ArticleStatus, error) {
+	if f.UncompressedSize64 > uint64(s.cfg.MaxFileSize) {
+		return 0, domain.ArticleStatusUnknown, ErrFileTooLarge
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return 0, domain.ArticleStatusUnknown, err
+	}
+	defer rc.Close()
+	// 头里面的大小可以是伪造的，读的时候再限制一次
+	val, err := io.ReadAll(io.LimitReader(rc, s.cfg.MaxFileSize+1))
+	if err != nil {
+		return 0, domain.ArticleStatusUnknown, err
+	}
+	if int64(len(val)) > s.cfg.MaxFileSize {
+		return 0, domain.ArticleStatusUnknown, ErrFileTooLarge
+	}
+	art, publish, err := parseArticleFile(f.Name, string(val))
+	if err != nil {
+		return 0, domain.ArticleStatusUnknown, err
+	}
+	art.Author = domain.Author{Id: uid}
+	if publish {
+		return s.svc.Publish(ctx, art)
+	}
+	id, _, err := s.svc.Save(ctx, art)
+	return id, domain.ArticleStatusUnpublished, err
+}
+
+// parseArticleFile 返回的 publish 表示要不要发表，别的状态都导入成草稿
+func parseArticleFile(name string, src string) (domain.Article, bool, error) {
+	metaSrc, body := markdown.SplitFrontMatter(src)
+	var meta articleMeta
+	err := yaml.Unmarshal([]byte(metaSrc), &meta)
+	if err != nil {
+		return domain.Article{}, false, fmt.Errorf("front matter 格式不对: %w", err)
+	}
+	art := domain.Article{
+		Title:    strings.TrimSpace(meta.Title),
+		Content:  body,
+		Tags:     meta.Tags,
+		Category: meta.Category,
+	}
+	if art.Title == "" {
+		art.Title = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+	if meta.Access != "" {
+		found := false
+		for access, accessName := range accessNames {
+			if accessName == meta.Access {
+				art.Access = access
+				found = true
+			}
+		}
+		if !found {
+			return domain.Article{}, false, domain.ErrInvalidAccess
+		}
+	}
+	switch meta.Status {
+	case statusNames[domain.ArticleStatusPublished]:
+		return art, true, nil
+	case statusNames[domain.ArticleStatusScheduled]:
+		// 定时的时间已经过了的话，就是立刻发表
+		if meta.PublishAt != nil {
+			art.PublishAt = *meta.PublishAt
+		}
+		return art, true, nil
+	default:
+		return art, false, nil
+	}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExportRepo 只实现了导出用到的方法
+type fakeExportRepo struct {
+	repository.ArticleRepository
+	arts []domain.Article
+}
+
+func (r *fakeExportRepo) ExportByAuthor(ctx context.Context, uid int64, cursor domain.Cursor, limit int) ([]domain.Article, error) {
+	start := 0
+	if !cursor.IsZero() {
+		for i, art := range r.arts {
+			if art.Id == cursor.Id {
+				start = i + 1
+			}
+		}
+	}
+	return r.arts[start:min(start+limit, len(r.arts))], nil
+}
+
+// fakeImportSvc 记录导入的时候保存和发表的文章
+type fakeImportSvc struct {
+	ArticleService
+	saved     []domain.Article
+	published []domain.Article
+}
+
+func (s *fakeImportSvc) Save(ctx context.Context, art domain.Article) (int64, int64, error) {
+	s.saved = append(s.saved, art)
+	return int64(len(s.saved) + len(s.published)), 1, nil
+}
+
+func (s *fakeImportSvc) Publish(ctx context.Context, art domain.Article) (int64, domain.ArticleStatus, error) {
+	s.published = append(s.published, art)
+	return int64(len(s.saved) + len(s.published)), domain.ArticleStatusPublished, nil
+}
+
+func TestArchiveService_ExportImport(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli()).UTC()
+	var arts []domain.Article
+	// 超过一批，要翻页
+	for i := 1; i <= exportBatchSize+1; i++ {
+		arts = append(arts, domain.Article{
+			Id:      int64(i),
+			Title:   "草稿",
+			Content: "正文\n",
+			Status:  domain.ArticleStatusUnpublished,
+			Ctime:   now,
+			Utime:   now,
+		})
+	}
+	arts[0] = domain.Article{
+		Id:      1,
+		Title:   "Go: 并发 / 实战",
+		Content: "# 标题\n\n正文\n",
+		Status:  domain.ArticleStatusPublished,
+		Access:  domain.ArticleAccessSubscribers,
+		Tags:    []string{"go"},
+		Ctime:   now,
+		Utime:   now,
+	}
+	svc := &fakeImportSvc{}
+	archive := NewArchiveService(svc, &fakeExportRepo{arts: arts}, ArchiveConfig{
+		MaxSize:     10 << 20,
+		MaxFiles:    100,
+		MaxFileSize: 1 << 20,
+	})
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(context.Background(), 1, &buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, exportBatchSize+1)
+	assert.Equal(t, "1-Go-并发-实战.md", zr.File[0].Name)
+
+	var results []ImportResult
+	err = archive.Import(context.Background(), 2, bytes.NewReader(buf.Bytes()),
+		func(res ImportResult) error {
+			results = append(results, res)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, results, exportBatchSize+1)
+	for _, res := range results {
+		assert.NoError(t, res.Err)
+	}
+	require.Len(t, svc.published, 1)
+	assert.Equal(t, domain.Article{
+		Title:   "Go: 并发 / 实战",
+		Content: "# 标题\n\n正文\n",
+		Access:  domain.ArticleAccessSubscribers,
+		Tags:    []string{"go"},
+		Author:  domain.Author{Id: 2},
+	}, svc.published[0])
+	assert.Len(t, svc.saved, exportBatchSize)
+}
+
+func TestArchiveService_Import(t *testing.T) {
+	testCases := []struct {
+		name     string
+		files    map[string]string
+		maxFiles int
+		wantErr  error
+		want     []ImportResult
+	}{
+		{
+			name: "没有 front matter 的用文件名做标题",
+			files: map[string]string{
+				"notes/hello.md":  "正文",
+				"__MACOSX/._a.md": "x",
+				"readme.txt":      "不是文章",
+			},
+			maxFiles: 10,
+			want: []ImportResult{
+				{Filename: "notes/hello.md", Id: 1, Status: domain.ArticleStatusUnpublished},
+			},
+		},
+		{
+			name: "访问级别不对",
+			files: map[string]string{
+				"a.md": "---\ntitle: a\naccess: vip\n---\n正文",
+			},
+			maxFiles: 10,
+			want: []ImportResult{
+				{Filename: "a.md", Err: domain.ErrInvalidAccess},
+			},
+		},
+		{
+			name: "文件太多",
+			files: map[string]string{
+				"a.md": "a",
+				"b.md": "b",
+			},
+			maxFiles: 1,
+			wantErr:  ErrTooManyFiles,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			zw := zip.NewWriter(&buf)
+			for name, content := range tc.files {
+				f, err := zw.Create(name)
+				require.NoError(t, err)
+				_, err = f.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, zw.Close())
+
+			svc := &fakeImportSvc{}
+			archive := NewArchiveService(svc, nil, ArchiveConfig{
+				MaxSize:     10 << 20,
+				MaxFiles:    tc.maxFiles,
+				MaxFileSize: 1 << 20,
+			})
+			var results []ImportResult
+			err := archive.Import(context.Background(), 1, bytes.NewReader(buf.Bytes()),
+				func(res ImportResult) error {
+					results = append(results, res)
+					return nil
+				})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, results)
+			if len(svc.saved) > 0 {
+				assert.Equal(t, "hello", svc.saved[0].Title)
+			}
+		})
+	}
+}
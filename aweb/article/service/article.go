@@ -2,59 +2,214 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/pluckhuang/goweb/aweb/article/domain"
 	"github.com/pluckhuang/goweb/aweb/article/events"
 	"github.com/pluckhuang/goweb/aweb/article/repository"
+	jobrepo "github.com/pluckhuang/goweb/aweb/internal/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/diffx"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 )
 
+var (
+	ErrNotAuthor       = errors.New("不是文章的作者")
+	ErrArticleNotFound = repository.ErrArticleNotFound
+)
+
+// ErrVersionConflict 保存的时候草稿已经被别的设备修改过了
+// 具体的错误是 *VersionConflictError，里面有服务端当前的版本号
+var ErrVersionConflict = repository.ErrVersionConflict
+
+type VersionConflictError = repository.VersionConflictError
+
 type ArticleService interface {
-	Save(ctx context.Context, art domain.Article) (int64, error)
-	Publish(ctx context.Context, art domain.Article) (int64, error)
+	// Save 和 Publish 的 art.Author 是操作的人，编辑者也可以修改和发表别人的文章
+	// Save 返回文章的 ID 和保存之后的版本号，客户端下次保存要带上这个版本号
+	Save(ctx context.Context, art domain.Article) (id int64, version int64, err error)
+	// Publish 如果 art.PublishAt 在未来，那么就是定时发表
+	// 对定时发表的文章再次调用，就是重新调度
+	// 内容命中敏感词的文章不会发表，而是进入审核队列，返回的状态是待审核
+	Publish(ctx context.Context, art domain.Article) (int64, domain.ArticleStatus, error)
+	CancelScheduledPublish(ctx context.Context, uid int64, id int64) error
+	// Withdraw 只有作者本人可以
 	Withdraw(ctx context.Context, uid int64, id int64) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthor filter 里面为空的条件不参与过滤
+	GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthorByCursor cursor 为空的时候返回第一页，
+	// 返回的 next 传给下一次调用，next 为空说明没有更多了
+	GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, cursor string, limit int) (arts []domain.Article, next string, err error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
+	// GetPubById 文章在系列里面的话，会带上上一篇和下一篇
+	// uid 是读者，没有权限看全文的话只返回摘要，Locked 为 true
 	GetPubById(ctx context.Context, id, uid int64) (domain.Article, error)
-	ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error)
+	// GetPubByIds 给别的服务展示文章列表用，不算阅读，不是公开的文章只返回摘要
+	// 已经下线或者不存在的跳过，按照 ids 的顺序返回
+	GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
+	// ListPub 和 ListPubByCursor 不是公开的文章都只返回摘要
+	ListPub(ctx context.Context, start time.Time, filter domain.ArticleFilter, offset, limit int) ([]domain.Article, error)
+	ListPubByCursor(ctx context.Context, start time.Time, filter domain.ArticleFilter, cursor string, limit int) (arts []domain.Article, next string, err error)
+
+	ListRevisions(ctx context.Context, uid, aid int64, offset, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, uid, aid, rid int64) (domain.ArticleRevision, error)
+	// DiffRevisions 按行比较两个版本，from 是旧版本，to 是新版本
+	DiffRevisions(ctx context.Context, uid, aid, from, to int64) ([]diffx.Line, error)
+	// RestoreRevision 把某个版本恢复成当前的草稿
+	RestoreRevision(ctx context.Context, uid, aid, rid int64) error
+
+	// Delete 放进回收站，已经发表的文章会同时下线
+	Delete(ctx context.Context, uid int64, id int64) error
+	// Restore 从回收站恢复成草稿，发表过的文章需要重新发表
+	Restore(ctx context.Context, uid int64, id int64) error
+	ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// ListExpiredTrash 和 Purge 给清理回收站的定时任务用
+	// 先列出过期的文章，彻底删除之后再清理真的被删除了的文章关联的数据
+	ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	// Purge 只会删除 ids 里面依旧在回收站并且在 before 之前删除的文章，返回真的被删除的
+	// 只删掉了一部分的时候不返回错误，剩下的还在回收站里面，下次会再删
+	Purge(ctx context.Context, ids []int64, before time.Time) ([]int64, error)
+
+	// CreateSeries s.ArticleIds 是初始的章节，必须是作者已经发表的文章
+	CreateSeries(ctx context.Context, s domain.Series) (int64, error)
+	// UpdateSeries 只修改标题和简介
+	UpdateSeries(ctx context.Context, s domain.Series) error
+	// DeleteSeries 只删除系列，里面的文章不受影响
+	DeleteSeries(ctx context.Context, uid int64, id int64) error
+	// GetSeries 给读者看的，只包含还在线上的文章
+	GetSeries(ctx context.Context, id int64) (domain.Series, error)
+	// ListSeries 给作者管理用的，包含已经下线的文章
+	ListSeries(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error)
+	// AddSeriesArticle 把文章加到系列的最后，一篇文章只能在一个系列里面
+	AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error
+	// ReorderSeries aids 是调整之后的顺序，必须刚好是系列里面现有的文章
+	ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error
+
+	// InviteCollaborator 只有作者能邀请，c.Role 只能是查看者或者编辑者
+	// 已经是协作者的，就是修改角色
+	InviteCollaborator(ctx context.Context, uid int64, c domain.Collaborator) error
+	// RemoveCollaborator 作者可以移除任何协作者，协作者可以自己退出
+	RemoveCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error
+	ListCollaborators(ctx context.Context, uid int64, aid int64) ([]domain.Collaborator, error)
+
+	// ReviewArticle 只有审核员可以，驳回的时候必须有原因
+	// 通过的时候，定时发表的文章还没到时间的话会重新调度，否则立刻发表
+	ReviewArticle(ctx context.Context, reviewer int64, rid int64, approve bool, reason string) (domain.Article, error)
+	// ListPendingReviews 审核队列，先提交的排在前面
+	ListPendingReviews(ctx context.Context, reviewer int64, offset int, limit int) ([]domain.ArticleReview, error)
+	// GetLatestReview 作者和审核员可以查看文章最近一次的审核结果
+	GetLatestReview(ctx context.Context, uid int64, aid int64) (domain.ArticleReview, error)
 }
 
 type articleService struct {
-	repo     repository.ArticleRepository
-	producer events.Producer
-	l        logger.LoggerV1
+	repo          repository.ArticleRepository
+	jobRepo       jobrepo.CronJobRepository
+	attachmentSvc AttachmentService
+	moderator     Moderator
+	access        AccessChecker
+	producer      events.Producer
+	l             logger.LoggerV1
 }
 
 func NewArticleService(repo repository.ArticleRepository,
+	jobRepo jobrepo.CronJobRepository,
+	attachmentSvc AttachmentService,
+	moderator Moderator,
+	access AccessChecker,
 	producer events.Producer, l logger.LoggerV1) ArticleService {
 	return &articleService{
-		repo:     repo,
-		producer: producer,
-		l:        l,
+		repo:          repo,
+		jobRepo:       jobRepo,
+		attachmentSvc: attachmentSvc,
+		moderator:     moderator,
+		access:        access,
+		producer:      producer,
+		l:             l,
 	}
 }
 
-func (a *articleService) Save(ctx context.Context, art domain.Article) (int64, error) {
+func (a *articleService) Save(ctx context.Context, art domain.Article) (int64, int64, error) {
+	if !art.Access.Valid() {
+		return art.Id, 0, domain.ErrInvalidAccess
+	}
+	if len(art.Content) > domain.MaxContentLength {
+		return art.Id, 0, domain.ErrContentTooLong
+	}
 	art.Status = domain.ArticleStatusUnpublished
+	art.Tags = domain.NormalizeTags(art.Tags)
+	art.Category = domain.NormalizeCategory(art.Category)
 	if art.Id > 0 {
-		err := a.repo.Update(ctx, art)
-		return art.Id, err
+		version, err := a.repo.Update(ctx, art)
+		return art.Id, version, err
 	}
-	return a.repo.Create(ctx, art)
+	id, err := a.repo.Create(ctx, art)
+	// 新建的文章从 1 开始
+	return id, 1, err
 }
 
-func (a *articleService) Publish(ctx context.Context, art domain.Article) (int64, error) {
+func (a *articleService) Publish(ctx context.Context, art domain.Article) (int64, domain.ArticleStatus, error) {
+	if !art.Access.Valid() {
+		return art.Id, art.Status, domain.ErrInvalidAccess
+	}
+	if len(art.Content) > domain.MaxContentLength {
+		return art.Id, art.Status, domain.ErrContentTooLong
+	}
+	art.Tags = domain.NormalizeTags(art.Tags)
+	art.Category = domain.NormalizeCategory(art.Category)
+	// 之前定时发表的任务触发的时候，发现文章不是定时状态，就会自己停下来
+	if hits := a.moderator.Check(art); len(hits) > 0 {
+		id, err := a.submitForReview(ctx, art, hits)
+		return id, domain.ArticleStatusPendingReview, err
+	}
+	if art.PublishAt.After(time.Now()) {
+		id, err := a.schedulePublish(ctx, art)
+		return id, domain.ArticleStatusScheduled, err
+	}
+	art.PublishAt = time.Time{}
 	art.Status = domain.ArticleStatusPublished
-	return a.repo.Sync(ctx, art)
+	var err error
+	art.Rendered, err = attachmentRenderer(ctx, a.attachmentSvc)(art.Content)
+	if err != nil {
+		return art.Id, art.Status, err
+	}
+	id, err := a.repo.Sync(ctx, art)
+	return id, art.Status, err
+}
+
+func (a *articleService) CancelScheduledPublish(ctx context.Context, uid int64, id int64) error {
+	err := a.repo.CancelScheduled(ctx, uid, id)
+	if err != nil {
+		return err
+	}
+	// 停不掉也没关系，任务触发的时候发现文章不是定时状态，什么也不会做
+	er := a.jobRepo.StopByName(ctx, scheduledPublishJobName(id))
+	if er != nil {
+		a.l.Error("停止定时发表任务失败",
+			logger.Int64("aid", id),
+			logger.Error(er))
+	}
+	return nil
 }
 
 func (a *articleService) Withdraw(ctx context.Context, uid int64, id int64) error {
 	return a.repo.SyncStatus(ctx, uid, id, domain.ArticleStatusPrivate)
 }
 
-func (a *articleService) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
-	return a.repo.GetByAuthor(ctx, uid, offset, limit)
+func (a *articleService) GetByAuthor(ctx context.Context, uid int64, filter domain.ArticleFilter, offset int, limit int) ([]domain.Article, error) {
+	return a.repo.GetByAuthor(ctx, uid, filter.Normalize(), offset, limit)
+}
+
+func (a *articleService) GetByAuthorByCursor(ctx context.Context, uid int64, filter domain.ArticleFilter, cursor string, limit int) ([]domain.Article, string, error) {
+	c, err := domain.ParseCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	arts, err := a.repo.GetByAuthorByCursor(ctx, uid, filter.Normalize(), c, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	return arts, domain.NextCursor(arts, limit), nil
 }
 
 func (a *articleService) GetById(ctx context.Context, id int64) (domain.Article, error) {
@@ -62,9 +217,14 @@ func (a *articleService) GetById(ctx context.Context, id int64) (domain.Article,
 }
 
 func (a *articleService) GetPubById(ctx context.Context, id, uid int64) (domain.Article, error) {
-	res, err := a.repo.GetPubById(ctx, id, uid)
+	res, err := a.repo.GetPubById(ctx, id)
+	if err == nil {
+		res.Series = a.seriesNav(ctx, id)
+		res = a.restrict(ctx, uid, res)
+	}
 	go func() {
-		if err == nil {
+		// 只看到摘要的不算阅读
+		if err == nil && !res.Locked {
 			// 在这里发一个消息
 			er := a.producer.ProduceReadEvent(events.ReadEvent{
 				Aid: id,
@@ -74,14 +234,119 @@ func (a *articleService) GetPubById(ctx context.Context, id, uid int64) (domain.
 				a.l.Error("发送 ReadEvent 失败",
 					logger.Int64("aid", id),
 					logger.Int64("uid", uid),
-					logger.Error(err))
+					logger.Error(er))
 			}
 		}
 	}()
 	return res, err
 }
 
+func (a *articleService) GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	arts, err := a.repo.GetPubByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return previewRestricted(arts), nil
+}
+
 func (a *articleService) ListPub(ctx context.Context,
-	start time.Time, offset, limit int) ([]domain.Article, error) {
-	return a.repo.ListPub(ctx, start, offset, limit)
+	start time.Time, filter domain.ArticleFilter, offset, limit int) ([]domain.Article, error) {
+	arts, err := a.repo.ListPub(ctx, start, filter.Normalize(), offset, limit)
+	return previewRestricted(arts), err
+}
+
+func (a *articleService) ListPubByCursor(ctx context.Context,
+	start time.Time, filter domain.ArticleFilter, cursor string, limit int) ([]domain.Article, string, error) {
+	c, err := domain.ParseCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	arts, err := a.repo.ListPubByCursor(ctx, start, filter.Normalize(), c, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	return previewRestricted(arts), domain.NextCursor(arts, limit), nil
+}
+
+func (a *articleService) ListRevisions(ctx context.Context, uid, aid int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	err := a.checkAuthor(ctx, uid, aid)
+	if err != nil {
+		return nil, err
+	}
+	return a.repo.ListRevisions(ctx, aid, offset, limit)
+}
+
+func (a *articleService) GetRevision(ctx context.Context, uid, aid, rid int64) (domain.ArticleRevision, error) {
+	err := a.checkAuthor(ctx, uid, aid)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return a.repo.GetRevision(ctx, aid, rid)
+}
+
+func (a *articleService) DiffRevisions(ctx context.Context, uid, aid, from, to int64) ([]diffx.Line, error) {
+	err := a.checkAuthor(ctx, uid, aid)
+	if err != nil {
+		return nil, err
+	}
+	fromRev, err := a.repo.GetRevision(ctx, aid, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := a.repo.GetRevision(ctx, aid, to)
+	if err != nil {
+		return nil, err
+	}
+	// 标题也当作一行参与比较
+	return diffx.Lines(fromRev.Title+"\n"+fromRev.Content,
+		toRev.Title+"\n"+toRev.Content), nil
+}
+
+func (a *articleService) RestoreRevision(ctx context.Context, uid, aid, rid int64) error {
+	err := a.checkAuthor(ctx, uid, aid)
+	if err != nil {
+		return err
+	}
+	rev, err := a.repo.GetRevision(ctx, aid, rid)
+	if err != nil {
+		return err
+	}
+	art, err := a.repo.GetById(ctx, aid)
+	if err != nil {
+		return err
+	}
+	// 走正常的保存流程，恢复本身也会产生一个新的版本
+	// 标签、分类、可见范围和定时发表的时间不在版本记录里面，保持现在的，
+	// 不然保存的时候会被清空，只给关注者看的草稿就变成公开的了
+	restored := domain.Article{
+		Id:      aid,
+		Title:   rev.Title,
+		Content: rev.Content,
+		Author: domain.Author{
+			Id: uid,
+		},
+		Tags:      art.Tags,
+		Category:  art.Category,
+		Access:    art.Access,
+		PublishAt: art.PublishAt,
+	}
+	if art.Status == domain.ArticleStatusScheduled {
+		// 保存会把文章变成未发表，定时就丢了，但是 publish_at 还在
+		// 所以定时的文章走发表的流程，恢复的内容一样要过审核，然后按原来的时间重新调度
+		_, _, err = a.Publish(ctx, restored)
+		return err
+	}
+	_, _, err = a.Save(ctx, restored)
+	return err
+}
+
+func (a *articleService) checkAuthor(ctx context.Context, uid, aid int64) error {
+	art, err := a.repo.GetById(ctx, aid)
+	if err != nil {
+		return err
+	}
+	if art.Author.Id != uid {
+		return ErrNotAuthor
+	}
+	return nil
 }
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/imagex"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/pkg/storage"
+)
+
+var (
+	ErrAttachmentNotFound     = repository.ErrAttachmentNotFound
+	ErrEmptyAttachment        = errors.New("附件是空的")
+	ErrAttachmentTooLarge     = errors.New("附件太大了")
+	ErrUnsupportedContentType = errors.New("不支持的附件类型")
+)
+
+type AttachmentService interface {
+	// Upload 内容一样的文件只会保存一份，重复上传返回之前的附件
+	// 文件类型根据内容判断，不相信客户端给的文件名
+	Upload(ctx context.Context, uid int64, filename string, r io.Reader) (domain.Attachment, error)
+	Get(ctx context.Context, id int64) (domain.Attachment, error)
+	// URLs 查询附件的访问地址，不存在的附件不会出现在结果里面
+	URLs(ctx context.Context, ids []int64) (map[int64]string, error)
+	// GC 回收没有被任何文章引用的附件，返回回收了多少个
+	// 刚上传的附件在宽限期内不会被回收，作者可能还没来得及保存文章
+	GC(ctx context.Context) (int, error)
+}
+
+type AttachmentConfig struct {
+	// MaxSize 单个附件最大多少字节
+	MaxSize int64 `yaml:"maxSize"`
+	// AllowedTypes 允许上传的 MIME 类型
+	AllowedTypes []string `yaml:"allowedTypes"`
+	// ThumbnailSize 缩略图的最长边，0 表示不生成缩略图
+	ThumbnailSize int `yaml:"thumbnailSize"`
+	// MaxPixels 超过这么多像素的图片不生成缩略图，防止解码的时候把内存撑爆
+	MaxPixels int `yaml:"maxPixels"`
+	// GCGracePeriod 上传之后多久没有被引用才会被回收
+	GCGracePeriod time.Duration `yaml:"gcGracePeriod"`
+	GCBatchSize   int           `yaml:"gcBatchSize"`
+}
+
+type attachmentService struct {
+	repo    repository.AttachmentRepository
+	storage storage.Storage
+	l       logger.LoggerV1
+	cfg     AttachmentConfig
+	allowed map[string]struct{}
+}
+
+func NewAttachmentService(repo repository.AttachmentRepository,
+	s storage.Storage, l logger.LoggerV1, cfg AttachmentConfig) AttachmentService {
+	allowed := make(map[string]struct{}, len(cfg.AllowedTypes))
+	for _, t := range cfg.AllowedTypes {
+		allowed[t] = struct{}{}
+	}
+	return &attachmentService{
+		repo:    repo,
+		storage: s,
+		l:       l,
+		cfg:     cfg,
+		allowed: allowed,
+	}
+}
+
+func (a *attachmentService) Upload(ctx context.Context, uid int64, filename string, r io.Reader) (domain.Attachment, error) {
+	// 多读一个字节，才知道是不是超过了限制
+	data, err := io.ReadAll(io.LimitReader(r, a.cfg.MaxSize+1))
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if len(data) == 0 {
+		return domain.Attachment{}, ErrEmptyAttachment
+	}
+	if int64(len(data)) > a.cfg.MaxSize {
+		return domain.Attachment{}, ErrAttachmentTooLarge
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return domain.Attachment{}, ErrUnsupportedContentType
+	}
+	if _, ok := a.allowed[contentType]; !ok {
+		return domain.Attachment{}, ErrUnsupportedContentType
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	existing, err := a.repo.FindByHash(ctx, hash)
+	switch err {
+	case nil:
+		return a.reuse(ctx, existing)
+	case ErrAttachmentNotFound:
+	default:
+		return domain.Attachment{}, err
+	}
+
+	att := domain.Attachment{
+		Uid:         uid,
+		Filename:    path.Base(filename),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Hash:        hash,
+	}
+	// 每次上传都用新的路径，回收的时候和并发的重新上传不会删到同一个对象
+	prefix := fmt.Sprintf("attachments/%s/%s/%d", hash[:2], hash, time.Now().UnixNano())
+	att.Key = prefix + "/origin"
+	err = a.storage.Put(ctx, att.Key, bytes.NewReader(data), contentType)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	a.thumbnail(ctx, &att, prefix+"/thumb", data)
+
+	res, created, err := a.repo.Create(ctx, att)
+	if err != nil || !created {
+		// 没有保存成功，或者同样的内容已经被别人上传了，刚写进去的对象就没用了
+		a.deleteObjects(ctx, att)
+	}
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if !created {
+		return a.reuse(ctx, res)
+	}
+	return a.withURL(res), nil
+}
+
+// reuse 重复上传，推迟已有附件的回收时间
+func (a *attachmentService) reuse(ctx context.Context, att domain.Attachment) (domain.Attachment, error) {
+	err := a.repo.Touch(ctx, att.Id)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	return a.withURL(att), nil
+}
+
+// thumbnail 能解析的图片才生成缩略图，失败了也不影响上传
+func (a *attachmentService) thumbnail(ctx context.Context, att *domain.Attachment, key string, data []byte) {
+	w, h, err := imagex.Size(data)
+	if err != nil {
+		return
+	}
+	att.Width, att.Height = w, h
+	if a.cfg.ThumbnailSize <= 0 || w*h > a.cfg.MaxPixels {
+		return
+	}
+	thumb, err := imagex.Thumbnail(data, a.cfg.ThumbnailSize)
+	if err != nil {
+		a.l.Warn("生成缩略图失败", logger.String("hash", att.Hash), logger.Error(err))
+		return
+	}
+	err = a.storage.Put(ctx, key, bytes.NewReader(thumb.Data), thumb.ContentType)
+	if err != nil {
+		a.l.Warn("保存缩略图失败", logger.String("hash", att.Hash), logger.Error(err))
+		return
+	}
+	att.ThumbKey = key
+}
+
+func (a *attachmentService) Get(ctx context.Context, id int64) (domain.Attachment, error) {
+	att, err := a.repo.FindById(ctx, id)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	return a.withURL(att), nil
+}
+
+func (a *attachmentService) URLs(ctx context.Context, ids []int64) (map[int64]string, error) {
+	atts, err := a.repo.FindByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]string, len(atts))
+	for _, att := range atts {
+		res[att.Id] = a.storage.URL(att.Key)
+	}
+	return res, nil
+}
+
+func (a *attachmentService) GC(ctx context.Context) (int, error) {
+	before := time.Now().Add(-a.cfg.GCGracePeriod)
+	cnt := 0
+	for {
+		atts, err := a.repo.ListOrphans(ctx, before, a.cfg.GCBatchSize)
+		if err != nil {
+			return cnt, err
+		}
+		for _, att := range atts {
+			// 先删记录，这样就算删除对象失败了，也只是在存储里面留下垃圾，
+			// 不会出现记录还在但是文件没了的情况
+			ok, err := a.repo.DeleteOrphan(ctx, att.Id, before)
+			if err != nil {
+				return cnt, err
+			}
+			if !ok {
+				continue
+			}
+			a.deleteObjects(ctx, att)
+			cnt++
+		}
+		if len(atts) < a.cfg.GCBatchSize {
+			return cnt, nil
+		}
+	}
+}
+
+func (a *attachmentService) deleteObjects(ctx context.Context, att domain.Attachment) {
+	for _, key := range []string{att.Key, att.ThumbKey} {
+		if key == "" {
+			continue
+		}
+		err := a.storage.Delete(ctx, key)
+		if err != nil {
+			a.l.Error("删除附件对象失败",
+				logger.String("key", key),
+				logger.Error(err))
+		}
+	}
+}
+
+func (a *attachmentService) withURL(att domain.Attachment) domain.Attachment {
+	att.URL = a.storage.URL(att.Key)
+	if att.ThumbKey != "" {
+		att.ThumbURL = a.storage.URL(att.ThumbKey)
+	}
+	return att
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+)
+
+var (
+	ErrPermissionDenied    = repository.ErrPermissionDenied
+	ErrInvalidCollaborator = repository.ErrInvalidCollaborator
+)
+
+func (a *articleService) InviteCollaborator(ctx context.Context, uid int64, c domain.Collaborator) error {
+	return a.repo.UpsertCollaborator(ctx, uid, c)
+}
+
+func (a *articleService) RemoveCollaborator(ctx context.Context, uid int64, aid int64, collaborator int64) error {
+	return a.repo.DeleteCollaborator(ctx, uid, aid, collaborator)
+}
+
+func (a *articleService) ListCollaborators(ctx context.Context, uid int64, aid int64) ([]domain.Collaborator, error) {
+	return a.repo.ListCollaborators(ctx, uid, aid)
+}
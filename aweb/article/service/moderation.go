@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/pkg/sensitive"
+)
+
+var (
+	ErrNotReviewer       = errors.New("不是审核员")
+	ErrEmptyRejectReason = errors.New("驳回的时候必须填写原因")
+	ErrReviewNotFound    = repository.ErrReviewNotFound
+	ErrReviewCanceled    = repository.ErrReviewCanceled
+)
+
+// Moderator 发表之前检查文章的内容
+type Moderator interface {
+	// Check 返回命中的敏感词，没有命中说明可以直接发表
+	Check(art domain.Article) []string
+	IsReviewer(uid int64) bool
+}
+
+type ModerationConfig struct {
+	// Reviewers 可以审核文章的用户
+	Reviewers []int64
+	// MaxHits 最多记录多少个命中的词，给审核的人参考，不需要全部列出来
+	MaxHits int
+}
+
+type dictModerator struct {
+	dict      *sensitive.Dictionary
+	reviewers map[int64]struct{}
+	maxHits   int
+}
+
+func NewModerator(dict *sensitive.Dictionary, cfg ModerationConfig) Moderator {
+	reviewers := make(map[int64]struct{}, len(cfg.Reviewers))
+	for _, uid := range cfg.Reviewers {
+		reviewers[uid] = struct{}{}
+	}
+	return &dictModerator{
+		dict:      dict,
+		reviewers: reviewers,
+		maxHits:   cfg.MaxHits,
+	}
+}
+
+func (m *dictModerator) Check(art domain.Article) []string {
+	// 用换行隔开，词典里面的词不会有换行，不会跨字段命中
+	text := art.Title + "\n" + art.Content + "\n" + strings.Join(art.Tags, "\n")
+	return m.dict.Find(text, m.maxHits)
+}
+
+func (m *dictModerator) IsReviewer(uid int64) bool {
+	_, ok := m.reviewers[uid]
+	return ok
+}
+
+// submitForReview 命中敏感词的文章先进审核队列，审核通过之后再发表
+func (a *articleService) submitForReview(ctx context.Context, art domain.Article, hits []string) (int64, error) {
+	art.Status = domain.ArticleStatusPendingReview
+	if !art.PublishAt.After(time.Now()) {
+		art.PublishAt = time.Time{}
+	}
+	id, err := a.repo.SubmitForReview(ctx, art, hits)
+	if err == nil {
+		a.l.Info("文章命中敏感词，等待审核",
+			logger.Int64("aid", id),
+			logger.String("hits", strings.Join(hits, ",")))
+	}
+	return id, err
+}
+
+func (a *articleService) ReviewArticle(ctx context.Context, reviewer int64, rid int64, approve bool, reason string) (domain.Article, error) {
+	if !a.moderator.IsReviewer(reviewer) {
+		return domain.Article{}, ErrNotReviewer
+	}
+	if !approve {
+		if strings.TrimSpace(reason) == "" {
+			return domain.Article{}, ErrEmptyRejectReason
+		}
+		return a.repo.RejectReview(ctx, rid, reviewer, reason)
+	}
+	art, err := a.repo.ApproveReview(ctx, rid, reviewer, reason,
+		attachmentRenderer(ctx, a.attachmentSvc))
+	if err != nil || art.Status != domain.ArticleStatusScheduled {
+		return art, err
+	}
+	// 审核通过的时候还没到定时发表的时间，重新调度任务
+	return art, scheduleJob(ctx, a.jobRepo, art.Id, art.Author.Id, art.PublishAt)
+}
+
+func (a *articleService) ListPendingReviews(ctx context.Context, reviewer int64, offset int, limit int) ([]domain.ArticleReview, error) {
+	if !a.moderator.IsReviewer(reviewer) {
+		return nil, ErrNotReviewer
+	}
+	return a.repo.ListPendingReviews(ctx, offset, limit)
+}
+
+func (a *articleService) GetLatestReview(ctx context.Context, uid int64, aid int64) (domain.ArticleReview, error) {
+	if !a.moderator.IsReviewer(uid) {
+		err := a.checkAuthor(ctx, uid, aid)
+		if err != nil {
+			return domain.ArticleReview{}, err
+		}
+	}
+	return a.repo.GetLatestReview(ctx, aid)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/markdown"
+)
+
+// abstractLength 摘要最多多少个字符
+const abstractLength = 128
+
+// renderContent 把 Markdown 源文件渲染成 HTML、目录和纯文本摘要
+// 内容里面的 attachment:<id> 会换成 urls 里面附件的真实地址，找不到的会被当成不安全的链接去掉
+func renderContent(content string, urls map[int64]string) domain.RenderedContent {
+	doc := markdown.RenderWith(content, markdown.Options{
+		ResolveURL: func(dest string) (string, bool) {
+			id, ok := domain.ParseAttachmentRef(dest)
+			if !ok {
+				return "", false
+			}
+			url, ok := urls[id]
+			return url, ok
+		},
+	})
+	return domain.RenderedContent{
+		HTML: doc.HTML,
+		TOC: slice.Map(doc.TOC, func(idx int, src markdown.Heading) domain.Heading {
+			return domain.Heading{
+				Level: src.Level,
+				ID:    src.ID,
+				Text:  src.Text,
+			}
+		}),
+		Abstract: doc.Abstract(abstractLength),
+	}
+}
+
+// attachmentRenderer 查询内容里面引用的附件地址，再渲染
+func attachmentRenderer(ctx context.Context, svc AttachmentService) func(content string) (domain.RenderedContent, error) {
+	return func(content string) (domain.RenderedContent, error) {
+		var urls map[int64]string
+		if ids := domain.AttachmentRefs(content); len(ids) > 0 {
+			var err error
+			urls, err = svc.URLs(ctx, ids)
+			if err != nil {
+				return domain.RenderedContent{}, err
+			}
+		}
+		return renderContent(content, urls), nil
+	}
+}
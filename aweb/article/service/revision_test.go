@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRevisionRepo 只实现了恢复版本用到的方法
+type fakeRevisionRepo struct {
+	repository.ArticleRepository
+	art     domain.Article
+	rev     domain.ArticleRevision
+	updated []domain.Article
+}
+
+func (r *fakeRevisionRepo) GetById(ctx context.Context, id int64) (domain.Article, error) {
+	return r.art, nil
+}
+
+func (r *fakeRevisionRepo) GetRevision(ctx context.Context, aid int64, rid int64) (domain.ArticleRevision, error) {
+	return r.rev, nil
+}
+
+func (r *fakeRevisionRepo) Update(ctx context.Context, art domain.Article) (int64, error) {
+	r.updated = append(r.updated, art)
+	return int64(len(r.updated) + 1), nil
+}
+
+func TestArticleService_RestoreRevision(t *testing.T) {
+	publishAt := time.UnixMilli(1800000000000)
+	repo := &fakeRevisionRepo{
+		art: domain.Article{
+			Id:        1,
+			Title:     "现在的标题",
+			Content:   "现在的内容",
+			Author:    domain.Author{Id: 2},
+			Tags:      []string{"go"},
+			Access:    domain.ArticleAccessSubscribers,
+			PublishAt: publishAt,
+		},
+		rev: domain.ArticleRevision{Id: 3, ArticleId: 1, Title: "旧标题", Content: "旧内容"},
+	}
+	svc := &articleService{repo: repo}
+
+	require.NoError(t, svc.RestoreRevision(context.Background(), 2, 1, 3))
+	require.Len(t, repo.updated, 1)
+	saved := repo.updated[0]
+	assert.Equal(t, "旧标题", saved.Title)
+	assert.Equal(t, "旧内容", saved.Content)
+	// 不在版本记录里面的字段保持现在的
+	assert.Equal(t, []string{"go"}, saved.Tags)
+	assert.Equal(t, domain.ArticleAccessSubscribers, saved.Access)
+	assert.Equal(t, publishAt, saved.PublishAt)
+
+	assert.Equal(t, ErrNotAuthor, svc.RestoreRevision(context.Background(), 5, 1, 3))
+}
+
+// nopModerator 什么都不拦
+type nopModerator struct{}
+
+func (nopModerator) Check(art domain.Article) []string { return nil }
+func (nopModerator) IsReviewer(uid int64) bool         { return false }
+
+func TestArticleService_RestoreRevision_Scheduled(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	repo := &fakeRevisionRepo{
+		art: domain.Article{
+			Id:        1,
+			Title:     "现在的标题",
+			Content:   "现在的内容",
+			Author:    domain.Author{Id: 2},
+			Status:    domain.ArticleStatusScheduled,
+			PublishAt: publishAt,
+		},
+		rev: domain.ArticleRevision{Id: 3, ArticleId: 1, Title: "旧标题", Content: "旧内容"},
+	}
+	jobs := &fakeJobRepo{scheduled: map[string]time.Time{}, cfgs: map[string]string{}}
+	svc := &articleService{repo: repo, jobRepo: jobs, moderator: nopModerator{}}
+
+	require.NoError(t, svc.RestoreRevision(context.Background(), 2, 1, 3))
+	require.Len(t, repo.updated, 1)
+	saved := repo.updated[0]
+	assert.Equal(t, "旧内容", saved.Content)
+	// 还是定时状态，任务按原来的时间重新调度
+	assert.Equal(t, domain.ArticleStatusScheduled, saved.Status)
+	assert.Equal(t, publishAt, saved.PublishAt)
+	assert.Equal(t, map[string]time.Time{scheduledPublishJobName(1): publishAt}, jobs.scheduled)
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	jobdomain "github.com/pluckhuang/goweb/aweb/internal/domain"
+	jobrepo "github.com/pluckhuang/goweb/aweb/internal/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+// ScheduledPublishExecutor 定时发表文章的任务在 job 表里面的执行器
+const ScheduledPublishExecutor = "article_scheduled_publish"
+
+type scheduledPublishCfg struct {
+	Aid int64 `json:"aid"`
+	Uid int64 `json:"uid"`
+}
+
+func scheduledPublishJobName(aid int64) string {
+	return fmt.Sprintf("article:scheduled_publish:%d", aid)
+}
+
+// schedulePublish 先把文章保存成定时状态，再创建或者重新调度任务
+// 文章分库了，任务在主库，两次写入没办法放在一个事务里面，
+// 中间失败或者崩溃留下的没有任务的定时文章，由 ScheduledPublisher 定期补上任务
+func (a *articleService) schedulePublish(ctx context.Context, art domain.Article) (int64, error) {
+	art.Status = domain.ArticleStatusScheduled
+	var err error
+	if art.Id > 0 {
+		_, err = a.repo.Update(ctx, art)
+	} else {
+		art.Id, err = a.repo.Create(ctx, art)
+	}
+	if err != nil {
+		return art.Id, err
+	}
+	return art.Id, scheduleJob(ctx, a.jobRepo, art.Id, art.Author.Id, art.PublishAt)
+}
+
+// scheduleJob 创建或者重新调度文章的定时发表任务
+func scheduleJob(ctx context.Context, jobRepo jobrepo.CronJobRepository, aid int64, uid int64, at time.Time) error {
+	cfg, err := json.Marshal(scheduledPublishCfg{Aid: aid, Uid: uid})
+	if err != nil {
+		return err
+	}
+	return jobRepo.Schedule(ctx, jobdomain.Job{
+		Name:     scheduledPublishJobName(aid),
+		Executor: ScheduledPublishExecutor,
+		Cfg:      string(cfg),
+	}, at)
+}
+
+// ScheduledPublisher 抢占到期的定时发表任务并执行
+// 多个实例同时运行也没关系，job 表的抢占保证同一时刻只有一个节点拿到任务
+// 节点在发表过程中崩溃，任务会在一分钟后被别的节点重新抢占，
+// 而文章只有处于定时状态才会被发表，所以不会重复发表
+type ScheduledPublisher struct {
+	repo          repository.ArticleRepository
+	jobRepo       jobrepo.CronJobRepository
+	attachmentSvc AttachmentService
+	l             logger.LoggerV1
+	// 没有任务的时候，隔多久再去抢占一次
+	interval time.Duration
+	// 隔多久检查一次定时文章是不是都有任务，每次检查多少篇
+	reconcileInterval time.Duration
+	reconcileBatch    int
+}
+
+func NewScheduledPublisher(repo repository.ArticleRepository,
+	jobRepo jobrepo.CronJobRepository,
+	attachmentSvc AttachmentService,
+	l logger.LoggerV1) *ScheduledPublisher {
+	return &ScheduledPublisher{
+		repo:          repo,
+		jobRepo:       jobRepo,
+		attachmentSvc: attachmentSvc,
+		l:             l,
+		interval:      time.Second,
+
+		reconcileInterval: time.Minute,
+		reconcileBatch:    100,
+	}
+}
+
+func (s *ScheduledPublisher) Start() error {
+	go func() {
+		for {
+			s.publishOnce()
+		}
+	}()
+	go func() {
+		ticker := time.NewTicker(s.reconcileInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.reconcile()
+		}
+	}()
+	return nil
+}
+
+// reconcile 给没有任务的定时文章补上任务
+// 多个实例同时补也没关系，重复调度只是让任务多一次版本号
+func (s *ScheduledPublisher) reconcile() {
+	var startId int64
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		arts, err := s.reconcileOnce(ctx, startId)
+		cancel()
+		if err != nil {
+			s.l.Error("补齐定时发表任务失败",
+				logger.Int64("startId", startId),
+				logger.Error(err))
+			return
+		}
+		if len(arts) < s.reconcileBatch {
+			return
+		}
+		startId = arts[len(arts)-1].Id
+	}
+}
+
+// reconcileOnce 检查 startId 之后的一批定时文章，返回这一批文章
+func (s *ScheduledPublisher) reconcileOnce(ctx context.Context, startId int64) ([]domain.Article, error) {
+	// 从库落后的话，会把刚补上的任务当成没有，或者把已经发表的文章当成还在定时
+	ctx = gormx.ForcePrimary(ctx)
+	arts, err := s.repo.ListScheduled(ctx, startId, s.reconcileBatch)
+	if err != nil || len(arts) == 0 {
+		return arts, err
+	}
+	names := make([]string, 0, len(arts))
+	for _, art := range arts {
+		names = append(names, scheduledPublishJobName(art.Id))
+	}
+	active, err := s.jobRepo.ActiveNames(ctx, names)
+	if err != nil {
+		return arts, err
+	}
+	exists := make(map[string]struct{}, len(active))
+	for _, name := range active {
+		exists[name] = struct{}{}
+	}
+	for _, art := range arts {
+		if _, ok := exists[scheduledPublishJobName(art.Id)]; ok {
+			continue
+		}
+		s.l.Warn("定时文章没有任务，重新调度",
+			logger.Int64("aid", art.Id))
+		err = scheduleJob(ctx, s.jobRepo, art.Id, art.Author.Id, art.PublishAt)
+		if err != nil {
+			return arts, err
+		}
+	}
+	return arts, nil
+}
+
+func (s *ScheduledPublisher) publishOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	j, err := s.jobRepo.PreemptByExecutor(ctx, ScheduledPublishExecutor)
+	cancel()
+	switch err {
+	case nil:
+	case jobrepo.ErrNoMoreJob:
+		time.Sleep(s.interval)
+		return
+	default:
+		s.l.Error("抢占定时发表任务失败", logger.Error(err))
+		time.Sleep(s.interval)
+		return
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	var cfg scheduledPublishCfg
+	err = json.Unmarshal([]byte(j.Cfg), &cfg)
+	if err != nil {
+		s.l.Error("定时发表任务配置错误",
+			logger.Int64("jid", j.Id),
+			logger.String("cfg", j.Cfg),
+			logger.Error(err))
+		s.stop(ctx, j)
+		return
+	}
+	ok, err := s.repo.PublishScheduled(ctx, cfg.Uid, cfg.Aid,
+		attachmentRenderer(ctx, s.attachmentSvc))
+	if err != nil {
+		// 不释放任务，一分钟之后它会被重新抢占，相当于重试
+		// 如果在这期间被重新调度了，任务会直接回到等待状态
+		s.l.Error("执行定时发表失败",
+			logger.Int64("aid", cfg.Aid),
+			logger.Error(err))
+		return
+	}
+	if !ok {
+		// 已经被取消、被重新调度或者已经发表过了
+		s.l.Info("定时发表的文章不需要发表",
+			logger.Int64("aid", cfg.Aid))
+	}
+	s.stop(ctx, j)
+}
+
+func (s *ScheduledPublisher) stop(ctx context.Context, j jobdomain.Job) {
+	// 带着 version 停止，如果在执行期间被重新调度了，就不会停掉新的调度
+	err := s.jobRepo.Stop(ctx, j.Id, j.Version)
+	if err != nil {
+		s.l.Error("停止定时发表任务失败",
+			logger.Int64("jid", j.Id),
+			logger.Error(err))
+	}
+}
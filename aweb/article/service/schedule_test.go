@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	jobdomain "github.com/pluckhuang/goweb/aweb/internal/domain"
+	jobrepo "github.com/pluckhuang/goweb/aweb/internal/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScheduledRepo 按照 id 从小到大存着定时文章
+type fakeScheduledRepo struct {
+	repository.ArticleRepository
+	arts []domain.Article
+}
+
+func (r *fakeScheduledRepo) ListScheduled(ctx context.Context, startId int64, limit int) ([]domain.Article, error) {
+	var res []domain.Article
+	for _, art := range r.arts {
+		if art.Id > startId && len(res) < limit {
+			res = append(res, art)
+		}
+	}
+	return res, nil
+}
+
+type fakeJobRepo struct {
+	jobrepo.CronJobRepository
+	active    map[string]bool
+	scheduled map[string]time.Time
+	cfgs      map[string]string
+}
+
+func (r *fakeJobRepo) ActiveNames(ctx context.Context, names []string) ([]string, error) {
+	var res []string
+	for _, name := range names {
+		if r.active[name] {
+			res = append(res, name)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeJobRepo) Schedule(ctx context.Context, j jobdomain.Job, next time.Time) error {
+	r.scheduled[j.Name] = next
+	r.cfgs[j.Name] = j.Cfg
+	return nil
+}
+
+func TestScheduledPublisher_Reconcile(t *testing.T) {
+	at := time.UnixMilli(1800000000000)
+	repo := &fakeScheduledRepo{}
+	for id := int64(1); id <= 5; id++ {
+		repo.arts = append(repo.arts, domain.Article{Id: id, Author: domain.Author{Id: 10 + id}, PublishAt: at})
+	}
+	jobs := &fakeJobRepo{
+		active: map[string]bool{
+			scheduledPublishJobName(1): true,
+			scheduledPublishJobName(4): true,
+		},
+		scheduled: map[string]time.Time{},
+		cfgs:      map[string]string{},
+	}
+	s := NewScheduledPublisher(repo, jobs, nil, logger.NewNopLogger())
+	// 一批两篇，要翻页才能检查完
+	s.reconcileBatch = 2
+	s.reconcile()
+
+	// 只给没有任务的文章补上任务，已经有的不动
+	assert.Equal(t, map[string]time.Time{
+		scheduledPublishJobName(2): at,
+		scheduledPublishJobName(3): at,
+		scheduledPublishJobName(5): at,
+	}, jobs.scheduled)
+	var cfg scheduledPublishCfg
+	require.NoError(t, json.Unmarshal([]byte(jobs.cfgs[scheduledPublishJobName(3)]), &cfg))
+	assert.Equal(t, scheduledPublishCfg{Aid: 3, Uid: 13}, cfg)
+}
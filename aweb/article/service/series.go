@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+var (
+	ErrSeriesNotFound       = repository.ErrSeriesNotFound
+	ErrInvalidSeriesArticle = repository.ErrInvalidSeriesArticle
+	ErrEmptySeriesTitle     = errors.New("系列的标题不能为空")
+)
+
+func (a *articleService) CreateSeries(ctx context.Context, s domain.Series) (int64, error) {
+	s.Title = strings.TrimSpace(s.Title)
+	if s.Title == "" {
+		return 0, ErrEmptySeriesTitle
+	}
+	return a.repo.CreateSeries(ctx, s)
+}
+
+func (a *articleService) UpdateSeries(ctx context.Context, s domain.Series) error {
+	s.Title = strings.TrimSpace(s.Title)
+	if s.Title == "" {
+		return ErrEmptySeriesTitle
+	}
+	return a.repo.UpdateSeries(ctx, s)
+}
+
+func (a *articleService) DeleteSeries(ctx context.Context, uid int64, id int64) error {
+	return a.repo.DeleteSeries(ctx, uid, id)
+}
+
+func (a *articleService) GetSeries(ctx context.Context, id int64) (domain.Series, error) {
+	return a.repo.GetSeries(ctx, id, true)
+}
+
+func (a *articleService) ListSeries(ctx context.Context, uid int64, offset int, limit int) ([]domain.Series, error) {
+	return a.repo.ListSeriesByAuthor(ctx, uid, offset, limit)
+}
+
+func (a *articleService) AddSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return a.repo.AddSeriesArticle(ctx, uid, id, aid)
+}
+
+func (a *articleService) RemoveSeriesArticle(ctx context.Context, uid int64, id int64, aid int64) error {
+	return a.repo.RemoveSeriesArticle(ctx, uid, id, aid)
+}
+
+func (a *articleService) ReorderSeries(ctx context.Context, uid int64, id int64, aids []int64) error {
+	return a.repo.ReorderSeries(ctx, uid, id, aids)
+}
+
+// seriesNav 查不到系列不影响看文章，只是没有导航
+func (a *articleService) seriesNav(ctx context.Context, aid int64) domain.SeriesNav {
+	nav, err := a.repo.GetSeriesNav(ctx, aid)
+	if err != nil {
+		a.l.Error("查找文章所在的系列失败",
+			logger.Int64("aid", aid),
+			logger.Error(err))
+	}
+	return nav
+}
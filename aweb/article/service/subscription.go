@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/article/repository"
+)
+
+var ErrNotAdmin = errors.New("不是管理员")
+
+// SubscriptionService 订阅的权益，付费的流程在别的地方，成功之后由管理员的接口开通
+type SubscriptionService interface {
+	// Grant 只有管理员可以，已经订阅过的会覆盖过期时间
+	Grant(ctx context.Context, admin int64, s domain.Subscription) error
+	// IsSubscribed 过期了的不算
+	IsSubscribed(ctx context.Context, uid int64, authorId int64) (bool, error)
+}
+
+type SubscriptionConfig struct {
+	// Admins 可以开通订阅的用户
+	Admins []int64
+}
+
+type subscriptionService struct {
+	repo   repository.SubscriptionRepository
+	admins map[int64]struct{}
+}
+
+func NewSubscriptionService(repo repository.SubscriptionRepository, cfg SubscriptionConfig) SubscriptionService {
+	admins := make(map[int64]struct{}, len(cfg.Admins))
+	for _, uid := range cfg.Admins {
+		admins[uid] = struct{}{}
+	}
+	return &subscriptionService{
+		repo:   repo,
+		admins: admins,
+	}
+}
+
+func (s *subscriptionService) Grant(ctx context.Context, admin int64, sub domain.Subscription) error {
+	if _, ok := s.admins[admin]; !ok {
+		return ErrNotAdmin
+	}
+	return s.repo.Upsert(ctx, sub)
+}
+
+func (s *subscriptionService) IsSubscribed(ctx context.Context, uid int64, authorId int64) (bool, error) {
+	sub, err := s.repo.Get(ctx, uid, authorId)
+	switch err {
+	case nil:
+		return sub.Active(time.Now()), nil
+	case repository.ErrSubscriptionNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/article/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+func (a *articleService) Delete(ctx context.Context, uid int64, id int64) error {
+	return a.repo.Delete(ctx, uid, id)
+}
+
+func (a *articleService) Restore(ctx context.Context, uid int64, id int64) error {
+	return a.repo.Restore(ctx, uid, id)
+}
+
+func (a *articleService) ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	return a.repo.ListTrash(ctx, uid, offset, limit)
+}
+
+func (a *articleService) ListExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	return a.repo.ListExpiredTrash(ctx, before, limit)
+}
+
+func (a *articleService) Purge(ctx context.Context, ids []int64, before time.Time) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	res, err := a.repo.Purge(ctx, ids, before)
+	if len(res) == 0 {
+		return nil, err
+	}
+	if err != nil {
+		// 已经删掉的必须告诉调用方，不然它们关联的数据就没有人清理了
+		a.l.Error("只删除了部分文章",
+			logger.Int("purged", len(res)),
+			logger.Error(err))
+	}
+	// 文章已经删掉了，附件回收失败也不影响，下次清理的时候还会再回收
+	cnt, err := a.attachmentSvc.GC(ctx)
+	if err != nil {
+		a.l.Error("回收附件失败", logger.Int("count", cnt), logger.Error(err))
+	}
+	return res, nil
+}
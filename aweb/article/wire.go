@@ -8,27 +8,48 @@ import (
 	grpc2 "github.com/pluckhuang/goweb/aweb/article/grpc"
 	ioc "github.com/pluckhuang/goweb/aweb/article/ioc"
 	"github.com/pluckhuang/goweb/aweb/article/repository"
-	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
 	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
 	"github.com/pluckhuang/goweb/aweb/article/service"
+	jobrepo "github.com/pluckhuang/goweb/aweb/internal/repository"
+	jobdao "github.com/pluckhuang/goweb/aweb/internal/repository/dao"
 )
 
 var serviceProviderSet = wire.NewSet(
-	dao.NewArticleGORMDAO,
-	cache.NewArticleRedisCache,
+	dao.NewGORMSubscriptionDAO,
 	repository.NewCachedArticleRepository,
+	repository.NewAttachmentRepository,
+	repository.NewSubscriptionRepository,
+	jobdao.NewGORMJobDAO,
+	jobrepo.NewPreemptJobRepository,
+	service.NewAccessChecker,
 	service.NewArticleService,
+	service.NewScheduledPublisher,
 	grpc2.NewGrpcServer,
 )
 
 var thirdProvider = wire.NewSet(
+	ioc.InitRedisClient,
 	ioc.InitRedis,
+	ioc.InitInvalidationBus,
+	ioc.InitArticleCache,
 	ioc.InitDB,
+	ioc.InitArticleShards,
+	ioc.InitIdGenerator,
+	ioc.InitArticleDAO,
+	ioc.InitAttachmentDAO,
 	ioc.InitEtcdClient,
 	ioc.InitLogger,
 	ioc.InitKafka,
 	ioc.InitSyncProducer,
 	events.NewSaramaSyncProducer,
+	ioc.InitOutboxRelays,
+	ioc.InitStorage,
+	ioc.InitAttachmentService,
+	ioc.InitArchiveService,
+	ioc.InitSensitiveDictionary,
+	ioc.InitModerator,
+	ioc.InitFollowClient,
+	ioc.InitSubscriptionService,
 )
 
 func Init() *App {
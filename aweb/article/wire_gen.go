@@ -12,9 +12,10 @@ import (
 	"github.com/pluckhuang/goweb/aweb/article/grpc"
 	"github.com/pluckhuang/goweb/aweb/article/ioc"
 	"github.com/pluckhuang/goweb/aweb/article/repository"
-	"github.com/pluckhuang/goweb/aweb/article/repository/cache"
-	"github.com/pluckhuang/goweb/aweb/article/repository/dao"
+	dao2 "github.com/pluckhuang/goweb/aweb/article/repository/dao"
 	"github.com/pluckhuang/goweb/aweb/article/service"
+	repository2 "github.com/pluckhuang/goweb/aweb/internal/repository"
+	"github.com/pluckhuang/goweb/aweb/internal/repository/dao"
 )
 
 // Injectors from wire.go:
@@ -23,24 +24,46 @@ func Init() *App {
 	loggerV1 := ioc.InitLogger()
 	client := ioc.InitEtcdClient()
 	db := ioc.InitDB(loggerV1)
-	articleDAO := dao.NewArticleGORMDAO(db)
-	cmdable := ioc.InitRedis()
-	articleCache := cache.NewArticleRedisCache(cmdable)
+	articleShards := ioc.InitArticleShards()
+	generator := ioc.InitIdGenerator()
+	articleDAO := ioc.InitArticleDAO(db, articleShards, generator)
+	redisClient := ioc.InitRedisClient()
+	cmdable := ioc.InitRedis(redisClient)
+	invalidationBus := ioc.InitInvalidationBus(redisClient, loggerV1)
+	articleCache := ioc.InitArticleCache(cmdable, invalidationBus)
 	articleRepository := repository.NewCachedArticleRepository(articleDAO, articleCache, loggerV1)
+	jobDAO := dao.NewGORMJobDAO(db)
+	cronJobRepository := repository2.NewPreemptJobRepository(jobDAO)
+	attachmentDAO := ioc.InitAttachmentDAO(db, articleShards)
+	attachmentRepository := repository.NewAttachmentRepository(attachmentDAO)
+	storage := ioc.InitStorage()
+	attachmentService := ioc.InitAttachmentService(attachmentRepository, storage, loggerV1)
+	dictionary := ioc.InitSensitiveDictionary(loggerV1)
+	moderator := ioc.InitModerator(dictionary)
+	followServiceClient := ioc.InitFollowClient(client)
+	subscriptionDAO := dao2.NewGORMSubscriptionDAO(db)
+	subscriptionRepository := repository.NewSubscriptionRepository(subscriptionDAO)
+	subscriptionService := ioc.InitSubscriptionService(subscriptionRepository)
+	accessChecker := service.NewAccessChecker(followServiceClient, subscriptionService)
 	saramaClient := ioc.InitKafka()
 	syncProducer := ioc.InitSyncProducer(saramaClient)
 	producer := events.NewSaramaSyncProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, producer, loggerV1)
-	articleServiceServer := grpc.NewGrpcServer(articleService)
+	articleService := service.NewArticleService(articleRepository, cronJobRepository, attachmentService, moderator, accessChecker, producer, loggerV1)
+	archiveService := ioc.InitArchiveService(articleService, articleRepository)
+	articleServiceServer := grpc.NewGrpcServer(articleService, attachmentService, subscriptionService, archiveService)
 	server := ioc.InitGRPCxServer(loggerV1, client, articleServiceServer)
+	v := ioc.InitOutboxRelays(db, articleShards, syncProducer, loggerV1)
+	scheduledPublisher := service.NewScheduledPublisher(articleRepository, cronJobRepository, attachmentService, loggerV1)
 	app := &App{
-		server: server,
+		server:    server,
+		relays:    v,
+		publisher: scheduledPublisher,
 	}
 	return app
 }
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewArticleGORMDAO, cache.NewArticleRedisCache, repository.NewCachedArticleRepository, service.NewArticleService, grpc.NewGrpcServer)
+var serviceProviderSet = wire.NewSet(dao2.NewGORMSubscriptionDAO, repository.NewCachedArticleRepository, repository.NewAttachmentRepository, repository.NewSubscriptionRepository, dao.NewGORMJobDAO, repository2.NewPreemptJobRepository, service.NewAccessChecker, service.NewArticleService, service.NewScheduledPublisher, grpc.NewGrpcServer)
 
-var thirdProvider = wire.NewSet(ioc.InitRedis, ioc.InitDB, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka, ioc.InitSyncProducer, events.NewSaramaSyncProducer)
+var thirdProvider = wire.NewSet(ioc.InitRedisClient, ioc.InitRedis, ioc.InitInvalidationBus, ioc.InitArticleCache, ioc.InitDB, ioc.InitArticleShards, ioc.InitIdGenerator, ioc.InitArticleDAO, ioc.InitAttachmentDAO, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka, ioc.InitSyncProducer, events.NewSaramaSyncProducer, ioc.InitOutboxRelays, ioc.InitStorage, ioc.InitAttachmentService, ioc.InitArchiveService, ioc.InitSensitiveDictionary, ioc.InitModerator, ioc.InitFollowClient, ioc.InitSubscriptionService)
@@ -60,6 +60,11 @@ func (c *CommentServiceServer) DeleteComment(ctx context.Context, request *comme
 	return &commentv1.DeleteCommentResponse{}, err
 }
 
+func (c *CommentServiceServer) DeleteByBiz(ctx context.Context, request *commentv1.DeleteByBizRequest) (*commentv1.DeleteByBizResponse, error) {
+	err := c.svc.DeleteByBiz(ctx, request.GetBiz(), request.GetBizIds())
+	return &commentv1.DeleteByBizResponse{}, err
+}
+
 func (c *CommentServiceServer) GetCommentList(ctx context.Context, request *commentv1.CommentListRequest) (*commentv1.CommentListResponse, error) {
 	minID := request.MinId
 	// 第一次查询
@@ -2,9 +2,11 @@ package ioc
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pluckhuang/goweb/aweb/comment/repository/dao"
 
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/spf13/viper"
 	"gorm.io/driver/mysql"
@@ -17,6 +19,9 @@ import (
 func InitDB(l logger.LoggerV1) *gorm.DB {
 	type Config struct {
 		DSN string `yaml:"dsn"`
+		// Replicas 从库的 DSN
+		Replicas            []string      `yaml:"replicas"`
+		HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
 	}
 	c := Config{
 		DSN: "root:root@tcp(localhost:3306)/mysql",
@@ -51,6 +56,15 @@ func InitDB(l logger.LoggerV1) *gorm.DB {
 	if err != nil {
 		panic(err)
 	}
+
+	err = gormx.UseReplicas(db, gormx.ReplicaConfig{
+		Replicas:            c.Replicas,
+		HealthCheckInterval: c.HealthCheckInterval,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	err = dao.InitTables(db)
 	if err != nil {
 		panic(err)
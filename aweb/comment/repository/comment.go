@@ -22,6 +22,8 @@ type CommentRepository interface {
 	CreateComment(ctx context.Context, comment domain.Comment) error
 	// 获取更多的一级评论对应的子评论
 	GetMoreReplies(ctx context.Context, rid int64, id int64, limit int64) ([]domain.Comment, bool, error)
+	// DeleteByBiz 删除资源下面的所有评论
+	DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error
 }
 
 type CachedCommentRepo struct {
@@ -79,6 +81,10 @@ func (c *CachedCommentRepo) DeleteComment(ctx context.Context, comment domain.Co
 	})
 }
 
+func (c *CachedCommentRepo) DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error {
+	return c.dao.DeleteByBiz(ctx, biz, bizIds)
+}
+
 func (c *CachedCommentRepo) CreateComment(ctx context.Context, comment domain.Comment) error {
 	return c.dao.Insert(ctx, c.toEntity(comment))
 }
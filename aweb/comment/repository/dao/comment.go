@@ -21,6 +21,8 @@ type CommentDAO interface {
 	Delete(ctx context.Context, u Comment) error
 	// 根据根评论的id和当前评论的id查找对应的回复
 	FindRepliesByRid(ctx context.Context, rid int64, id int64, limit int64) ([]Comment, bool, error)
+	// DeleteByBiz 删除资源下面的所有评论
+	DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error
 }
 
 // Comment 把这个评论的表结构设计好
@@ -93,6 +95,12 @@ func (c *GORMCommentDAO) Delete(ctx context.Context, u Comment) error {
 	}).Error
 }
 
+func (c *GORMCommentDAO) DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error {
+	return c.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ?", biz, bizIds).
+		Delete(&Comment{}).Error
+}
+
 func (c *GORMCommentDAO) FindRepliesByRid(ctx context.Context,
 	rid int64, id int64, limit int64) ([]Comment, bool, error) {
 	var res []Comment
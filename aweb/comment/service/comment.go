@@ -16,6 +16,8 @@ type CommentService interface {
 	CreateComment(ctx context.Context, comment domain.Comment) error
 	// 获取更多的一级评论对应的子评论
 	GetMoreReplies(ctx context.Context, rid int64, maxID int64, limit int64) ([]domain.Comment, bool, error)
+	// DeleteByBiz 资源被彻底删除之后，清理它下面的所有评论
+	DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error
 }
 
 type commentService struct {
@@ -38,6 +40,13 @@ func (c *commentService) DeleteComment(ctx context.Context, id int64) error {
 	})
 }
 
+func (c *commentService) DeleteByBiz(ctx context.Context, biz string, bizIds []int64) error {
+	if len(bizIds) == 0 {
+		return nil
+	}
+	return c.repo.DeleteByBiz(ctx, biz, bizIds)
+}
+
 func (c *commentService) GetCommentList(ctx context.Context, biz string,
 	bizId, minID, limit int64) ([]domain.Comment, bool, error) {
 	list, hasMore, err := c.repo.FindByBiz(ctx, biz, bizId, minID, limit)
@@ -3,7 +3,9 @@ package ioc
 import (
 	"time"
 
+	"github.com/pluckhuang/goweb/aweb/cronjob/repository"
 	"github.com/pluckhuang/goweb/aweb/cronjob/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -12,7 +14,13 @@ func InitRankingRedisCache(client redis.Cmdable) *cache.RankingRedisCache {
 	return cache.NewRankingRedisCache(client)
 }
 
-// 提供 RankingLocalCache
-func InitRankingLocalCache() *cache.RankingLocalCache {
-	return cache.NewRankingLocalCache(5 * time.Minute) // 5分钟过期时间
+// 提供 RankingLocalCache，别的实例更新了排行榜之后会通过 bus 通知过来
+func InitRankingLocalCache(bus cachex.InvalidationBus) *cache.RankingLocalCache {
+	res := cache.NewRankingLocalCache(5 * time.Minute) // 5分钟过期时间
+	bus.Subscribe(repository.RankingInvalidationKey, res)
+	return res
+}
+
+func InitTrashCache(client redis.Cmdable) cache.TrashCache {
+	return cache.NewTrashRedisCache(client)
 }
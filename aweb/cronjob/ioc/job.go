@@ -4,18 +4,58 @@ import (
 	"time"
 
 	rlock "github.com/gotomicro/redis-lock"
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	commentv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/comment/v1"
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
 	"github.com/pluckhuang/goweb/aweb/cronjob/job"
+	"github.com/pluckhuang/goweb/aweb/cronjob/repository"
 	"github.com/pluckhuang/goweb/aweb/cronjob/service"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/robfig/cron/v3"
+	"github.com/spf13/viper"
 )
 
 func InitRankingJob(l logger.LoggerV1, client *rlock.Client, svc service.RankingService) *job.RankingJob {
 	return job.NewRankingJob(l, client, svc, time.Second*30)
 }
 
-func InitCronJob(l logger.LoggerV1, rjob *job.RankingJob) *cron.Cron {
+func InitTrashService(artSvc articlev1.ArticleServiceClient,
+	interactiveSvc interactivev1.InteractiveServiceClient,
+	commentSvc commentv1.CommentServiceClient,
+	repo repository.TrashRepository,
+	l logger.LoggerV1) service.TrashService {
+	cfg := TrashConfig()
+	return service.NewPurgeTrashService(artSvc, interactiveSvc, commentSvc, repo,
+		cfg.Retention, cfg.BatchSize, l)
+}
+
+func InitPurgeTrashJob(l logger.LoggerV1, client *rlock.Client, svc service.TrashService) *job.PurgeTrashJob {
+	return job.NewPurgeTrashJob(l, client, svc, time.Minute*5)
+}
+
+type trashConfig struct {
+	// Retention 回收站里面的文章保留多久
+	Retention time.Duration `yaml:"retention"`
+	BatchSize int           `yaml:"batchSize"`
+	// Spec 清理任务的 cron 表达式
+	Spec string `yaml:"spec"`
+}
+
+func TrashConfig() trashConfig {
+	cfg := trashConfig{
+		Retention: time.Hour * 24 * 30,
+		BatchSize: 100,
+		Spec:      "@every 1h",
+	}
+	err := viper.UnmarshalKey("trash", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+func InitCronJob(l logger.LoggerV1, rjob *job.RankingJob, pjob *job.PurgeTrashJob) *cron.Cron {
 	builder := job.NewCronJobBuilder(l, prometheus.SummaryOpts{
 		Namespace: "goweb",
 		Subsystem: "aweb",
@@ -34,5 +74,9 @@ func InitCronJob(l logger.LoggerV1, rjob *job.RankingJob) *cron.Cron {
 	if err != nil {
 		panic(err)
 	}
+	_, err = expr.AddJob(TrashConfig().Spec, builder.Build(pjob))
+	if err != nil {
+		panic(err)
+	}
 	return expr
 }
@@ -1,18 +1,39 @@
 package ioc
 
 import (
+	"context"
+
 	rlock "github.com/gotomicro/redis-lock"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 )
 
-func InitRedis() redis.Cmdable {
-	cmd := redis.NewClient(&redis.Options{
+func InitRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr: viper.GetString("redis.addr"),
 	})
-	return cmd
+}
+
+func InitRedis(client *redis.Client) redis.Cmdable {
+	return client
 }
 
 func InitRlockClient(client redis.Cmdable) *rlock.Client {
 	return rlock.NewClient(client)
 }
+
+// InitInvalidationBus 通知别的实例删除本地缓存
+func InitInvalidationBus(client *redis.Client, l logger.LoggerV1) cachex.InvalidationBus {
+	channel := viper.GetString("cache.invalidation.channel")
+	if channel == "" {
+		channel = "cronjob:cache_invalidation"
+	}
+	bus := cachex.NewRedisInvalidationBus(client, channel, l)
+	err := bus.Start(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	return bus
+}
@@ -0,0 +1,47 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	rlock "github.com/gotomicro/redis-lock"
+	"github.com/pluckhuang/goweb/aweb/cronjob/service"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+// PurgeTrashJob 定期彻底删除回收站里面过期的文章
+type PurgeTrashJob struct {
+	*LockableJob
+	svc     service.TrashService
+	timeout time.Duration
+}
+
+func NewPurgeTrashJob(
+	l logger.LoggerV1,
+	client *rlock.Client,
+	svc service.TrashService,
+	timeout time.Duration) *PurgeTrashJob {
+	return &PurgeTrashJob{
+		LockableJob: NewLockableJob(l, client, "trash:purge:job", timeout),
+		svc:         svc,
+		timeout:     timeout,
+	}
+}
+
+func (p *PurgeTrashJob) Run() error {
+	if !p.EnsureLock() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	return p.svc.PurgeExpired(ctx)
+}
+
+func (p *PurgeTrashJob) Name() string {
+	return "PurgeTrashJob"
+}
+
+func (p *PurgeTrashJob) Close() error {
+	return p.Unlock()
+}
@@ -45,3 +45,13 @@ func (r *RankingLocalCache) ForceGet(ctx context.Context) ([]domain.Article, err
 	}
 	return arts, nil
 }
+
+// Evict 别的实例更新了排行榜，让本地的过期
+// 数据留着给 ForceGet 兜底，Redis 出问题的时候旧的排行榜总比没有好
+func (r *RankingLocalCache) Evict(key string) {
+	r.ddl.Store(time.Time{})
+}
+
+func (r *RankingLocalCache) EvictAll() {
+	r.ddl.Store(time.Time{})
+}
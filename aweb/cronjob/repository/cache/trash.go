@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TrashCache 记录已经彻底删除、但是互动和评论还没有清理掉的文章
+type TrashCache interface {
+	AddPending(ctx context.Context, ids []int64) error
+	// Pending 随机取最多 limit 个
+	Pending(ctx context.Context, limit int) ([]int64, error)
+	RemovePending(ctx context.Context, ids []int64) error
+}
+
+type TrashRedisCache struct {
+	client redis.Cmdable
+	key    string
+}
+
+func NewTrashRedisCache(client redis.Cmdable) *TrashRedisCache {
+	return &TrashRedisCache{
+		client: client,
+		// 不设置过期时间，清理成功之前都要留着
+		key: "trash:pending_cleanup",
+	}
+}
+
+func (t *TrashRedisCache) AddPending(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return t.client.SAdd(ctx, t.key, t.members(ids)...).Err()
+}
+
+func (t *TrashRedisCache) Pending(ctx context.Context, limit int) ([]int64, error) {
+	vals, err := t.client.SRandMemberN(ctx, t.key, int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(vals))
+	for _, val := range vals {
+		id, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			continue
+		}
+		res = append(res, id)
+	}
+	return res, nil
+}
+
+func (t *TrashRedisCache) RemovePending(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return t.client.SRem(ctx, t.key, t.members(ids)...).Err()
+}
+
+func (t *TrashRedisCache) members(ids []int64) []any {
+	res := make([]any, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, strconv.FormatInt(id, 10))
+	}
+	return res
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pluckhuang/goweb/aweb/article/domain"
 	"github.com/pluckhuang/goweb/aweb/cronjob/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
 )
 
 type RankingRepository interface {
@@ -12,13 +13,18 @@ type RankingRepository interface {
 	GetTopN(ctx context.Context) ([]domain.Article, error)
 }
 
+// RankingInvalidationKey 排行榜更新之后在 bus 上面通知的 key
+const RankingInvalidationKey = "ranking:top_n"
+
 type CachedRankingRepository struct {
 	redisCache *cache.RankingRedisCache
 	localCache *cache.RankingLocalCache
+	bus        cachex.InvalidationBus
 }
 
-func NewCachedRankingRepository(redisCache *cache.RankingRedisCache, localCache *cache.RankingLocalCache) RankingRepository {
-	return &CachedRankingRepository{redisCache: redisCache, localCache: localCache}
+func NewCachedRankingRepository(redisCache *cache.RankingRedisCache,
+	localCache *cache.RankingLocalCache, bus cachex.InvalidationBus) RankingRepository {
+	return &CachedRankingRepository{redisCache: redisCache, localCache: localCache, bus: bus}
 }
 
 func (repo *CachedRankingRepository) GetTopN(ctx context.Context) ([]domain.Article, error) {
@@ -36,5 +42,10 @@ func (repo *CachedRankingRepository) GetTopN(ctx context.Context) ([]domain.Arti
 
 func (repo *CachedRankingRepository) ReplaceTopN(ctx context.Context, arts []domain.Article) error {
 	_ = repo.localCache.Set(ctx, arts)
-	return repo.redisCache.Set(ctx, arts)
+	err := repo.redisCache.Set(ctx, arts)
+	if err != nil {
+		return err
+	}
+	// 别的实例本地缓存的还是旧的排行榜
+	return repo.bus.Publish(ctx, RankingInvalidationKey)
 }
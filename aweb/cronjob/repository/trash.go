@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pluckhuang/goweb/aweb/cronjob/repository/cache"
+)
+
+// TrashRepository 文章删掉之后，关联数据的清理失败了要记下来，下次再试
+type TrashRepository interface {
+	AddPendingCleanup(ctx context.Context, ids []int64) error
+	PendingCleanup(ctx context.Context, limit int) ([]int64, error)
+	RemovePendingCleanup(ctx context.Context, ids []int64) error
+}
+
+type CachedTrashRepository struct {
+	cache cache.TrashCache
+}
+
+func NewCachedTrashRepository(cache cache.TrashCache) TrashRepository {
+	return &CachedTrashRepository{cache: cache}
+}
+
+func (repo *CachedTrashRepository) AddPendingCleanup(ctx context.Context, ids []int64) error {
+	return repo.cache.AddPending(ctx, ids)
+}
+
+func (repo *CachedTrashRepository) PendingCleanup(ctx context.Context, limit int) ([]int64, error) {
+	return repo.cache.Pending(ctx, limit)
+}
+
+func (repo *CachedTrashRepository) RemovePendingCleanup(ctx context.Context, ids []int64) error {
+	return repo.cache.RemovePending(ctx, ids)
+}
@@ -64,7 +64,7 @@ func (b *BatchRankingService) TopN(ctx context.Context) error {
 }
 
 func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error) {
-	offset := 0
+	cursor := ""
 	start := time.Now()
 	ddl := start.Add(-7 * 24 * time.Hour)
 
@@ -85,9 +85,9 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 
 	for {
 		// 取数据
-		artsResp, err := b.artSvc.ListPub(ctx, &articlev1.ListPubRequest{
+		artsResp, err := b.artSvc.ListPubByCursor(ctx, &articlev1.ListPubByCursorRequest{
 			Start:  timestamppb.New(start),
-			Offset: int32(offset),
+			Cursor: cursor,
 			Limit:  int32(b.batchSize),
 		})
 		if err != nil {
@@ -118,6 +118,9 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 					},
 					Utime: art.Utime.AsTime(),
 					Ctime: art.Ctime.AsTime(),
+					Rendered: domain.RenderedContent{
+						Abstract: art.Abstract,
+					},
 				},
 			}
 			err = topN.Enqueue(ele)
@@ -132,10 +135,9 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 				}
 			}
 		}
-		offset = offset + len(arts)
-		// 没有取够一批，我们就直接中断执行
+		cursor = artsResp.NextCursor
 		// 没有下一批了
-		if len(arts) < b.batchSize ||
+		if cursor == "" ||
 			// 这个是一个优化
 			arts[len(arts)-1].Utime.AsTime().Before(ddl) {
 			break
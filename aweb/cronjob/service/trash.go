@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	commentv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/comment/v1"
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/cronjob/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const articleBiz = "article"
+
+type TrashService interface {
+	// PurgeExpired 彻底删除在回收站里面超过保留期限的文章
+	PurgeExpired(ctx context.Context) error
+}
+
+type PurgeTrashService struct {
+	artSvc         articlev1.ArticleServiceClient
+	interactiveSvc interactivev1.InteractiveServiceClient
+	commentSvc     commentv1.CommentServiceClient
+	repo           repository.TrashRepository
+
+	// retention 文章在回收站里面保留多久
+	retention time.Duration
+	batchSize int
+	l         logger.LoggerV1
+}
+
+func NewPurgeTrashService(artSvc articlev1.ArticleServiceClient,
+	interactiveSvc interactivev1.InteractiveServiceClient,
+	commentSvc commentv1.CommentServiceClient,
+	repo repository.TrashRepository,
+	retention time.Duration, batchSize int,
+	l logger.LoggerV1) TrashService {
+	return &PurgeTrashService{
+		artSvc:         artSvc,
+		interactiveSvc: interactiveSvc,
+		commentSvc:     commentSvc,
+		repo:           repo,
+		retention:      retention,
+		batchSize:      batchSize,
+		l:              l,
+	}
+}
+
+func (s *PurgeTrashService) PurgeExpired(ctx context.Context) error {
+	// 先把上一次没有清理完的补上
+	err := s.retryCleanup(ctx)
+	if err != nil {
+		return err
+	}
+	// 整个任务用同一个截止时间，中途被恢复的文章 PurgeTrash 不会删除，也不会返回
+	before := timestamppb.New(time.Now().Add(-s.retention))
+	for {
+		resp, err := s.artSvc.ListExpiredTrash(ctx, &articlev1.ListExpiredTrashRequest{
+			Before: before,
+			Limit:  int32(s.batchSize),
+		})
+		if err != nil {
+			return err
+		}
+		ids := resp.GetIds()
+		if len(ids) == 0 {
+			return nil
+		}
+		err = s.purge(ctx, ids, before)
+		if err != nil {
+			return err
+		}
+		if len(ids) < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// purge 先删除文章本身，只有真的被删掉的文章才清理关联的数据
+// 反过来的话，列出来之后又被恢复的文章，互动和评论就没了
+func (s *PurgeTrashService) purge(ctx context.Context, ids []int64, before *timestamppb.Timestamp) error {
+	resp, err := s.artSvc.PurgeTrash(ctx, &articlev1.PurgeTrashRequest{
+		Ids:    ids,
+		Before: before,
+	})
+	if err != nil {
+		return err
+	}
+	purged := resp.GetIds()
+	s.l.Info("清理回收站",
+		logger.Int("listed", len(ids)),
+		logger.Int("purged", len(purged)))
+	if len(purged) == 0 {
+		return nil
+	}
+	// 文章已经没了，不会再出现在回收站里面，清理失败的话只能靠这里的记录重试
+	err = s.repo.AddPendingCleanup(ctx, purged)
+	if err != nil {
+		s.l.Error("记录待清理的文章失败",
+			logger.Field{Key: "ids", Val: purged},
+			logger.Error(err))
+	}
+	return s.cleanup(ctx, purged)
+}
+
+// retryCleanup 清理记录下来的文章，直到全部成功或者出错
+func (s *PurgeTrashService) retryCleanup(ctx context.Context) error {
+	for {
+		ids, err := s.repo.PendingCleanup(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		err = s.cleanup(ctx, ids)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// cleanup 删除互动和评论，重复执行也没有问题
+func (s *PurgeTrashService) cleanup(ctx context.Context, ids []int64) error {
+	_, err := s.interactiveSvc.DeleteByBiz(ctx, &interactivev1.DeleteByBizRequest{
+		Biz:    articleBiz,
+		BizIds: ids,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.commentSvc.DeleteByBiz(ctx, &commentv1.DeleteByBizRequest{
+		Biz:    articleBiz,
+		BizIds: ids,
+	})
+	if err != nil {
+		return err
+	}
+	return s.repo.RemovePendingCleanup(ctx, ids)
+}
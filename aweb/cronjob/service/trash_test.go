@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	commentv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/comment/v1"
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// trashLog 按照调用的顺序记录每一步
+type trashLog []string
+
+func (l *trashLog) add(step string, ids []int64) {
+	*l = append(*l, fmt.Sprintf("%s%v", step, ids))
+}
+
+type fakeTrashArticle struct {
+	articlev1.ArticleServiceClient
+	log *trashLog
+	// listed 回收站里面过期的文章
+	listed []int64
+	// restored 列出来之后被作者恢复了的文章
+	restored map[int64]bool
+}
+
+func (f *fakeTrashArticle) ListExpiredTrash(ctx context.Context, in *articlev1.ListExpiredTrashRequest, opts ...grpc.CallOption) (*articlev1.ListExpiredTrashResponse, error) {
+	ids := f.listed
+	f.listed = nil
+	return &articlev1.ListExpiredTrashResponse{Ids: ids}, nil
+}
+
+func (f *fakeTrashArticle) PurgeTrash(ctx context.Context, in *articlev1.PurgeTrashRequest, opts ...grpc.CallOption) (*articlev1.PurgeTrashResponse, error) {
+	var purged []int64
+	for _, id := range in.GetIds() {
+		if !f.restored[id] {
+			purged = append(purged, id)
+		}
+	}
+	f.log.add("purge", purged)
+	return &articlev1.PurgeTrashResponse{Ids: purged}, nil
+}
+
+type fakeTrashInteractive struct {
+	interactivev1.InteractiveServiceClient
+	log *trashLog
+	err error
+}
+
+func (f *fakeTrashInteractive) DeleteByBiz(ctx context.Context, in *interactivev1.DeleteByBizRequest, opts ...grpc.CallOption) (*interactivev1.DeleteByBizResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.log.add("interactive", in.GetBizIds())
+	return &interactivev1.DeleteByBizResponse{}, nil
+}
+
+type fakeTrashComment struct {
+	commentv1.CommentServiceClient
+	log *trashLog
+}
+
+func (f *fakeTrashComment) DeleteByBiz(ctx context.Context, in *commentv1.DeleteByBizRequest, opts ...grpc.CallOption) (*commentv1.DeleteByBizResponse, error) {
+	f.log.add("comment", in.GetBizIds())
+	return &commentv1.DeleteByBizResponse{}, nil
+}
+
+type fakeTrashRepo struct {
+	pending map[int64]struct{}
+}
+
+func (f *fakeTrashRepo) AddPendingCleanup(ctx context.Context, ids []int64) error {
+	for _, id := range ids {
+		f.pending[id] = struct{}{}
+	}
+	return nil
+}
+
+func (f *fakeTrashRepo) PendingCleanup(ctx context.Context, limit int) ([]int64, error) {
+	res := make([]int64, 0, len(f.pending))
+	for id := range f.pending {
+		if len(res) == limit {
+			break
+		}
+		res = append(res, id)
+	}
+	return res, nil
+}
+
+func (f *fakeTrashRepo) RemovePendingCleanup(ctx context.Context, ids []int64) error {
+	for _, id := range ids {
+		delete(f.pending, id)
+	}
+	return nil
+}
+
+func TestPurgeTrashService_PurgeExpired(t *testing.T) {
+	log := &trashLog{}
+	art := &fakeTrashArticle{log: log, listed: []int64{1, 2, 3}, restored: map[int64]bool{2: true}}
+	repo := &fakeTrashRepo{pending: map[int64]struct{}{}}
+	svc := NewPurgeTrashService(art, &fakeTrashInteractive{log: log}, &fakeTrashComment{log: log}, repo,
+		time.Hour, 10, logger.NewNopLogger())
+
+	require.NoError(t, svc.PurgeExpired(context.Background()))
+	// 先删文章，被恢复的 2 不删，也不清理它的互动和评论
+	assert.Equal(t, trashLog{"purge[1 3]", "interactive[1 3]", "comment[1 3]"}, *log)
+	assert.Empty(t, repo.pending)
+}
+
+func TestPurgeTrashService_RetryCleanup(t *testing.T) {
+	log := &trashLog{}
+	art := &fakeTrashArticle{log: log, listed: []int64{1, 2}}
+	intr := &fakeTrashInteractive{log: log, err: errors.New("互动服务不可用")}
+	repo := &fakeTrashRepo{pending: map[int64]struct{}{}}
+	svc := NewPurgeTrashService(art, intr, &fakeTrashComment{log: log}, repo,
+		time.Hour, 10, logger.NewNopLogger())
+
+	require.Error(t, svc.PurgeExpired(context.Background()))
+	assert.Equal(t, trashLog{"purge[1 2]"}, *log)
+	// 文章已经没了，不会再出现在回收站里面，清理失败要记下来
+	assert.Equal(t, map[int64]struct{}{1: {}, 2: {}}, repo.pending)
+
+	// 下一次执行的时候先补上
+	intr.err = nil
+	*log = (*log)[:0]
+	require.NoError(t, svc.PurgeExpired(context.Background()))
+	require.Len(t, *log, 2)
+	assert.Contains(t, []string{"interactive[1 2]", "interactive[2 1]"}, (*log)[0])
+	assert.Contains(t, []string{"comment[1 2]", "comment[2 1]"}, (*log)[1])
+	assert.Empty(t, repo.pending)
+}
@@ -12,7 +12,9 @@ import (
 func Init() *App {
 	wire.Build(
 		// 第三方依赖
+		ioc.InitRedisClient,
 		ioc.InitRedis,
+		ioc.InitInvalidationBus,
 		ioc.InitLogger,
 		ioc.InitEtcd,
 		ioc.InitRlockClient,
@@ -23,6 +25,11 @@ func Init() *App {
 		ioc.InitArticleClient,
 		service.NewBatchRankingService,
 		ioc.InitRankingJob,
+		ioc.InitCommentClient,
+		ioc.InitTrashCache,
+		repository.NewCachedTrashRepository,
+		ioc.InitTrashService,
+		ioc.InitPurgeTrashJob,
 		ioc.InitCronJob,
 		ioc.InitWebServer,
 
@@ -17,17 +17,24 @@ import (
 func Init() *App {
 	engine := ioc.InitWebServer()
 	loggerV1 := ioc.InitLogger()
-	cmdable := ioc.InitRedis()
-	client := ioc.InitRlockClient(cmdable)
+	client := ioc.InitRedisClient()
+	cmdable := ioc.InitRedis(client)
+	rlockClient := ioc.InitRlockClient(cmdable)
 	clientv3Client := ioc.InitEtcd()
 	interactiveServiceClient := ioc.InitInteractiveClient(clientv3Client)
 	articleServiceClient := ioc.InitArticleClient(clientv3Client)
 	rankingRedisCache := ioc.InitRankingRedisCache(cmdable)
-	rankingLocalCache := ioc.InitRankingLocalCache()
-	rankingRepository := repository.NewCachedRankingRepository(rankingRedisCache, rankingLocalCache)
+	invalidationBus := ioc.InitInvalidationBus(client, loggerV1)
+	rankingLocalCache := ioc.InitRankingLocalCache(invalidationBus)
+	rankingRepository := repository.NewCachedRankingRepository(rankingRedisCache, rankingLocalCache, invalidationBus)
 	rankingService := service.NewBatchRankingService(interactiveServiceClient, articleServiceClient, rankingRepository)
-	rankingJob := ioc.InitRankingJob(loggerV1, client, rankingService)
-	cron := ioc.InitCronJob(loggerV1, rankingJob)
+	rankingJob := ioc.InitRankingJob(loggerV1, rlockClient, rankingService)
+	commentServiceClient := ioc.InitCommentClient(clientv3Client)
+	trashCache := ioc.InitTrashCache(cmdable)
+	trashRepository := repository.NewCachedTrashRepository(trashCache)
+	trashService := ioc.InitTrashService(articleServiceClient, interactiveServiceClient, commentServiceClient, trashRepository, loggerV1)
+	purgeTrashJob := ioc.InitPurgeTrashJob(loggerV1, rlockClient, trashService)
+	cron := ioc.InitCronJob(loggerV1, rankingJob, purgeTrashJob)
 	app := &App{
 		server: engine,
 		cron:   cron,
@@ -7,6 +7,8 @@ import (
 	"github.com/pluckhuang/goweb/aweb/follow/domain"
 	"github.com/pluckhuang/goweb/aweb/follow/service"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type FollowServiceServer struct {
@@ -65,6 +67,10 @@ func (f *FollowServiceServer) GetFollower(ctx context.Context, request *followv1
 
 func (f *FollowServiceServer) FollowInfo(ctx context.Context, request *followv1.FollowInfoRequest) (*followv1.FollowInfoResponse, error) {
 	info, err := f.svc.FollowInfo(ctx, request.Follower, request.Followee)
+	if err == service.ErrFollowRelationNotFound {
+		// 没有关注是正常的业务结果，调用方按照 NOT_FOUND 判断
+		return nil, status.Error(codes.NotFound, "没有关注")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,13 @@
 package dao
 
-import "context"
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// ErrFollowRelationNotFound 没有关注，或者已经取消关注了
+var ErrFollowRelationNotFound = gorm.ErrRecordNotFound
 
 // 存储用户的关注数据
 type FollowRelation struct {
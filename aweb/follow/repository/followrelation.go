@@ -9,6 +9,8 @@ import (
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 )
 
+var ErrFollowRelationNotFound = dao.ErrFollowRelationNotFound
+
 type FollowRepository interface {
 	// AddFollowRelation 创建关注关系
 	AddFollowRelation(ctx context.Context, f domain.FollowRelation) error
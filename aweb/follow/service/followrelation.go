@@ -7,6 +7,8 @@ import (
 	"github.com/pluckhuang/goweb/aweb/follow/repository"
 )
 
+var ErrFollowRelationNotFound = repository.ErrFollowRelationNotFound
+
 type FollowRelationService interface {
 	Follow(ctx context.Context, follower, followee int64) error
 	CancelFollow(ctx context.Context, follower, followee int64) error
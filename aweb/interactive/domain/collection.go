@@ -0,0 +1,23 @@
+package domain
+
+// Collection 用户的收藏夹
+// Id 为 0 的是默认收藏夹，每个用户都有，不用创建，只有自己能看到
+type Collection struct {
+	Id   int64
+	Uid  int64
+	Name string
+	// Private 私密的收藏夹别人看不到
+	Private bool
+	Ctime   int64
+	Utime   int64
+}
+
+// CollectionItem 收藏夹里面的一条收藏
+type CollectionItem struct {
+	Cid   int64
+	Biz   string
+	BizId int64
+	Uid   int64
+	// Ctime 放进这个收藏夹的时间
+	Ctime int64
+}
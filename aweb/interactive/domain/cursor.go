@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("非法的分页游标")
+
+// Cursor 按照 (Time, Id) 倒序翻页的位置，指向上一页的最后一条
+// 零值表示从第一页开始
+type Cursor struct {
+	Time int64
+	Id   int64
+}
+
+func (c Cursor) IsZero() bool {
+	return c.Time == 0 && c.Id == 0
+}
+
+// Encode 调用方不应该关心游标的内容，所以编码成不透明的字符串
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.Time, 10) + ":" + strconv.FormatInt(c.Id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor 空字符串返回零值，也就是第一页
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	timeStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	t, err := strconv.ParseInt(timeStr, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{Time: t, Id: id}, nil
+}
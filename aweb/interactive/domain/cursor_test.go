@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	item := HistoryItem{Id: 12, Time: 1700000000123}
+	c, err := ParseCursor(CursorOf(item).Encode())
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Time: 1700000000123, Id: 12}, c)
+
+	c, err = ParseCursor("")
+	require.NoError(t, err)
+	assert.True(t, c.IsZero())
+
+	for _, s := range []string{"!!!", "MTIz", "YTpi"} {
+		_, err = ParseCursor(s)
+		assert.Equal(t, ErrInvalidCursor, err, s)
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	items := []HistoryItem{
+		{Id: 3, Time: 300},
+		{Id: 2, Time: 200},
+	}
+	assert.Equal(t, "", NextCursor(items, 3))
+	assert.Equal(t, Cursor{Time: 200, Id: 2}.Encode(), NextCursor(items, 2))
+	assert.Equal(t, "", NextCursor(nil, 2))
+}
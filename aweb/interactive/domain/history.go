@@ -0,0 +1,35 @@
+package domain
+
+// HistoryItem 用户点赞过或者收藏过的一个资源
+type HistoryItem struct {
+	// Id 点赞或者收藏那一条记录的 id，翻页用
+	Id    int64
+	Biz   string
+	BizId int64
+	// Time 点赞或者收藏的时间，毫秒数
+	Time int64
+	// Meta 资源的标题之类的，目前只有 article 有，
+	// 资源已经下线或者查询失败的时候是 nil
+	Meta *ItemMeta
+}
+
+// ItemMeta 列表里面展示资源需要的信息
+type ItemMeta struct {
+	Title    string
+	AuthorId int64
+	Abstract string
+}
+
+// CursorOf 以 item 为上一页的最后一条
+func CursorOf(item HistoryItem) Cursor {
+	return Cursor{Time: item.Time, Id: item.Id}
+}
+
+// NextCursor 根据这一页的结果算出下一页的游标
+// 不满一页说明没有更多了，返回空字符串
+func NextCursor(items []HistoryItem, limit int) string {
+	if len(items) == 0 || len(items) < limit {
+		return ""
+	}
+	return CursorOf(items[len(items)-1]).Encode()
+}
@@ -6,6 +6,10 @@ type Interactive struct {
 	ReadCnt    int64
 	LikeCnt    int64
 	CollectCnt int64
-	Liked      bool
-	Collected  bool
+	// Reactions 每种表态的数量
+	Reactions map[ReactionType]int64
+	Liked     bool
+	Collected bool
+	// Reaction 查看的人自己的表态
+	Reaction ReactionType
 }
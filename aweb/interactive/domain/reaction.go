@@ -0,0 +1,44 @@
+package domain
+
+// ReactionType 点赞之外的表态，每个人对一个资源只能有一种，换一种会覆盖之前的
+// 0 表示没有表态
+type ReactionType uint8
+
+const (
+	ReactionTypeUnknown ReactionType = iota
+	// ReactionTypeInsightful 有启发
+	ReactionTypeInsightful
+	// ReactionTypeFunny 有趣
+	ReactionTypeFunny
+	// ReactionTypeCelebrate 庆祝
+	ReactionTypeCelebrate
+)
+
+// ReactionTypes 所有的表态，新增类型要在这里加上，并且在 Interactive 表里面加一列
+var ReactionTypes = []ReactionType{
+	ReactionTypeInsightful,
+	ReactionTypeFunny,
+	ReactionTypeCelebrate,
+}
+
+func (r ReactionType) ToUint8() uint8 {
+	return uint8(r)
+}
+
+func (r ReactionType) Valid() bool {
+	return r >= ReactionTypeInsightful && r <= ReactionTypeCelebrate
+}
+
+// String 用作数据库的列名和缓存里面的字段名的前缀
+func (r ReactionType) String() string {
+	switch r {
+	case ReactionTypeInsightful:
+		return "insightful"
+	case ReactionTypeFunny:
+		return "funny"
+	case ReactionTypeCelebrate:
+		return "celebrate"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidGranularity = errors.New("不支持的时间粒度")
+
+// Granularity 趋势数据的时间粒度
+type Granularity uint8
+
+const (
+	GranularityUnknown Granularity = iota
+	GranularityHour
+	GranularityDay
+)
+
+func (g Granularity) Valid() bool {
+	return g == GranularityHour || g == GranularityDay
+}
+
+// Truncate 返回 t 所在的桶的开始时间，天是按照 t 所在的时区切的
+func (g Granularity) Truncate(t time.Time) time.Time {
+	switch g {
+	case GranularityHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case GranularityDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	default:
+		return t
+	}
+}
+
+// Next 下一个桶的开始时间，t 必须是 Truncate 过的
+// 天用 AddDate，夏令时切换的那天也不会错位
+func (g Granularity) Next(t time.Time) time.Time {
+	if g == GranularityDay {
+		return t.AddDate(0, 0, 1)
+	}
+	return t.Add(time.Hour)
+}
+
+// BucketDelta 一次互动带来的变化，会同时计入小时桶和天桶
+type BucketDelta struct {
+	Biz   string
+	BizId int64
+	// Time 互动发生的时间
+	Time       time.Time
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+}
+
+// SeriesPoint 趋势图上的一个点，Start 是桶的开始时间
+type SeriesPoint struct {
+	Start      time.Time
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+}
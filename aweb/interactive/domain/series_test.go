@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGranularity_Truncate(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	at := time.Date(2024, 3, 5, 23, 45, 12, 100, loc)
+
+	assert.Equal(t, time.Date(2024, 3, 5, 23, 0, 0, 0, loc), GranularityHour.Truncate(at))
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, loc), GranularityDay.Truncate(at))
+	// 同一个时刻换成 UTC 就是另外一天的桶
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), GranularityDay.Truncate(at.UTC()))
+}
+
+func TestGranularity_Next(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 2024-03-10 开始夏令时，这一天只有 23 个小时
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, loc)
+	next := GranularityDay.Next(day)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, loc), next)
+	assert.Equal(t, 23*time.Hour, next.Sub(day))
+
+	hour := time.Date(2024, 3, 10, 5, 0, 0, 0, loc)
+	assert.Equal(t, time.Hour, GranularityHour.Next(hour).Sub(hour))
+}
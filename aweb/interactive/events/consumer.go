@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
 	"github.com/pluckhuang/goweb/aweb/interactive/repository"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/pluckhuang/goweb/aweb/pkg/saramax"
@@ -14,19 +15,22 @@ import (
 var _ saramax.Consumer = &InteractiveSyncEventConsumer{}
 
 type InteractiveSyncEventConsumer struct {
-	client sarama.Client
-	repo   repository.InteractiveRepository
-	l      logger.LoggerV1
+	client    sarama.Client
+	repo      repository.InteractiveRepository
+	analytics repository.AnalyticsRepository
+	l         logger.LoggerV1
 }
 
 func NewInteractiveSyncEventConsumer(
 	client sarama.Client,
 	l logger.LoggerV1,
-	repo repository.InteractiveRepository) *InteractiveSyncEventConsumer {
+	repo repository.InteractiveRepository,
+	analytics repository.AnalyticsRepository) *InteractiveSyncEventConsumer {
 	ic := &InteractiveSyncEventConsumer{
-		repo:   repo,
-		client: client,
-		l:      l,
+		repo:      repo,
+		analytics: analytics,
+		client:    client,
+		l:         l,
 	}
 	return ic
 }
@@ -52,15 +56,28 @@ func (r *InteractiveSyncEventConsumer) Consume(msg *sarama.ConsumerMessage,
 	event InteractiveEvent) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
+	delta := domain.BucketDelta{Biz: event.Biz, BizId: event.BizId, Time: eventTime(msg)}
+	var err error
 	switch event.Type {
 	case LikeEventType:
-		return r.repo.IncrLike(ctx, event.Biz, event.BizId, event.Uid)
+		err = r.repo.IncrLike(ctx, event.Biz, event.BizId, event.Uid)
+		delta.LikeCnt = 1
 	case CancelLikeEventType:
-		return r.repo.DecrLike(ctx, event.Biz, event.BizId, event.Uid)
+		err = r.repo.DecrLike(ctx, event.Biz, event.BizId, event.Uid)
+		// 取消点赞算在取消的那个桶里面，桶里面的是净增的点赞数
+		delta.LikeCnt = -1
 	case CollectEventType:
-		return r.repo.AddCollectionItem(ctx, event.Biz, event.BizId, 0, event.Uid)
+		_, err = r.repo.AddCollectionItem(ctx, event.Biz, event.BizId, event.Cid, event.Uid)
+		delta.CollectCnt = 1
+	case CancelCollectEventType:
+		_, err = r.repo.RemoveCollectionItem(ctx, event.Biz, event.BizId, event.Uid)
+		delta.CollectCnt = -1
 	default:
 		r.l.Warn("未知的互动事件类型", logger.Int64("type: ", int64(event.Type)))
 		return errors.New("unknown interactive event type")
 	}
+	if err != nil {
+		return err
+	}
+	return r.analytics.IncrBuckets(ctx, []domain.BucketDelta{delta})
 }
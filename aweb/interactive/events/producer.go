@@ -14,10 +14,12 @@ type InteractiveProducer interface {
 }
 
 type InteractiveEvent struct {
-	Type  InteractiveEventType `json:"type"` // 1-喜欢 2-收藏 3-取消喜欢
+	Type  InteractiveEventType `json:"type"` // 1-喜欢 2-收藏 3-取消喜欢 4-取消收藏
 	Biz   string               `json:"biz"`
 	BizId int64                `json:"bizId"`
 	Uid   int64                `json:"uid"`
+	// Cid 收藏到哪个收藏夹，只有收藏事件有
+	Cid int64 `json:"cid,omitempty"`
 }
 
 type InteractiveEventType int64
@@ -26,6 +28,8 @@ const (
 	LikeEventType       InteractiveEventType = 1
 	CollectEventType    InteractiveEventType = 2
 	CancelLikeEventType InteractiveEventType = 3
+	// CancelCollectEventType 取消收藏，删除收藏夹的时候里面的每一条收藏都会有一个
+	CancelCollectEventType InteractiveEventType = 4
 )
 
 type interactiveProducer struct {
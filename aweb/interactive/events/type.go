@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
 	"github.com/pluckhuang/goweb/aweb/interactive/repository"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/pluckhuang/goweb/aweb/pkg/saramax"
@@ -20,19 +21,26 @@ type ReadEvent struct {
 var _ saramax.Consumer = &InteractiveReadEventConsumer{}
 
 type InteractiveReadEventConsumer struct {
-	client sarama.Client
-	repo   repository.InteractiveRepository
-	l      logger.LoggerV1
+	client    sarama.Client
+	repo      repository.InteractiveRepository
+	analytics repository.AnalyticsRepository
+	l         logger.LoggerV1
+	batch     saramax.BatchConfig
 }
 
+// NewInteractiveReadEventConsumer 阅读事件攒一批再写库，batch 控制一批多少条、最多等多久
 func NewInteractiveReadEventConsumer(
 	client sarama.Client,
 	l logger.LoggerV1,
-	repo repository.InteractiveRepository) *InteractiveReadEventConsumer {
+	repo repository.InteractiveRepository,
+	analytics repository.AnalyticsRepository,
+	batch saramax.BatchConfig) *InteractiveReadEventConsumer {
 	ic := &InteractiveReadEventConsumer{
-		repo:   repo,
-		client: client,
-		l:      l,
+		repo:      repo,
+		analytics: analytics,
+		client:    client,
+		l:         l,
+		batch:     batch,
 	}
 	return ic
 }
@@ -46,7 +54,7 @@ func (r *InteractiveReadEventConsumer) Start() error {
 	go func() {
 		err := cg.Consume(context.Background(),
 			[]string{topicReadEvent},
-			saramax.NewHandler[ReadEvent](r.l, r.Consume))
+			saramax.NewBatchHandler[ReadEvent](r.l, r.BatchConsume, r.batch))
 		if err != nil {
 			r.l.Error("InteractiveReadEvent 退出了消费循环异常", logger.Error(err))
 		}
@@ -54,39 +62,34 @@ func (r *InteractiveReadEventConsumer) Start() error {
 	return err
 }
 
-func (r *InteractiveReadEventConsumer) StartBatch() error {
-	cg, err := sarama.NewConsumerGroupFromClient("interactive",
-		r.client)
-	if err != nil {
-		return err
-	}
-	go func() {
-		err := cg.Consume(context.Background(),
-			[]string{topicReadEvent},
-			saramax.NewBatchHandler[ReadEvent](r.l, r.BatchConsume))
-		if err != nil {
-			r.l.Error("退出了消费循环异常", logger.Error(err))
-		}
-	}()
-	return err
-}
-func (r *InteractiveReadEventConsumer) Consume(msg *sarama.ConsumerMessage,
-	evt ReadEvent) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
-	err := r.repo.IncrReadCnt(ctx, "article", evt.Aid)
-	return err
-}
-
+// BatchConsume 同一个资源的阅读在 DAO 里面合并成一行，一条语句写进去。
+// 写成功之后才会提交这一批的偏移量，写失败整批重新消费，阅读数可能多算不会少算
 func (r *InteractiveReadEventConsumer) BatchConsume(msgs []*sarama.ConsumerMessage,
 	evts []ReadEvent) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	bizs := make([]string, 0, len(msgs))
 	ids := make([]int64, 0, len(msgs))
-	for _, evt := range evts {
+	deltas := make([]domain.BucketDelta, 0, len(msgs))
+	for i, evt := range evts {
 		bizs = append(bizs, "article")
-		ids = append(ids, evt.Uid)
+		ids = append(ids, evt.Aid)
+		deltas = append(deltas, domain.BucketDelta{
+			Biz: "article", BizId: evt.Aid, Time: eventTime(msgs[i]), ReadCnt: 1,
+		})
+	}
+	err := r.repo.BatchIncrReadCnt(ctx, bizs, ids)
+	if err != nil {
+		return err
+	}
+	return r.analytics.IncrBuckets(ctx, deltas)
+}
+
+// eventTime 按照消息写进 Kafka 的时间分桶，积压或者重放的消息也能落到正确的桶里面
+func eventTime(msg *sarama.ConsumerMessage) time.Time {
+	if msg.Timestamp.IsZero() {
+		// 老版本的消息格式没有时间戳
+		return time.Now()
 	}
-	return r.repo.BatchIncrReadCnt(ctx, bizs, ids)
+	return msg.Timestamp
 }
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *InteractiveServiceServer) GetSeries(ctx context.Context, request *interactivev1.GetSeriesRequest) (*interactivev1.GetSeriesResponse, error) {
+	g := domain.Granularity(request.GetGranularity())
+	start, end := request.GetStart().AsTime(), request.GetEnd().AsTime()
+	var (
+		points []domain.SeriesPoint
+		err    error
+	)
+	switch target := request.GetTarget().(type) {
+	case *interactivev1.GetSeriesRequest_BizId:
+		points, err = i.analyticsSvc.Series(ctx, request.GetBiz(), target.BizId, g, start, end)
+	case *interactivev1.GetSeriesRequest_AuthorId:
+		if request.GetBiz() != "" && request.GetBiz() != "article" {
+			return nil, status.Error(codes.InvalidArgument, "按作者汇总只支持 article")
+		}
+		points, err = i.analyticsSvc.AuthorSeries(ctx, target.AuthorId, g, start, end)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "biz_id 和 author_id 必须有一个")
+	}
+	if err != nil {
+		return nil, convertSeriesErr(err)
+	}
+	res := make([]*interactivev1.SeriesPoint, 0, len(points))
+	for _, p := range points {
+		res = append(res, &interactivev1.SeriesPoint{
+			Start:      timestamppb.New(p.Start),
+			ReadCnt:    p.ReadCnt,
+			LikeCnt:    p.LikeCnt,
+			CollectCnt: p.CollectCnt,
+		})
+	}
+	return &interactivev1.GetSeriesResponse{Points: res}, nil
+}
+
+func convertSeriesErr(err error) error {
+	switch err {
+	case service.ErrInvalidGranularity, service.ErrInvalidRange, service.ErrRangeTooLarge:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
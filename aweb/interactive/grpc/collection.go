@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *InteractiveServiceServer) CancelCollect(ctx context.Context, request *interactivev1.CancelCollectRequest) (*interactivev1.CancelCollectResponse, error) {
+	err := i.svc.CancelCollect(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	return &interactivev1.CancelCollectResponse{}, err
+}
+
+func (i *InteractiveServiceServer) CreateCollection(ctx context.Context, request *interactivev1.CreateCollectionRequest) (*interactivev1.CreateCollectionResponse, error) {
+	id, err := i.svc.CreateCollection(ctx, domain.Collection{
+		Uid:     request.GetUid(),
+		Name:    request.GetName(),
+		Private: request.GetPrivate(),
+	})
+	if err != nil {
+		return nil, convertCollectionErr(err)
+	}
+	return &interactivev1.CreateCollectionResponse{Id: id}, nil
+}
+
+func (i *InteractiveServiceServer) UpdateCollection(ctx context.Context, request *interactivev1.UpdateCollectionRequest) (*interactivev1.UpdateCollectionResponse, error) {
+	err := i.svc.UpdateCollection(ctx, domain.Collection{
+		Id:      request.GetId(),
+		Uid:     request.GetUid(),
+		Name:    request.GetName(),
+		Private: request.GetPrivate(),
+	})
+	if err != nil {
+		return nil, convertCollectionErr(err)
+	}
+	return &interactivev1.UpdateCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) DeleteCollection(ctx context.Context, request *interactivev1.DeleteCollectionRequest) (*interactivev1.DeleteCollectionResponse, error) {
+	err := i.svc.DeleteCollection(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, convertCollectionErr(err)
+	}
+	return &interactivev1.DeleteCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollections(ctx context.Context, request *interactivev1.ListCollectionsRequest) (*interactivev1.ListCollectionsResponse, error) {
+	cs, err := i.svc.ListCollections(ctx, request.GetViewer(), request.GetOwner(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &interactivev1.ListCollectionsResponse{
+		Collections: slice.Map(cs, func(idx int, src domain.Collection) *interactivev1.Collection {
+			return &interactivev1.Collection{
+				Id:      src.Id,
+				Uid:     src.Uid,
+				Name:    src.Name,
+				Private: src.Private,
+				Ctime:   src.Ctime,
+				Utime:   src.Utime,
+			}
+		}),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollectionItems(ctx context.Context, request *interactivev1.ListCollectionItemsRequest) (*interactivev1.ListCollectionItemsResponse, error) {
+	items, err := i.svc.ListCollectionItems(ctx, request.GetUid(), request.GetCid(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertCollectionErr(err)
+	}
+	return &interactivev1.ListCollectionItemsResponse{
+		Items: slice.Map(items, func(idx int, src domain.CollectionItem) *interactivev1.CollectionItem {
+			return &interactivev1.CollectionItem{
+				Cid:   src.Cid,
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Uid:   src.Uid,
+				Ctime: src.Ctime,
+			}
+		}),
+	}, nil
+}
+
+func convertCollectionErr(err error) error {
+	switch err {
+	case service.ErrCollectionNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrInvalidCollectionName:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *InteractiveServiceServer) ListLiked(ctx context.Context, request *interactivev1.ListLikedRequest) (*interactivev1.ListLikedResponse, error) {
+	items, next, err := i.svc.ListLiked(ctx, request.GetUid(), request.GetBiz(),
+		request.GetCursor(), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertCursorErr(err)
+	}
+	return &interactivev1.ListLikedResponse{
+		Items:      slice.Map(items, toHistoryItemDTO),
+		NextCursor: next,
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollected(ctx context.Context, request *interactivev1.ListCollectedRequest) (*interactivev1.ListCollectedResponse, error) {
+	items, next, err := i.svc.ListCollected(ctx, request.GetUid(), request.GetBiz(),
+		request.GetCursor(), int(request.GetLimit()))
+	if err != nil {
+		return nil, convertCursorErr(err)
+	}
+	return &interactivev1.ListCollectedResponse{
+		Items:      slice.Map(items, toHistoryItemDTO),
+		NextCursor: next,
+	}, nil
+}
+
+func toHistoryItemDTO(idx int, src domain.HistoryItem) *interactivev1.HistoryItem {
+	res := &interactivev1.HistoryItem{
+		Biz:   src.Biz,
+		BizId: src.BizId,
+		Time:  src.Time,
+	}
+	if src.Meta != nil {
+		res.Meta = &interactivev1.ItemMeta{
+			Title:    src.Meta.Title,
+			AuthorId: src.Meta.AuthorId,
+			Abstract: src.Meta.Abstract,
+		}
+	}
+	return res
+}
+
+func convertCursorErr(err error) error {
+	if err == service.ErrInvalidCursor {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
@@ -11,11 +11,13 @@ import (
 
 type InteractiveServiceServer struct {
 	interactivev1.UnimplementedInteractiveServiceServer
-	svc service.InteractiveService
+	svc          service.InteractiveService
+	analyticsSvc service.AnalyticsService
 }
 
-func NewInteractiveServiceServer(svc service.InteractiveService) *InteractiveServiceServer {
-	return &InteractiveServiceServer{svc: svc}
+func NewInteractiveServiceServer(svc service.InteractiveService,
+	analyticsSvc service.AnalyticsService) *InteractiveServiceServer {
+	return &InteractiveServiceServer{svc: svc, analyticsSvc: analyticsSvc}
 }
 
 func (i *InteractiveServiceServer) Register(s *grpc.Server) {
@@ -40,7 +42,10 @@ func (i *InteractiveServiceServer) CancelLike(ctx context.Context, request *inte
 func (i *InteractiveServiceServer) Collect(ctx context.Context, request *interactivev1.CollectRequest) (*interactivev1.CollectResponse, error) {
 	err := i.svc.Collect(ctx, request.GetBiz(), request.GetBizId(),
 		request.GetCid(), request.GetUid())
-	return &interactivev1.CollectResponse{}, err
+	if err != nil {
+		return nil, convertCollectionErr(err)
+	}
+	return &interactivev1.CollectResponse{}, nil
 }
 
 func (i *InteractiveServiceServer) Get(ctx context.Context, request *interactivev1.GetRequest) (*interactivev1.GetResponse, error) {
@@ -68,6 +73,25 @@ func (i *InteractiveServiceServer) GetByIds(ctx context.Context, request *intera
 	}, nil
 }
 
+func (i *InteractiveServiceServer) GetByIdsWithViewer(ctx context.Context, request *interactivev1.GetByIdsWithViewerRequest) (*interactivev1.GetByIdsWithViewerResponse, error) {
+	res, err := i.svc.GetByIdsWithViewer(ctx, request.GetBiz(), request.GetIds(), request.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	intrs := make(map[int64]*interactivev1.Interactive, len(res))
+	for k, v := range res {
+		intrs[k] = i.toDTO(v)
+	}
+	return &interactivev1.GetByIdsWithViewerResponse{
+		Intrs: intrs,
+	}, nil
+}
+
+func (i *InteractiveServiceServer) DeleteByBiz(ctx context.Context, request *interactivev1.DeleteByBizRequest) (*interactivev1.DeleteByBizResponse, error) {
+	err := i.svc.DeleteByBiz(ctx, request.GetBiz(), request.GetBizIds())
+	return &interactivev1.DeleteByBizResponse{}, err
+}
+
 func (i *InteractiveServiceServer) toDTO(intr domain.Interactive) *interactivev1.Interactive {
 	return &interactivev1.Interactive{
 		Biz:        intr.Biz,
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	interactivev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *InteractiveServiceServer) React(ctx context.Context, request *interactivev1.ReactRequest) (*interactivev1.ReactResponse, error) {
+	err := i.svc.React(ctx, request.GetBiz(), request.GetBizId(), request.GetUid(),
+		domain.ReactionType(request.GetType()))
+	if err == service.ErrInvalidReaction {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &interactivev1.ReactResponse{}, err
+}
+
+func (i *InteractiveServiceServer) Unreact(ctx context.Context, request *interactivev1.UnreactRequest) (*interactivev1.UnreactResponse, error) {
+	err := i.svc.Unreact(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	return &interactivev1.UnreactResponse{}, err
+}
+
+func (i *InteractiveServiceServer) GetReactions(ctx context.Context, request *interactivev1.GetReactionsRequest) (*interactivev1.GetReactionsResponse, error) {
+	intr, err := i.svc.GetReactions(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]*interactivev1.ReactionCount, 0, len(domain.ReactionTypes))
+	for _, typ := range domain.ReactionTypes {
+		counts = append(counts, &interactivev1.ReactionCount{
+			Type: interactivev1.ReactionType(typ),
+			Cnt:  intr.Reactions[typ],
+		})
+	}
+	return &interactivev1.GetReactionsResponse{
+		Counts:     counts,
+		MyReaction: interactivev1.ReactionType(intr.Reaction),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package ioc
+
+import (
+	"time"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/interactive/service"
+	"github.com/spf13/viper"
+	etcdv3 "go.etcd.io/etcd/client/v3"
+	resolver "go.etcd.io/etcd/client/v3/naming/resolver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// InitArticleClient 按作者汇总趋势的时候查作者有哪些文章，
+// 我的点赞、收藏里面也要查文章的标题
+func InitArticleClient(etcdClient *etcdv3.Client) articlev1.ArticleServiceClient {
+	type Config struct {
+		Target string `json:"target"`
+		Secure bool   `json:"secure"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("grpc.client.article", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	rs, err := resolver.NewBuilder(etcdClient)
+	if err != nil {
+		panic(err)
+	}
+	opts := []grpc.DialOption{grpc.WithResolvers(rs)}
+	if !cfg.Secure {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	cc, err := grpc.Dial(cfg.Target, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return articlev1.NewArticleServiceClient(cc)
+}
+
+// InitAnalyticsRepository 天按照配置的时区切分，改了时区之后旧的天桶就对不上了
+func InitAnalyticsRepository(bucketDAO dao.BucketDAO) repository.AnalyticsRepository {
+	type Config struct {
+		Timezone string `yaml:"timezone"`
+	}
+	cfg := Config{Timezone: "Asia/Shanghai"}
+	err := viper.UnmarshalKey("analytics", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		panic(err)
+	}
+	return repository.NewAnalyticsRepository(bucketDAO, loc)
+}
+
+func InitAnalyticsService(repo repository.AnalyticsRepository,
+	articleClient articlev1.ArticleServiceClient) service.AnalyticsService {
+	cfg := service.AnalyticsConfig{
+		// 按小时最多查 31 天
+		MaxPoints: 744,
+	}
+	err := viper.UnmarshalKey("analytics", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewAnalyticsService(repo, articleClient, cfg)
+}
@@ -3,6 +3,8 @@ package ioc
 import (
 	"github.com/IBM/sarama"
 	"github.com/pluckhuang/goweb/aweb/interactive/events"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/pluckhuang/goweb/aweb/pkg/saramax"
 	"github.com/spf13/viper"
 )
@@ -33,6 +35,18 @@ func InitSaramaSyncProducer(c sarama.Client) sarama.SyncProducer {
 	return p
 }
 
+// InitReadEventConsumer 阅读事件按照 kafka.readEvent 的配置攒批写库
+func InitReadEventConsumer(client sarama.Client, l logger.LoggerV1,
+	repo repository.InteractiveRepository,
+	analytics repository.AnalyticsRepository) *events.InteractiveReadEventConsumer {
+	var cfg saramax.BatchConfig
+	err := viper.UnmarshalKey("kafka.readEvent", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return events.NewInteractiveReadEventConsumer(client, l, repo, analytics, cfg)
+}
+
 func InitConsumers(c1 *events.InteractiveReadEventConsumer, c2 *events.InteractiveSyncEventConsumer) []saramax.Consumer {
 	return []saramax.Consumer{c1, c2}
 }
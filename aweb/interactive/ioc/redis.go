@@ -1,13 +1,58 @@
 package ioc
 
 import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 )
 
-func InitRedis() redis.Cmdable {
-	cmd := redis.NewClient(&redis.Options{
+func InitRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr: viper.GetString("redis.addr"),
 	})
-	return cmd
+}
+
+func InitRedis(client *redis.Client) redis.Cmdable {
+	return client
+}
+
+// InitInvalidationBus 通知别的实例删除本地缓存
+func InitInvalidationBus(client *redis.Client, l logger.LoggerV1) cachex.InvalidationBus {
+	channel := viper.GetString("cache.invalidation.channel")
+	if channel == "" {
+		channel = "interactive:cache_invalidation"
+	}
+	bus := cachex.NewRedisInvalidationBus(client, channel, l)
+	err := bus.Start(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	return bus
+}
+
+// InitInteractiveCache Redis 前面加一层本地缓存
+func InitInteractiveCache(client redis.Cmdable, bus cachex.InvalidationBus) cache.InteractiveCache {
+	type Config struct {
+		Capacity   int           `yaml:"capacity"`
+		Expiration time.Duration `yaml:"expiration"`
+	}
+	cfg := Config{
+		Capacity:   10000,
+		Expiration: time.Second * 10,
+	}
+	err := viper.UnmarshalKey("cache.local", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	res, err := cache.NewInteractiveLocalCache(cache.NewInteractiveRedisCache(client),
+		bus, cfg.Capacity, cfg.Expiration)
+	if err != nil {
+		panic(err)
+	}
+	return res
 }
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
+)
+
+type AnalyticsRepository interface {
+	// IncrBuckets 每个 delta 同时计入它发生的那个小时和那一天
+	IncrBuckets(ctx context.Context, deltas []domain.BucketDelta) error
+	// Series 返回 [start, end) 之间每个桶的数据，多个资源加在一起
+	// 没有数据的桶也会返回，计数是 0
+	Series(ctx context.Context, biz string, ids []int64, granularity domain.Granularity,
+		start, end time.Time) ([]domain.SeriesPoint, error)
+}
+
+type analyticsRepository struct {
+	dao dao.BucketDAO
+	// loc 按照哪个时区切分天，写入和查询必须一致
+	loc *time.Location
+}
+
+func NewAnalyticsRepository(dao dao.BucketDAO, loc *time.Location) AnalyticsRepository {
+	return &analyticsRepository{dao: dao, loc: loc}
+}
+
+func (r *analyticsRepository) IncrBuckets(ctx context.Context, deltas []domain.BucketDelta) error {
+	incrs := make([]dao.InteractiveBucket, 0, len(deltas)*2)
+	for _, d := range deltas {
+		at := d.Time.In(r.loc)
+		for _, g := range []domain.Granularity{domain.GranularityHour, domain.GranularityDay} {
+			incrs = append(incrs, dao.InteractiveBucket{
+				Biz:         d.Biz,
+				BizId:       d.BizId,
+				Granularity: uint8(g),
+				Start:       g.Truncate(at).UnixMilli(),
+				ReadCnt:     d.ReadCnt,
+				LikeCnt:     d.LikeCnt,
+				CollectCnt:  d.CollectCnt,
+			})
+		}
+	}
+	return r.dao.Incr(ctx, incrs)
+}
+
+func (r *analyticsRepository) Series(ctx context.Context, biz string, ids []int64,
+	granularity domain.Granularity, start, end time.Time) ([]domain.SeriesPoint, error) {
+	first := granularity.Truncate(start.In(r.loc))
+	var buckets []dao.InteractiveBucket
+	if len(ids) > 0 {
+		var err error
+		buckets, err = r.dao.Sum(ctx, biz, ids, uint8(granularity), first.UnixMilli(), end.UnixMilli())
+		if err != nil {
+			return nil, err
+		}
+	}
+	byStart := make(map[int64]dao.InteractiveBucket, len(buckets))
+	for _, b := range buckets {
+		byStart[b.Start] = b
+	}
+	var res []domain.SeriesPoint
+	for t := first; t.Before(end); t = granularity.Next(t) {
+		b := byStart[t.UnixMilli()]
+		res = append(res, domain.SeriesPoint{
+			Start:      t,
+			ReadCnt:    b.ReadCnt,
+			LikeCnt:    b.LikeCnt,
+			CollectCnt: b.CollectCnt,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBucketDAO 把 Incr 收到的桶原样存下来，Sum 按 Start 过滤之后返回
+type fakeBucketDAO struct {
+	buckets []dao.InteractiveBucket
+}
+
+func (f *fakeBucketDAO) Incr(ctx context.Context, incrs []dao.InteractiveBucket) error {
+	f.buckets = append(f.buckets, incrs...)
+	return nil
+}
+
+func (f *fakeBucketDAO) Sum(ctx context.Context, biz string, ids []int64,
+	granularity uint8, start, end int64) ([]dao.InteractiveBucket, error) {
+	var res []dao.InteractiveBucket
+	for _, b := range f.buckets {
+		if b.Granularity == granularity && b.Start >= start && b.Start < end {
+			res = append(res, b)
+		}
+	}
+	return res, nil
+}
+
+func TestAnalyticsRepository(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	d := &fakeBucketDAO{}
+	repo := NewAnalyticsRepository(d, loc)
+	ctx := context.Background()
+
+	// UTC 的 3 月 4 日 17:30 在东八区已经是 3 月 5 日了
+	at := time.Date(2024, 3, 4, 17, 30, 0, 0, time.UTC)
+	err = repo.IncrBuckets(ctx, []domain.BucketDelta{
+		{Biz: "article", BizId: 1, Time: at, ReadCnt: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, d.buckets, 2)
+	assert.Equal(t, time.Date(2024, 3, 5, 1, 0, 0, 0, loc).UnixMilli(), d.buckets[0].Start)
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, loc).UnixMilli(), d.buckets[1].Start)
+
+	points, err := repo.Series(ctx, "article", []int64{1}, domain.GranularityDay,
+		time.Date(2024, 3, 4, 12, 0, 0, 0, loc), time.Date(2024, 3, 7, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	// 开始时间会向下取整到桶的开始，没有数据的桶补 0
+	require.Len(t, points, 3)
+	assert.True(t, time.Date(2024, 3, 4, 0, 0, 0, 0, loc).Equal(points[0].Start))
+	assert.Equal(t, int64(0), points[0].ReadCnt)
+	assert.Equal(t, int64(1), points[1].ReadCnt)
+	assert.Equal(t, int64(0), points[2].ReadCnt)
+
+	points, err = repo.Series(ctx, "article", nil, domain.GranularityHour,
+		time.Date(2024, 3, 5, 0, 0, 0, 0, loc), time.Date(2024, 3, 5, 3, 0, 0, 0, loc))
+	require.NoError(t, err)
+	// 作者没有文章也是返回补 0 的点
+	assert.Len(t, points, 3)
+}
@@ -27,14 +27,26 @@ type InteractiveCache interface {
 	// IncrReadCntIfPresent 如果在缓存中有对应的数据，就 +1
 	IncrReadCntIfPresent(ctx context.Context,
 		biz string, bizId int64) error
+	// AddReadCntIfPresent 批量消费阅读事件的时候，同一个资源合并之后一次加上去
+	AddReadCntIfPresent(ctx context.Context,
+		biz string, bizId int64, delta int64) error
 	IncrLikeCntIfPresent(ctx context.Context,
 		biz string, bizId int64) error
 	DecrLikeCntIfPresent(ctx context.Context,
 		biz string, bizId int64) error
 	IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	// IncrReactionCntIfPresent 表态的计数加上 delta，换表态的时候旧的要减一
+	IncrReactionCntIfPresent(ctx context.Context, biz string, bizId int64,
+		typ domain.ReactionType, delta int64) error
 	// Get 查询缓存中数据
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error
+	// GetByIds 一次 pipeline 查多个，缓存里面没有的不在返回的 map 里面
+	GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// SetByIds 一次 pipeline 回写多个，用 intr.BizId 做 key
+	SetByIds(ctx context.Context, biz string, intrs []domain.Interactive) error
+	Del(ctx context.Context, biz string, bizIds ...int64) error
 }
 
 type InteractiveRedisCache struct {
@@ -56,6 +68,13 @@ func (r *InteractiveRedisCache) IncrReadCntIfPresent(ctx context.Context,
 		fieldReadCnt, 1).Err()
 }
 
+func (r *InteractiveRedisCache) AddReadCntIfPresent(ctx context.Context,
+	biz string, bizId int64, delta int64) error {
+	return r.client.Eval(ctx, luaIncrCnt,
+		[]string{r.key(biz, bizId)},
+		fieldReadCnt, delta).Err()
+}
+
 func (r *InteractiveRedisCache) IncrLikeCntIfPresent(ctx context.Context,
 	biz string, bizId int64) error {
 	return r.client.Eval(ctx, luaIncrCnt,
@@ -77,22 +96,80 @@ func (r *InteractiveRedisCache) IncrCollectCntIfPresent(ctx context.Context,
 		fieldCollectCnt, 1).Err()
 }
 
+func (r *InteractiveRedisCache) DecrCollectCntIfPresent(ctx context.Context,
+	biz string, bizId int64) error {
+	return r.client.Eval(ctx, luaIncrCnt,
+		[]string{r.key(biz, bizId)},
+		fieldCollectCnt, -1).Err()
+}
+
+func (r *InteractiveRedisCache) IncrReactionCntIfPresent(ctx context.Context,
+	biz string, bizId int64, typ domain.ReactionType, delta int64) error {
+	return r.client.Eval(ctx, luaIncrCnt,
+		[]string{r.key(biz, bizId)},
+		reactionField(typ), delta).Err()
+}
+
+// reactionField 每种表态在 hash 里面一个字段
+func reactionField(typ domain.ReactionType) string {
+	return typ.String() + "_cnt"
+}
+
 func (r *InteractiveRedisCache) key(biz string, bizId int64) string {
+	return interactiveKey(biz, bizId)
+}
+
+// interactiveKey 本地缓存和失效通知也用这个 key
+func interactiveKey(biz string, bizId int64) string {
 	return fmt.Sprintf("interactive:%s:%d", biz, bizId)
 }
 
 func (r *InteractiveRedisCache) Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error {
 	key := r.key(biz, bizId)
-	err := r.client.HMSet(ctx, key,
-		fieldLikeCnt, intr.LikeCnt,
-		fieldCollectCnt, intr.CollectCnt,
-		fieldReadCnt, intr.ReadCnt).Err()
+	err := r.client.HMSet(ctx, key, r.fields(intr)...).Err()
 	if err != nil {
 		return err
 	}
 	return r.client.Expire(ctx, key, r.expiration).Err()
 }
 
+func (r *InteractiveRedisCache) SetByIds(ctx context.Context, biz string, intrs []domain.Interactive) error {
+	if len(intrs) == 0 {
+		return nil
+	}
+	pipe := r.client.Pipeline()
+	for _, intr := range intrs {
+		key := r.key(biz, intr.BizId)
+		pipe.HMSet(ctx, key, r.fields(intr)...)
+		pipe.Expire(ctx, key, r.expiration)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *InteractiveRedisCache) fields(intr domain.Interactive) []any {
+	vals := []any{
+		fieldLikeCnt, intr.LikeCnt,
+		fieldCollectCnt, intr.CollectCnt,
+		fieldReadCnt, intr.ReadCnt,
+	}
+	for _, typ := range domain.ReactionTypes {
+		vals = append(vals, reactionField(typ), intr.Reactions[typ])
+	}
+	return vals
+}
+
+func (r *InteractiveRedisCache) Del(ctx context.Context, biz string, bizIds ...int64) error {
+	if len(bizIds) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(bizIds))
+	for _, id := range bizIds {
+		keys = append(keys, r.key(biz, id))
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
+
 func (r *InteractiveRedisCache) Get(ctx context.Context,
 	biz string, bizId int64) (domain.Interactive, error) {
 	// 直接使用 HMGet，即便缓存中没有对应的 key，也不会返回 error
@@ -105,11 +182,43 @@ func (r *InteractiveRedisCache) Get(ctx context.Context,
 		// 缓存不存在
 		return domain.Interactive{}, ErrKeyNotExist
 	}
+	return r.toDomain(biz, bizId, data), nil
+}
+
+func (r *InteractiveRedisCache) GetByIds(ctx context.Context,
+	biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
+	if len(bizIds) == 0 {
+		return map[int64]domain.Interactive{}, nil
+	}
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(bizIds))
+	for _, id := range bizIds {
+		cmds = append(cmds, pipe.HGetAll(ctx, r.key(biz, id)))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]domain.Interactive, len(bizIds))
+	for i, cmd := range cmds {
+		data := cmd.Val()
+		if len(data) == 0 {
+			continue
+		}
+		res[bizIds[i]] = r.toDomain(biz, bizIds[i], data)
+	}
+	return res, nil
+}
 
+func (r *InteractiveRedisCache) toDomain(biz string, bizId int64, data map[string]string) domain.Interactive {
 	// 理论上来说，这里不可能有 error
 	collectCnt, _ := strconv.ParseInt(data[fieldCollectCnt], 10, 64)
 	likeCnt, _ := strconv.ParseInt(data[fieldLikeCnt], 10, 64)
 	readCnt, _ := strconv.ParseInt(data[fieldReadCnt], 10, 64)
+	reactions := make(map[domain.ReactionType]int64, len(domain.ReactionTypes))
+	for _, typ := range domain.ReactionTypes {
+		reactions[typ], _ = strconv.ParseInt(data[reactionField(typ)], 10, 64)
+	}
 
 	return domain.Interactive{
 		Biz:        biz,
@@ -117,5 +226,6 @@ func (r *InteractiveRedisCache) Get(ctx context.Context,
 		CollectCnt: collectCnt,
 		LikeCnt:    likeCnt,
 		ReadCnt:    readCnt,
-	}, err
+		Reactions:  reactions,
+	}
 }
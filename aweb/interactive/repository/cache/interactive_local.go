@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+)
+
+// InteractiveLocalCache 在 Redis 前面加一层进程内的缓存
+// 点赞、收藏和删除会通过 bus 通知所有实例删除本地缓存，
+// 阅读数变得太频繁了，不发通知，最多落后 expiration 这么久
+type InteractiveLocalCache struct {
+	InteractiveCache
+	bus        cachex.InvalidationBus
+	local      *lru.Cache
+	expiration time.Duration
+}
+
+type localItem struct {
+	intr   domain.Interactive
+	expire time.Time
+}
+
+func NewInteractiveLocalCache(remote InteractiveCache, bus cachex.InvalidationBus,
+	capacity int, expiration time.Duration) (*InteractiveLocalCache, error) {
+	local, err := lru.New(capacity)
+	if err != nil {
+		return nil, err
+	}
+	res := &InteractiveLocalCache{
+		InteractiveCache: remote,
+		bus:              bus,
+		local:            local,
+		expiration:       expiration,
+	}
+	// bus 上面的 key 和 Redis 的 key 一样
+	bus.Subscribe("interactive:", res)
+	return res, nil
+}
+
+func (c *InteractiveLocalCache) Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error) {
+	key := interactiveKey(biz, bizId)
+	if val, ok := c.local.Get(key); ok {
+		itm := val.(localItem)
+		if time.Now().Before(itm.expire) {
+			return itm.intr, nil
+		}
+		c.local.Remove(key)
+	}
+	intr, err := c.InteractiveCache.Get(ctx, biz, bizId)
+	if err != nil {
+		return intr, err
+	}
+	c.add(key, intr)
+	return intr, nil
+}
+
+func (c *InteractiveLocalCache) Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error {
+	err := c.InteractiveCache.Set(ctx, biz, bizId, intr)
+	if err != nil {
+		return err
+	}
+	c.add(interactiveKey(biz, bizId), intr)
+	return nil
+}
+
+// GetByIds 本地缓存里面没有的才去 Redis 查
+func (c *InteractiveLocalCache) GetByIds(ctx context.Context,
+	biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
+	res := make(map[int64]domain.Interactive, len(bizIds))
+	missing := make([]int64, 0, len(bizIds))
+	now := time.Now()
+	for _, id := range bizIds {
+		key := interactiveKey(biz, id)
+		if val, ok := c.local.Get(key); ok {
+			itm := val.(localItem)
+			if now.Before(itm.expire) {
+				res[id] = itm.intr
+				continue
+			}
+			c.local.Remove(key)
+		}
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return res, nil
+	}
+	remote, err := c.InteractiveCache.GetByIds(ctx, biz, missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, intr := range remote {
+		c.add(interactiveKey(biz, id), intr)
+		res[id] = intr
+	}
+	return res, nil
+}
+
+func (c *InteractiveLocalCache) SetByIds(ctx context.Context, biz string, intrs []domain.Interactive) error {
+	err := c.InteractiveCache.SetByIds(ctx, biz, intrs)
+	if err != nil {
+		return err
+	}
+	for _, intr := range intrs {
+		c.add(interactiveKey(biz, intr.BizId), intr)
+	}
+	return nil
+}
+
+func (c *InteractiveLocalCache) IncrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	err := c.InteractiveCache.IncrLikeCntIfPresent(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizId)
+}
+
+func (c *InteractiveLocalCache) DecrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	err := c.InteractiveCache.DecrLikeCntIfPresent(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizId)
+}
+
+func (c *InteractiveLocalCache) IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	err := c.InteractiveCache.IncrCollectCntIfPresent(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizId)
+}
+
+func (c *InteractiveLocalCache) DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	err := c.InteractiveCache.DecrCollectCntIfPresent(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizId)
+}
+
+func (c *InteractiveLocalCache) IncrReactionCntIfPresent(ctx context.Context, biz string, bizId int64,
+	typ domain.ReactionType, delta int64) error {
+	err := c.InteractiveCache.IncrReactionCntIfPresent(ctx, biz, bizId, typ, delta)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizId)
+}
+
+func (c *InteractiveLocalCache) Del(ctx context.Context, biz string, bizIds ...int64) error {
+	err := c.InteractiveCache.Del(ctx, biz, bizIds...)
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, biz, bizIds...)
+}
+
+// invalidate 必须在 Redis 更新之后调用，不然别的实例可能又从 Redis 里面读到旧的数据
+func (c *InteractiveLocalCache) invalidate(ctx context.Context, biz string, bizIds ...int64) error {
+	keys := make([]string, 0, len(bizIds))
+	for _, id := range bizIds {
+		key := interactiveKey(biz, id)
+		c.local.Remove(key)
+		keys = append(keys, key)
+	}
+	return c.bus.Publish(ctx, keys...)
+}
+
+func (c *InteractiveLocalCache) Evict(key string) {
+	c.local.Remove(key)
+}
+
+func (c *InteractiveLocalCache) EvictAll() {
+	c.local.Purge()
+}
+
+func (c *InteractiveLocalCache) add(key string, intr domain.Interactive) {
+	c.local.Add(key, localItem{
+		intr:   intr,
+		expire: time.Now().Add(c.expiration),
+	})
+}
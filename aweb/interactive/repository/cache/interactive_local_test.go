@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/pkg/cachex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRemote 用 map 模拟多个实例共用的 Redis
+type fakeRemote struct {
+	InteractiveCache
+	data map[string]domain.Interactive
+	// batchGets GetByIds 调用了多少次
+	batchGets int
+}
+
+func (f *fakeRemote) Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error) {
+	intr, ok := f.data[interactiveKey(biz, bizId)]
+	if !ok {
+		return domain.Interactive{}, ErrKeyNotExist
+	}
+	return intr, nil
+}
+
+func (f *fakeRemote) GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
+	f.batchGets++
+	res := make(map[int64]domain.Interactive, len(bizIds))
+	for _, id := range bizIds {
+		if intr, ok := f.data[interactiveKey(biz, id)]; ok {
+			res[id] = intr
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRemote) SetByIds(ctx context.Context, biz string, intrs []domain.Interactive) error {
+	for _, intr := range intrs {
+		f.data[interactiveKey(biz, intr.BizId)] = intr
+	}
+	return nil
+}
+
+func (f *fakeRemote) IncrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	key := interactiveKey(biz, bizId)
+	if intr, ok := f.data[key]; ok {
+		intr.LikeCnt++
+		f.data[key] = intr
+	}
+	return nil
+}
+
+func (f *fakeRemote) Del(ctx context.Context, biz string, bizIds ...int64) error {
+	for _, id := range bizIds {
+		delete(f.data, interactiveKey(biz, id))
+	}
+	return nil
+}
+
+func TestInteractiveLocalCache_CrossInstance(t *testing.T) {
+	testCases := []struct {
+		name string
+		// 在实例 a 上面修改
+		change  func(ctx context.Context, a *InteractiveLocalCache) error
+		wantErr error
+		wantCnt int64
+	}{
+		{
+			name: "点赞",
+			change: func(ctx context.Context, a *InteractiveLocalCache) error {
+				return a.IncrLikeCntIfPresent(ctx, "article", 1)
+			},
+			wantCnt: 2,
+		},
+		{
+			name: "删除",
+			change: func(ctx context.Context, a *InteractiveLocalCache) error {
+				return a.Del(ctx, "article", 1)
+			},
+			wantErr: ErrKeyNotExist,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			remote := &fakeRemote{data: map[string]domain.Interactive{
+				interactiveKey("article", 1): {BizId: 1, LikeCnt: 1},
+			}}
+			bus := cachex.NewLocalInvalidationBus()
+			a, err := NewInteractiveLocalCache(remote, bus, 10, time.Minute)
+			require.NoError(t, err)
+			b, err := NewInteractiveLocalCache(remote, bus, 10, time.Minute)
+			require.NoError(t, err)
+			ctx := context.Background()
+			// b 先把旧的计数缓存在本地
+			_, err = b.Get(ctx, "article", 1)
+			require.NoError(t, err)
+
+			require.NoError(t, tc.change(ctx, a))
+			intr, err := b.Get(ctx, "article", 1)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCnt, intr.LikeCnt)
+		})
+	}
+}
+
+func TestInteractiveLocalCache_GetByIds(t *testing.T) {
+	remote := &fakeRemote{data: map[string]domain.Interactive{
+		interactiveKey("article", 2): {Biz: "article", BizId: 2, LikeCnt: 2},
+	}}
+	c, err := NewInteractiveLocalCache(remote, cachex.NewLocalInvalidationBus(), 10, time.Minute)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, c.SetByIds(ctx, "article", []domain.Interactive{{Biz: "article", BizId: 1, LikeCnt: 1}}))
+	res, err := c.GetByIds(ctx, "article", []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]domain.Interactive{
+		1: {Biz: "article", BizId: 1, LikeCnt: 1},
+		2: {Biz: "article", BizId: 2, LikeCnt: 2},
+	}, res)
+	assert.Equal(t, 1, remote.batchGets)
+
+	// 都在本地缓存里面，不用再查 Redis
+	_, err = c.GetByIds(ctx, "article", []int64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, remote.batchGets)
+}
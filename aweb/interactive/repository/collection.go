@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+var ErrCollectionNotFound = dao.ErrCollectionNotFound
+
+type CollectionRepository interface {
+	Create(ctx context.Context, c domain.Collection) (int64, error)
+	Update(ctx context.Context, c domain.Collection) error
+	// Delete 连同里面的收藏一起删除，返回被删掉的收藏
+	Delete(ctx context.Context, uid int64, id int64) ([]domain.CollectionItem, error)
+	FindById(ctx context.Context, id int64) (domain.Collection, error)
+	ListByUid(ctx context.Context, uid int64, onlyPublic bool, offset int, limit int) ([]domain.Collection, error)
+	ListItems(ctx context.Context, uid int64, cid int64, offset int, limit int) ([]domain.CollectionItem, error)
+}
+
+type collectionRepository struct {
+	dao   dao.CollectionDAO
+	cache cache.InteractiveCache
+	l     logger.LoggerV1
+}
+
+func NewCollectionRepository(dao dao.CollectionDAO, cache cache.InteractiveCache,
+	l logger.LoggerV1) CollectionRepository {
+	return &collectionRepository{dao: dao, cache: cache, l: l}
+}
+
+func (r *collectionRepository) Create(ctx context.Context, c domain.Collection) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(c))
+}
+
+func (r *collectionRepository) Update(ctx context.Context, c domain.Collection) error {
+	return r.dao.Update(ctx, r.toEntity(c))
+}
+
+func (r *collectionRepository) Delete(ctx context.Context, uid int64, id int64) ([]domain.CollectionItem, error) {
+	items, err := r.dao.Delete(ctx, uid, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		// 数据库已经减掉了，缓存失败最多是过期之前计数偏大
+		er := r.cache.DecrCollectCntIfPresent(ctx, item.Biz, item.BizId)
+		if er != nil {
+			r.l.Error("更新收藏数缓存失败",
+				logger.String("biz", item.Biz),
+				logger.Int64("bizId", item.BizId),
+				logger.Error(er))
+		}
+	}
+	return slice.Map(items, r.itemToDomain), nil
+}
+
+func (r *collectionRepository) FindById(ctx context.Context, id int64) (domain.Collection, error) {
+	c, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	return r.toDomain(c), nil
+}
+
+func (r *collectionRepository) ListByUid(ctx context.Context, uid int64, onlyPublic bool,
+	offset int, limit int) ([]domain.Collection, error) {
+	cs, err := r.dao.ListByUid(ctx, uid, onlyPublic, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(cs, func(idx int, src dao.Collection) domain.Collection {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *collectionRepository) ListItems(ctx context.Context, uid int64, cid int64,
+	offset int, limit int) ([]domain.CollectionItem, error) {
+	items, err := r.dao.ListItems(ctx, uid, cid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, r.itemToDomain), nil
+}
+
+func (r *collectionRepository) toEntity(c domain.Collection) dao.Collection {
+	return dao.Collection{
+		Id:      c.Id,
+		Uid:     c.Uid,
+		Name:    c.Name,
+		Private: c.Private,
+	}
+}
+
+func (r *collectionRepository) toDomain(c dao.Collection) domain.Collection {
+	return domain.Collection{
+		Id:      c.Id,
+		Uid:     c.Uid,
+		Name:    c.Name,
+		Private: c.Private,
+		Ctime:   c.Ctime,
+		Utime:   c.Utime,
+	}
+}
+
+func (r *collectionRepository) itemToDomain(idx int, src dao.UserCollectionBiz) domain.CollectionItem {
+	return domain.CollectionItem{
+		Cid:   src.Cid,
+		Biz:   src.Biz,
+		BizId: src.BizId,
+		Uid:   src.Uid,
+		Ctime: src.Utime,
+	}
+}
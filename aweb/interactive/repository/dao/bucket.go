@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BucketDAO 按小时、按天汇总的互动数
+type BucketDAO interface {
+	// Incr 同一个桶出现多次会先合并，再在一个事务里面更新
+	Incr(ctx context.Context, incrs []InteractiveBucket) error
+	// Sum 查询 [start, end) 之间的桶，多个资源的同一个桶会加在一起，按照 Start 升序返回
+	Sum(ctx context.Context, biz string, ids []int64, granularity uint8, start, end int64) ([]InteractiveBucket, error)
+}
+
+type GORMBucketDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMBucketDAO(db *gorm.DB) BucketDAO {
+	return &GORMBucketDAO{db: db}
+}
+
+type bucketKey struct {
+	biz         string
+	bizId       int64
+	granularity uint8
+	start       int64
+}
+
+func (dao *GORMBucketDAO) Incr(ctx context.Context, incrs []InteractiveBucket) error {
+	merged := make(map[bucketKey]*InteractiveBucket, len(incrs))
+	keys := make([]bucketKey, 0, len(incrs))
+	for _, incr := range incrs {
+		key := bucketKey{biz: incr.Biz, bizId: incr.BizId, granularity: incr.Granularity, start: incr.Start}
+		b, ok := merged[key]
+		if !ok {
+			b = &InteractiveBucket{Biz: incr.Biz, BizId: incr.BizId,
+				Granularity: incr.Granularity, Start: incr.Start}
+			merged[key] = b
+			keys = append(keys, key)
+		}
+		b.ReadCnt += incr.ReadCnt
+		b.LikeCnt += incr.LikeCnt
+		b.CollectCnt += incr.CollectCnt
+	}
+	// 固定加锁顺序，并发的批量更新不会互相死锁
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.biz != b.biz {
+			return a.biz < b.biz
+		}
+		if a.bizId != b.bizId {
+			return a.bizId < b.bizId
+		}
+		if a.granularity != b.granularity {
+			return a.granularity < b.granularity
+		}
+		return a.start < b.start
+	})
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, key := range keys {
+			b := merged[key]
+			b.Ctime = now
+			b.Utime = now
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "biz"}, {Name: "biz_id"}, {Name: "granularity"}, {Name: "start"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"read_cnt":    gorm.Expr("`read_cnt` + ?", b.ReadCnt),
+					"like_cnt":    gorm.Expr("`like_cnt` + ?", b.LikeCnt),
+					"collect_cnt": gorm.Expr("`collect_cnt` + ?", b.CollectCnt),
+					"utime":       now,
+				}),
+			}).Create(b).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (dao *GORMBucketDAO) Sum(ctx context.Context, biz string, ids []int64,
+	granularity uint8, start, end int64) ([]InteractiveBucket, error) {
+	var res []InteractiveBucket
+	err := dao.db.WithContext(ctx).Model(&InteractiveBucket{}).
+		Select("`start`, SUM(`read_cnt`) AS read_cnt, SUM(`like_cnt`) AS like_cnt, SUM(`collect_cnt`) AS collect_cnt").
+		Where("biz = ? AND biz_id IN ? AND granularity = ? AND `start` >= ? AND `start` < ?",
+			biz, ids, granularity, start, end).
+		Group("`start`").
+		Order("`start` ASC").
+		Find(&res).Error
+	return res, err
+}
+
+// InteractiveBucket 一个资源在一个小时或者一天里面新增的互动数
+// 取消点赞会把点赞数减回去，所以 LikeCnt 是净增的点赞数，可能是负数
+type InteractiveBucket struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Biz   string `gorm:"type:varchar(128);uniqueIndex:biz_id_granularity_start"`
+	BizId int64  `gorm:"uniqueIndex:biz_id_granularity_start"`
+	// Granularity 1-小时 2-天
+	Granularity uint8 `gorm:"uniqueIndex:biz_id_granularity_start"`
+	// Start 桶的开始时间，毫秒数
+	Start int64 `gorm:"uniqueIndex:biz_id_granularity_start"`
+
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+	Utime      int64
+	Ctime      int64
+}
@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMBucketDAO(t *testing.T) {
+	db := newTestDB(t, &InteractiveBucket{})
+	dao := NewGORMBucketDAO(db)
+	ctx := context.Background()
+
+	err := dao.Incr(ctx, []InteractiveBucket{
+		{Biz: "article", BizId: 1, Granularity: 1, Start: 1000, ReadCnt: 1},
+		{Biz: "article", BizId: 1, Granularity: 1, Start: 1000, ReadCnt: 1},
+		{Biz: "article", BizId: 1, Granularity: 1, Start: 2000, LikeCnt: 1},
+		{Biz: "article", BizId: 2, Granularity: 1, Start: 1000, CollectCnt: 1},
+		{Biz: "article", BizId: 2, Granularity: 2, Start: 1000, ReadCnt: 5},
+	})
+	require.NoError(t, err)
+	// 已经存在的桶是累加
+	err = dao.Incr(ctx, []InteractiveBucket{
+		{Biz: "article", BizId: 1, Granularity: 1, Start: 1000, ReadCnt: 3, LikeCnt: -1},
+	})
+	require.NoError(t, err)
+
+	var cnt int64
+	require.NoError(t, db.Model(&InteractiveBucket{}).Count(&cnt).Error)
+	assert.Equal(t, int64(4), cnt)
+
+	testCases := []struct {
+		name  string
+		ids   []int64
+		start int64
+		end   int64
+		want  []InteractiveBucket
+	}{
+		{
+			name:  "单个资源",
+			ids:   []int64{1},
+			start: 0,
+			end:   3000,
+			want: []InteractiveBucket{
+				{Start: 1000, ReadCnt: 5, LikeCnt: -1},
+				{Start: 2000, LikeCnt: 1},
+			},
+		},
+		{
+			name:  "多个资源加在一起",
+			ids:   []int64{1, 2},
+			start: 0,
+			end:   3000,
+			want: []InteractiveBucket{
+				{Start: 1000, ReadCnt: 5, LikeCnt: -1, CollectCnt: 1},
+				{Start: 2000, LikeCnt: 1},
+			},
+		},
+		{
+			name:  "不包含结束时间",
+			ids:   []int64{1, 2},
+			start: 1000,
+			end:   2000,
+			want: []InteractiveBucket{
+				{Start: 1000, ReadCnt: 5, LikeCnt: -1, CollectCnt: 1},
+			},
+		},
+		{
+			name:  "没有数据",
+			ids:   []int64{3},
+			start: 0,
+			end:   3000,
+			want:  []InteractiveBucket{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := dao.Sum(ctx, "article", tc.ids, 1, tc.start, tc.end)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrCollectionNotFound 收藏夹不存在，或者不是这个用户的
+var ErrCollectionNotFound = errors.New("收藏夹不存在")
+
+type CollectionDAO interface {
+	Insert(ctx context.Context, c Collection) (int64, error)
+	// Update 修改名字和是否私密，只有主人能改
+	Update(ctx context.Context, c Collection) error
+	// Delete 删除收藏夹和里面的收藏，返回被删掉的收藏，收藏数已经减掉了
+	Delete(ctx context.Context, uid int64, id int64) ([]UserCollectionBiz, error)
+	FindById(ctx context.Context, id int64) (Collection, error)
+	// ListByUid onlyPublic 为 true 的时候不返回私密的收藏夹
+	ListByUid(ctx context.Context, uid int64, onlyPublic bool, offset int, limit int) ([]Collection, error)
+	// ListItems 按照放进收藏夹的时间倒序，cid 为 0 的是 uid 的默认收藏夹
+	ListItems(ctx context.Context, uid int64, cid int64, offset int, limit int) ([]UserCollectionBiz, error)
+}
+
+type GORMCollectionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollectionDAO(db *gorm.DB) CollectionDAO {
+	return &GORMCollectionDAO{db: db}
+}
+
+func (dao *GORMCollectionDAO) Insert(ctx context.Context, c Collection) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := dao.db.WithContext(ctx).Create(&c).Error
+	return c.Id, err
+}
+
+func (dao *GORMCollectionDAO) Update(ctx context.Context, c Collection) error {
+	res := dao.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ? AND uid = ?", c.Id, c.Uid).
+		Updates(map[string]any{
+			"name":    c.Name,
+			"private": c.Private,
+			"utime":   time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+func (dao *GORMCollectionDAO) Delete(ctx context.Context, uid int64, id int64) ([]UserCollectionBiz, error) {
+	var items []UserCollectionBiz
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := lockCollection(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		err = tx.Where("uid = ? AND cid = ?", uid, id).Find(&items).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		for _, item := range items {
+			err = tx.Delete(&UserCollectionBiz{}, item.Id).Error
+			if err != nil {
+				return err
+			}
+			err = decrCollectCnt(tx, item.Biz, item.BizId, now)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&Collection{}, id).Error
+	})
+	return items, err
+}
+
+func (dao *GORMCollectionDAO) FindById(ctx context.Context, id int64) (Collection, error) {
+	var res Collection
+	err := dao.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	if err == gorm.ErrRecordNotFound {
+		return res, ErrCollectionNotFound
+	}
+	return res, err
+}
+
+func (dao *GORMCollectionDAO) ListByUid(ctx context.Context, uid int64, onlyPublic bool,
+	offset int, limit int) ([]Collection, error) {
+	query := dao.db.WithContext(ctx).Where("uid = ?", uid)
+	if onlyPublic {
+		query = query.Where("private = ?", false)
+	}
+	var res []Collection
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMCollectionDAO) ListItems(ctx context.Context, uid int64, cid int64,
+	offset int, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := dao.db.WithContext(ctx).
+		Where("uid = ? AND cid = ?", uid, cid).
+		Order("utime DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// lockCollection 锁住 uid 的收藏夹，防止一边删除一边往里面放东西
+func lockCollection(tx *gorm.DB, uid int64, id int64) error {
+	var c Collection
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND uid = ?", id, uid).
+		First(&c).Error
+	if err == gorm.ErrRecordNotFound {
+		return ErrCollectionNotFound
+	}
+	return err
+}
+
+func decrCollectCnt(tx *gorm.DB, biz string, bizId int64, now int64) error {
+	return tx.Model(&Interactive{}).
+		Where("biz = ? AND biz_id = ?", biz, bizId).
+		Updates(map[string]any{
+			"collect_cnt": gorm.Expr("`collect_cnt` - 1"),
+			"utime":       now,
+		}).Error
+}
+
+// Collection 收藏夹，默认收藏夹没有对应的记录
+type Collection struct {
+	Id      int64  `gorm:"primaryKey,autoIncrement"`
+	Uid     int64  `gorm:"index"`
+	Name    string `gorm:"type:varchar(256)"`
+	Private bool
+	Ctime   int64
+	Utime   int64
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newCollectionTestDB(t *testing.T) *gorm.DB {
+	return newTestDB(t, &Interactive{}, &UserCollectionBiz{}, &Collection{})
+}
+
+func collectCnt(t *testing.T, db *gorm.DB, bizId int64) int64 {
+	var intr Interactive
+	require.NoError(t, db.Where("biz = ? AND biz_id = ?", "article", bizId).First(&intr).Error)
+	return intr.CollectCnt
+}
+
+func TestGORMInteractiveDAO_Collection(t *testing.T) {
+	db := newCollectionTestDB(t)
+	intrDAO := NewGORMInteractiveDAO(db)
+	cDAO := NewGORMCollectionDAO(db)
+	ctx := context.Background()
+
+	cid, err := cDAO.Insert(ctx, Collection{Uid: 1, Name: "技术"})
+	require.NoError(t, err)
+
+	// 别人的收藏夹不能放
+	_, err = intrDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 2, Biz: "article", BizId: 1, Cid: cid})
+	assert.Equal(t, ErrCollectionNotFound, err)
+
+	created, err := intrDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 1, Biz: "article", BizId: 1})
+	require.NoError(t, err)
+	assert.True(t, created)
+	// 从默认收藏夹移到 cid，收藏数不变
+	created, err = intrDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 1, Biz: "article", BizId: 1, Cid: cid})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, int64(1), collectCnt(t, db, 1))
+
+	items, err := cDAO.ListItems(ctx, 1, cid, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	items, err = cDAO.ListItems(ctx, 1, 0, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	deleted, err := intrDAO.DeleteCollectionBiz(ctx, "article", 1, 1)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, int64(0), collectCnt(t, db, 1))
+	deleted, err = intrDAO.DeleteCollectionBiz(ctx, "article", 1, 1)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Equal(t, int64(0), collectCnt(t, db, 1))
+}
+
+func TestGORMCollectionDAO(t *testing.T) {
+	db := newCollectionTestDB(t)
+	intrDAO := NewGORMInteractiveDAO(db)
+	cDAO := NewGORMCollectionDAO(db)
+	ctx := context.Background()
+
+	cid, err := cDAO.Insert(ctx, Collection{Uid: 1, Name: "技术"})
+	require.NoError(t, err)
+	_, err = cDAO.Insert(ctx, Collection{Uid: 1, Name: "私密", Private: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, ErrCollectionNotFound, cDAO.Update(ctx, Collection{Id: cid, Uid: 2, Name: "改名"}))
+	require.NoError(t, cDAO.Update(ctx, Collection{Id: cid, Uid: 1, Name: "改名", Private: true}))
+	c, err := cDAO.FindById(ctx, cid)
+	require.NoError(t, err)
+	assert.Equal(t, "改名", c.Name)
+	assert.True(t, c.Private)
+
+	cs, err := cDAO.ListByUid(ctx, 1, false, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, cs, 2)
+	cs, err = cDAO.ListByUid(ctx, 1, true, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, cs)
+
+	for id := int64(1); id <= 3; id++ {
+		_, err = intrDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 1, Biz: "article", BizId: id, Cid: cid})
+		require.NoError(t, err)
+	}
+	_, err = cDAO.Delete(ctx, 2, cid)
+	assert.Equal(t, ErrCollectionNotFound, err)
+	items, err := cDAO.Delete(ctx, 1, cid)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+	for id := int64(1); id <= 3; id++ {
+		assert.Equal(t, int64(0), collectCnt(t, db, id))
+	}
+	_, err = cDAO.FindById(ctx, cid)
+	assert.Equal(t, ErrCollectionNotFound, err)
+}
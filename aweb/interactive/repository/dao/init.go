@@ -7,5 +7,8 @@ func InitTables(db *gorm.DB) error {
 		&Interactive{},
 		&UserLikeBiz{},
 		&UserCollectionBiz{},
+		&Collection{},
+		&UserReactionBiz{},
+		&InteractiveBucket{},
 	)
 }
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"gorm.io/gorm"
@@ -13,13 +14,35 @@ type InteractiveDAO interface {
 	BatchIncrReadCnt(ctx context.Context, bizs []string, bizIds []int64) error
 	InsertLikeInfo(ctx context.Context, biz string, id int64, uid int64) error
 	DeleteLikeInfo(ctx context.Context, biz string, id int64, uid int64) error
-	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error
+	// InsertCollectionBiz 同一个资源只能在一个收藏夹里面，已经收藏过的会移到 cb.Cid
+	// 只有新收藏的才会增加收藏数，created 为 true
+	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) (created bool, err error)
+	// DeleteCollectionBiz 取消收藏，没有收藏过的返回 false
+	DeleteCollectionBiz(ctx context.Context, biz string, id int64, uid int64) (bool, error)
 	GetLikeInfo(ctx context.Context,
 		biz string, id int64, uid int64) (UserLikeBiz, error)
 	GetCollectInfo(ctx context.Context,
 		biz string, id int64, uid int64) (UserCollectionBiz, error)
+	// GetLikeInfos uid 在 ids 里面点赞了哪些，没点赞的不返回
+	GetLikeInfos(ctx context.Context,
+		biz string, ids []int64, uid int64) ([]UserLikeBiz, error)
+	// GetCollectInfos uid 在 ids 里面收藏了哪些，没收藏的不返回
+	GetCollectInfos(ctx context.Context,
+		biz string, ids []int64, uid int64) ([]UserCollectionBiz, error)
+	// InsertReaction 表态，返回之前的表态，0 表示之前没有
+	InsertReaction(ctx context.Context, biz string, id int64, uid int64, typ uint8) (old uint8, err error)
+	// DeleteReaction 取消表态，返回取消掉的表态，0 表示之前没有
+	DeleteReaction(ctx context.Context, biz string, id int64, uid int64) (old uint8, err error)
+	GetReaction(ctx context.Context, biz string, id int64, uid int64) (UserReactionBiz, error)
+	// ListLikes uid 点赞过的 biz，按照 (utime, id) 倒序，返回排在 (utime, id) 后面的
+	// utime 和 id 都是 0 的时候从头开始，取消了的不返回
+	ListLikes(ctx context.Context, uid int64, biz string, utime int64, id int64, limit int) ([]UserLikeBiz, error)
+	// ListCollects uid 收藏过的 biz，按照 (ctime, id) 倒序，换收藏夹不影响顺序
+	ListCollects(ctx context.Context, uid int64, biz string, ctime int64, id int64, limit int) ([]UserCollectionBiz, error)
 	Get(ctx context.Context, biz string, id int64) (Interactive, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]Interactive, error)
+	// DeleteByBiz 删除资源的计数、点赞、收藏记录和趋势数据，资源本身被彻底删除的时候用
+	DeleteByBiz(ctx context.Context, biz string, ids []int64) error
 }
 
 var (
@@ -50,17 +73,49 @@ func (dao *GORMInteractiveDAO) IncrReadCnt(ctx context.Context, biz string, bizI
 	}).Error
 }
 
+// BatchIncrReadCnt 同一个资源出现多次会先合并，然后一条 INSERT ... ON DUPLICATE KEY UPDATE 写进去
 func (dao *GORMInteractiveDAO) BatchIncrReadCnt(ctx context.Context, bizs []string, bizIds []int64) error {
-	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		txDAO := NewGORMInteractiveDAO(tx)
-		for i := 0; i < len(bizs); i++ {
-			err := txDAO.IncrReadCnt(ctx, bizs[i], bizIds[i])
-			if err != nil {
-				return err
-			}
+	type readKey struct {
+		biz   string
+		bizId int64
+	}
+	merged := make(map[readKey]int, len(bizs))
+	rows := make([]Interactive, 0, len(bizs))
+	now := time.Now().UnixMilli()
+	for i := 0; i < len(bizs); i++ {
+		key := readKey{biz: bizs[i], bizId: bizIds[i]}
+		idx, ok := merged[key]
+		if !ok {
+			idx = len(rows)
+			merged[key] = idx
+			rows = append(rows, Interactive{Biz: bizs[i], BizId: bizIds[i], Ctime: now, Utime: now})
 		}
+		rows[idx].ReadCnt++
+	}
+	if len(rows) == 0 {
 		return nil
+	}
+	// 固定加锁顺序，并发的批量更新不会互相死锁
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Biz != rows[j].Biz {
+			return rows[i].Biz < rows[j].Biz
+		}
+		return rows[i].BizId < rows[j].BizId
 	})
+	return dao.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"read_cnt": gorm.Expr("`read_cnt` + " + dao.inserted("read_cnt")),
+			"utime":    now,
+		}),
+	}).Create(&rows).Error
+}
+
+// inserted 冲突的时候引用 INSERT 里面这一行的值，MySQL 和 SQLite 写法不一样
+func (dao *GORMInteractiveDAO) inserted(col string) string {
+	if dao.db.Dialector.Name() == "mysql" {
+		return "VALUES(`" + col + "`)"
+	}
+	return "excluded.`" + col + "`"
 }
 
 func (dao *GORMInteractiveDAO) InsertLikeInfo(ctx context.Context,
@@ -129,15 +184,37 @@ func (dao *GORMInteractiveDAO) DeleteLikeInfo(ctx context.Context,
 }
 
 func (dao *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context,
-	cb UserCollectionBiz) error {
+	cb UserCollectionBiz) (bool, error) {
 	now := time.Now().UnixMilli()
 	cb.Ctime = now
 	cb.Utime = now
-	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Create(&cb).Error
+	created := false
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if cb.Cid > 0 {
+			err := lockCollection(tx, cb.Uid, cb.Cid)
+			if err != nil {
+				return err
+			}
+		}
+		var old UserCollectionBiz
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ? AND biz = ? AND biz_id = ?", cb.Uid, cb.Biz, cb.BizId).
+			Limit(1).Find(&old).Error
 		if err != nil {
 			return err
 		}
+		if old.Id > 0 {
+			// 换个收藏夹，收藏数不变
+			return tx.Model(&old).Updates(map[string]any{
+				"cid":   cb.Cid,
+				"utime": now,
+			}).Error
+		}
+		err = tx.Create(&cb).Error
+		if err != nil {
+			return err
+		}
+		created = true
 		return tx.WithContext(ctx).Clauses(clause.OnConflict{
 			DoUpdates: clause.Assignments(map[string]interface{}{
 				"collect_cnt": gorm.Expr("`collect_cnt` + 1"),
@@ -151,6 +228,22 @@ func (dao *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context,
 			Utime:      now,
 		}).Error
 	})
+	return created && err == nil, err
+}
+
+func (dao *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context,
+	biz string, id int64, uid int64) (bool, error) {
+	deleted := false
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, id).
+			Delete(&UserCollectionBiz{})
+		if res.Error != nil || res.RowsAffected < 1 {
+			return res.Error
+		}
+		deleted = true
+		return decrCollectCnt(tx, biz, id, time.Now().UnixMilli())
+	})
+	return deleted && err == nil, err
 }
 
 func (dao *GORMInteractiveDAO) GetLikeInfo(ctx context.Context,
@@ -172,6 +265,53 @@ func (dao *GORMInteractiveDAO) GetCollectInfo(ctx context.Context,
 	return res, err
 }
 
+func (dao *GORMInteractiveDAO) GetLikeInfos(ctx context.Context,
+	biz string, ids []int64, uid int64) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	err := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ? AND uid = ? AND status = ?",
+			biz, ids, uid, 1).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) GetCollectInfos(ctx context.Context,
+	biz string, ids []int64, uid int64) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ? AND uid = ?", biz, ids, uid).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) ListLikes(ctx context.Context,
+	uid int64, biz string, utime int64, id int64, limit int) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	query := dao.db.WithContext(ctx).
+		Where("uid = ? AND biz = ? AND status = ?", uid, biz, 1)
+	if utime != 0 || id != 0 {
+		query = query.Where("utime < ? OR (utime = ? AND id < ?)", utime, utime, id)
+	}
+	err := query.Order("utime DESC, id DESC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) ListCollects(ctx context.Context,
+	uid int64, biz string, ctime int64, id int64, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	query := dao.db.WithContext(ctx).
+		Where("uid = ? AND biz = ?", uid, biz)
+	if ctime != 0 || id != 0 {
+		query = query.Where("ctime < ? OR (ctime = ? AND id < ?)", ctime, ctime, id)
+	}
+	err := query.Order("ctime DESC, id DESC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
 func (dao *GORMInteractiveDAO) Get(ctx context.Context, biz string, id int64) (Interactive, error) {
 	var res Interactive
 	err := dao.db.WithContext(ctx).
@@ -188,6 +328,19 @@ func (dao *GORMInteractiveDAO) GetByIds(ctx context.Context, biz string, ids []i
 	return res, err
 }
 
+func (dao *GORMInteractiveDAO) DeleteByBiz(ctx context.Context, biz string, ids []int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []any{&Interactive{}, &UserLikeBiz{}, &UserCollectionBiz{},
+			&UserReactionBiz{}, &InteractiveBucket{}} {
+			err := tx.Where("biz = ? AND biz_id IN ?", biz, ids).Delete(model).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 type Interactive struct {
 	Id int64 `gorm:"primaryKey,autoIncrement"`
 	// <bizid, biz>
@@ -198,28 +351,35 @@ type Interactive struct {
 	ReadCnt    int64
 	LikeCnt    int64
 	CollectCnt int64
-	Utime      int64
-	Ctime      int64
+	// 每种表态一列，列名是 reactionColumn
+	// 这几列是后加的，NOT NULL DEFAULT 0 让已有的行迁移之后是 0 而不是 NULL
+	InsightfulCnt int64 `gorm:"not null;default:0"`
+	FunnyCnt      int64 `gorm:"not null;default:0"`
+	CelebrateCnt  int64 `gorm:"not null;default:0"`
+	Utime         int64
+	Ctime         int64
 }
 
 type UserLikeBiz struct {
-	Id     int64  `gorm:"primaryKey,autoIncrement"`
-	Uid    int64  `gorm:"uniqueIndex:uid_biz_type_id"`
-	BizId  int64  `gorm:"uniqueIndex:uid_biz_type_id"`
-	Biz    string `gorm:"type:varchar(128);uniqueIndex:uid_biz_type_id"`
+	Id    int64 `gorm:"primaryKey,autoIncrement"`
+	Uid   int64 `gorm:"uniqueIndex:uid_biz_type_id;index:uid_biz_utime,priority:1"`
+	BizId int64 `gorm:"uniqueIndex:uid_biz_type_id"`
+	// 我的点赞按照 uid、biz 查，utime 倒序
+	Biz    string `gorm:"type:varchar(128);uniqueIndex:uid_biz_type_id;index:uid_biz_utime,priority:2"`
 	Status int
-	Utime  int64
+	Utime  int64 `gorm:"index:uid_biz_utime,priority:3"`
 	Ctime  int64
 }
 
 type UserCollectionBiz struct {
-	Id    int64  `gorm:"primaryKey,autoIncrement"`
-	Uid   int64  `gorm:"uniqueIndex:uid_biz_type_id"`
-	BizId int64  `gorm:"uniqueIndex:uid_biz_type_id"`
-	Biz   string `gorm:"type:varchar(128);uniqueIndex:uid_biz_type_id"`
+	Id    int64 `gorm:"primaryKey,autoIncrement"`
+	Uid   int64 `gorm:"uniqueIndex:uid_biz_type_id;index:uid_biz_ctime,priority:1"`
+	BizId int64 `gorm:"uniqueIndex:uid_biz_type_id"`
+	// 我的收藏按照 uid、biz 查，ctime 倒序
+	Biz string `gorm:"type:varchar(128);uniqueIndex:uid_biz_type_id;index:uid_biz_ctime,priority:2"`
 	// 收藏夹的ID
 	// 收藏夹ID本身有索引
 	Cid   int64 `gorm:"index"`
 	Utime int64
-	Ctime int64
+	Ctime int64 `gorm:"index:uid_biz_ctime,priority:3"`
 }
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB 每个测试一个单独的 SQLite 文件，只建用到的表
+// 点赞表和收藏表的索引同名，SQLite 的索引名是全库唯一的，不能一起建
+func newTestDB(t *testing.T, models ...any) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "interactive.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(models...))
+	return db
+}
+
+func TestGORMInteractiveDAO_BatchIncrReadCnt(t *testing.T) {
+	db := newTestDB(t, &Interactive{})
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	require.NoError(t, intrDAO.IncrReadCnt(ctx, "article", 1))
+	require.NoError(t, intrDAO.BatchIncrReadCnt(ctx,
+		[]string{"article", "article", "article", "video", "article"},
+		[]int64{1, 2, 1, 1, 1}))
+	require.NoError(t, intrDAO.BatchIncrReadCnt(ctx, nil, nil))
+
+	readCnt := func(biz string, id int64) int64 {
+		intr, err := intrDAO.Get(ctx, biz, id)
+		require.NoError(t, err)
+		return intr.ReadCnt
+	}
+	assert.Equal(t, int64(4), readCnt("article", 1))
+	assert.Equal(t, int64(1), readCnt("article", 2))
+	assert.Equal(t, int64(1), readCnt("video", 1))
+	var cnt int64
+	require.NoError(t, db.Model(&Interactive{}).Count(&cnt).Error)
+	assert.Equal(t, int64(3), cnt)
+}
+
+func TestGORMInteractiveDAO_GetInfosByIds(t *testing.T) {
+	// 收藏用 collection_test 的库
+	db := newTestDB(t, &Interactive{}, &UserLikeBiz{})
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	require.NoError(t, intrDAO.InsertLikeInfo(ctx, "article", 1, 1))
+	require.NoError(t, intrDAO.InsertLikeInfo(ctx, "article", 3, 1))
+	require.NoError(t, intrDAO.InsertLikeInfo(ctx, "article", 2, 2))
+	require.NoError(t, intrDAO.DeleteLikeInfo(ctx, "article", 3, 1))
+	likes, err := intrDAO.GetLikeInfos(ctx, "article", []int64{1, 2, 3}, 1)
+	require.NoError(t, err)
+	require.Len(t, likes, 1)
+	assert.Equal(t, int64(1), likes[0].BizId)
+
+	cdb := newCollectionTestDB(t)
+	cDAO := NewGORMInteractiveDAO(cdb)
+	_, err = cDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 1, Biz: "article", BizId: 2})
+	require.NoError(t, err)
+	_, err = cDAO.InsertCollectionBiz(ctx, UserCollectionBiz{Uid: 2, Biz: "article", BizId: 1})
+	require.NoError(t, err)
+	items, err := cDAO.GetCollectInfos(ctx, "article", []int64{1, 2, 3}, 1)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(2), items[0].BizId)
+}
+
+func TestGORMInteractiveDAO_ListLikes(t *testing.T) {
+	db := newTestDB(t, &Interactive{}, &UserLikeBiz{})
+	assert.True(t, db.Migrator().HasIndex(&UserLikeBiz{}, "uid_biz_utime"))
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	// 同一个 utime 的按照 id 倒序
+	require.NoError(t, db.Create([]UserLikeBiz{
+		{Uid: 1, Biz: "article", BizId: 1, Status: 1, Utime: 100},
+		{Uid: 1, Biz: "article", BizId: 2, Status: 1, Utime: 200},
+		{Uid: 1, Biz: "article", BizId: 3, Status: 1, Utime: 200},
+		{Uid: 1, Biz: "article", BizId: 4, Status: 0, Utime: 300},
+		{Uid: 1, Biz: "video", BizId: 5, Status: 1, Utime: 300},
+		{Uid: 2, Biz: "article", BizId: 6, Status: 1, Utime: 300},
+	}).Error)
+
+	likes, err := intrDAO.ListLikes(ctx, 1, "article", 0, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, likes, 2)
+	assert.Equal(t, []int64{3, 2}, []int64{likes[0].BizId, likes[1].BizId})
+	last := likes[1]
+	likes, err = intrDAO.ListLikes(ctx, 1, "article", last.Utime, last.Id, 2)
+	require.NoError(t, err)
+	require.Len(t, likes, 1)
+	assert.Equal(t, int64(1), likes[0].BizId)
+}
+
+func TestGORMInteractiveDAO_ListCollects(t *testing.T) {
+	db := newCollectionTestDB(t)
+	assert.True(t, db.Migrator().HasIndex(&UserCollectionBiz{}, "uid_biz_ctime"))
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	require.NoError(t, db.Create([]UserCollectionBiz{
+		{Uid: 1, Biz: "article", BizId: 1, Ctime: 100, Utime: 400},
+		{Uid: 1, Biz: "article", BizId: 2, Ctime: 200, Utime: 200},
+		{Uid: 1, Biz: "article", BizId: 3, Ctime: 300, Utime: 300},
+	}).Error)
+
+	// 换过收藏夹的 utime 变了，顺序还是按照收藏的时间
+	items, err := intrDAO.ListCollects(ctx, 1, "article", 0, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, []int64{3, 2}, []int64{items[0].BizId, items[1].BizId})
+	items, err = intrDAO.ListCollects(ctx, 1, "article", items[1].Ctime, items[1].Id, 2)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(1), items[0].BizId)
+}
@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserReactionBiz 用户对资源的表态，和点赞一样取消的时候只改 status
+type UserReactionBiz struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Uid   int64  `gorm:"uniqueIndex:uid_biz_reaction"`
+	BizId int64  `gorm:"uniqueIndex:uid_biz_reaction"`
+	Biz   string `gorm:"type:varchar(128);uniqueIndex:uid_biz_reaction"`
+	// Type 见 domain.ReactionType
+	Type   uint8
+	Status int
+	Utime  int64
+	Ctime  int64
+}
+
+// reactionColumn 表态在 Interactive 里面对应的计数列
+func reactionColumn(typ uint8) string {
+	return domain.ReactionType(typ).String() + "_cnt"
+}
+
+func (dao *GORMInteractiveDAO) InsertReaction(ctx context.Context,
+	biz string, id int64, uid int64, typ uint8) (uint8, error) {
+	now := time.Now().UnixMilli()
+	var old uint8
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var r UserReactionBiz
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, id).
+			Limit(1).Find(&r).Error
+		if err != nil {
+			return err
+		}
+		if r.Status == 1 {
+			old = r.Type
+		}
+		if old == typ {
+			return nil
+		}
+		if r.Id > 0 {
+			err = tx.Model(&r).Updates(map[string]any{
+				"type":   typ,
+				"status": 1,
+				"utime":  now,
+			}).Error
+		} else {
+			err = tx.Create(&UserReactionBiz{
+				Uid:    uid,
+				Biz:    biz,
+				BizId:  id,
+				Type:   typ,
+				Status: 1,
+				Utime:  now,
+				Ctime:  now,
+			}).Error
+		}
+		if err != nil {
+			return err
+		}
+		if old > 0 {
+			err = incrReactionCnt(tx, biz, id, old, -1, now)
+			if err != nil {
+				return err
+			}
+		}
+		return incrReactionCnt(tx, biz, id, typ, 1, now)
+	})
+	return old, err
+}
+
+func (dao *GORMInteractiveDAO) DeleteReaction(ctx context.Context,
+	biz string, id int64, uid int64) (uint8, error) {
+	now := time.Now().UnixMilli()
+	var old uint8
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var r UserReactionBiz
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ? AND biz = ? AND biz_id = ? AND status = ?", uid, biz, id, 1).
+			Limit(1).Find(&r).Error
+		if err != nil || r.Id == 0 {
+			return err
+		}
+		old = r.Type
+		err = tx.Model(&r).Updates(map[string]any{
+			"status": 0,
+			"utime":  now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return incrReactionCnt(tx, biz, id, old, -1, now)
+	})
+	return old, err
+}
+
+func (dao *GORMInteractiveDAO) GetReaction(ctx context.Context,
+	biz string, id int64, uid int64) (UserReactionBiz, error) {
+	var res UserReactionBiz
+	err := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id = ? AND uid = ? AND status = ?", biz, id, uid, 1).
+		First(&res).Error
+	return res, err
+}
+
+func incrReactionCnt(tx *gorm.DB, biz string, id int64, typ uint8, delta int64, now int64) error {
+	col := reactionColumn(typ)
+	// 列是动态的，只能用 map 插入，map 插入不会给没写的列填零值，
+	// 计数列是 NULL 的话后面加一还是 NULL，所以全部写上
+	row := map[string]any{
+		"biz":         biz,
+		"biz_id":      id,
+		"read_cnt":    0,
+		"like_cnt":    0,
+		"collect_cnt": 0,
+		"ctime":       now,
+		"utime":       now,
+	}
+	for _, t := range domain.ReactionTypes {
+		row[reactionColumn(t.ToUint8())] = 0
+	}
+	row[col] = delta
+	return tx.Model(&Interactive{}).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			// 早先迁移出来的列可能是 NULL，NULL 加上任何数还是 NULL
+			col:     gorm.Expr("COALESCE(`"+col+"`, 0) + ?", delta),
+			"utime": now,
+		}),
+	}).Create(row).Error
+}
@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMInteractiveDAO_Reaction(t *testing.T) {
+	db := newTestDB(t, &Interactive{}, &UserReactionBiz{})
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+	insightful := domain.ReactionTypeInsightful.ToUint8()
+	funny := domain.ReactionTypeFunny.ToUint8()
+
+	old, err := intrDAO.InsertReaction(ctx, "article", 1, 1, insightful)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), old)
+	_, err = intrDAO.InsertReaction(ctx, "article", 1, 2, insightful)
+	require.NoError(t, err)
+	// 重复表态不改计数
+	old, err = intrDAO.InsertReaction(ctx, "article", 1, 1, insightful)
+	require.NoError(t, err)
+	assert.Equal(t, insightful, old)
+
+	intr, err := intrDAO.Get(ctx, "article", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), intr.InsightfulCnt)
+
+	// 换一种表态，旧的减一
+	old, err = intrDAO.InsertReaction(ctx, "article", 1, 1, funny)
+	require.NoError(t, err)
+	assert.Equal(t, insightful, old)
+	intr, err = intrDAO.Get(ctx, "article", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), intr.InsightfulCnt)
+	assert.Equal(t, int64(1), intr.FunnyCnt)
+	r, err := intrDAO.GetReaction(ctx, "article", 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, funny, r.Type)
+
+	old, err = intrDAO.DeleteReaction(ctx, "article", 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, funny, old)
+	old, err = intrDAO.DeleteReaction(ctx, "article", 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), old)
+	_, err = intrDAO.GetReaction(ctx, "article", 1, 1)
+	assert.Equal(t, ErrRecordNotFound, err)
+	intr, err = intrDAO.Get(ctx, "article", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), intr.FunnyCnt)
+
+	// 取消之后再表态，复用原来那一行
+	_, err = intrDAO.InsertReaction(ctx, "article", 1, 1, funny)
+	require.NoError(t, err)
+	var cnt int64
+	require.NoError(t, db.Model(&UserReactionBiz{}).Where("uid = ?", 1).Count(&cnt).Error)
+	assert.Equal(t, int64(1), cnt)
+}
+
+// legacyInteractive 加表态之前的 interactives 表
+type legacyInteractive struct {
+	Id         int64  `gorm:"primaryKey,autoIncrement"`
+	BizId      int64  `gorm:"uniqueIndex:biz_type_id"`
+	Biz        string `gorm:"type:varchar(128);uniqueIndex:biz_type_id"`
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+	Utime      int64
+	Ctime      int64
+}
+
+func (legacyInteractive) TableName() string {
+	return "interactives"
+}
+
+func TestGORMInteractiveDAO_ReactionOnMigratedRow(t *testing.T) {
+	db := newTestDB(t, &legacyInteractive{})
+	require.NoError(t, db.Create(&legacyInteractive{Biz: "article", BizId: 1, ReadCnt: 10}).Error)
+	require.NoError(t, db.AutoMigrate(&Interactive{}, &UserReactionBiz{}))
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	intr, err := intrDAO.Get(ctx, "article", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), intr.InsightfulCnt)
+
+	_, err = intrDAO.InsertReaction(ctx, "article", 1, 1, domain.ReactionTypeInsightful.ToUint8())
+	require.NoError(t, err)
+	var cnt *int64
+	require.NoError(t, db.Raw("SELECT insightful_cnt FROM interactives WHERE biz = ? AND biz_id = ?",
+		"article", 1).Scan(&cnt).Error)
+	require.NotNil(t, cnt)
+	assert.Equal(t, int64(1), *cnt)
+
+	assert.Equal(t, int64(10), intr.ReadCnt)
+}
+
+// nullableReactionInteractive 表态的列当初迁移成了可以为 NULL 的样子
+type nullableReactionInteractive struct {
+	Id            int64  `gorm:"primaryKey,autoIncrement"`
+	BizId         int64  `gorm:"uniqueIndex:biz_type_id"`
+	Biz           string `gorm:"type:varchar(128);uniqueIndex:biz_type_id"`
+	ReadCnt       int64
+	LikeCnt       int64
+	CollectCnt    int64
+	Utime         int64
+	Ctime         int64
+	InsightfulCnt *int64
+	FunnyCnt      *int64
+	CelebrateCnt  *int64
+}
+
+func (nullableReactionInteractive) TableName() string {
+	return "interactives"
+}
+
+func TestGORMInteractiveDAO_ReactionOnNullColumn(t *testing.T) {
+	db := newTestDB(t, &nullableReactionInteractive{}, &UserReactionBiz{})
+	require.NoError(t, db.Create(&nullableReactionInteractive{Biz: "article", BizId: 1, ReadCnt: 10}).Error)
+	intrDAO := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	_, err := intrDAO.InsertReaction(ctx, "article", 1, 1, domain.ReactionTypeFunny.ToUint8())
+	require.NoError(t, err)
+	intr, err := intrDAO.Get(ctx, "article", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), intr.FunnyCnt)
+	assert.Equal(t, int64(10), intr.ReadCnt)
+}
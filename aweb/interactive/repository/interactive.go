@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/pluckhuang/goweb/aweb/interactive/domain"
@@ -16,11 +17,29 @@ type InteractiveRepository interface {
 	BatchIncrReadCnt(ctx context.Context, biz []string, bizId []int64) error
 	IncrLike(ctx context.Context, biz string, id int64, uid int64) error
 	DecrLike(ctx context.Context, biz string, id int64, uid int64) error
-	AddCollectionItem(ctx context.Context, biz string, id int64, cid int64, uid int64) error
+	// AddCollectionItem 已经收藏过的会移到 cid 里面，只有新收藏的 created 才是 true
+	AddCollectionItem(ctx context.Context, biz string, id int64, cid int64, uid int64) (created bool, err error)
+	// RemoveCollectionItem 没有收藏过的返回 false
+	RemoveCollectionItem(ctx context.Context, biz string, id int64, uid int64) (bool, error)
+	// React 表态，已经有别的表态的会被替换
+	React(ctx context.Context, biz string, id int64, uid int64, typ domain.ReactionType) error
+	Unreact(ctx context.Context, biz string, id int64, uid int64) error
+	// Reaction uid 的表态，没有表态返回 domain.ReactionTypeUnknown
+	Reaction(ctx context.Context, biz string, id int64, uid int64) (domain.ReactionType, error)
 	Get(ctx context.Context, biz string, id int64) (domain.Interactive, error)
 	Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error)
 	Collected(ctx context.Context, biz string, id int64, uid int64) (bool, error)
+	// LikedByIds ids 里面 uid 点赞了的，没点赞的不在 map 里面
+	LikedByIds(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]bool, error)
+	// CollectedByIds ids 里面 uid 收藏了的，没收藏的不在 map 里面
+	CollectedByIds(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]bool, error)
+	// ListLiked uid 点赞过的 biz，按照点赞的时间倒序，Meta 都是 nil
+	ListLiked(ctx context.Context, uid int64, biz string, cursor domain.Cursor, limit int) ([]domain.HistoryItem, error)
+	// ListCollected uid 收藏过的 biz，按照收藏的时间倒序，Meta 都是 nil
+	ListCollected(ctx context.Context, uid int64, biz string, cursor domain.Cursor, limit int) ([]domain.HistoryItem, error)
+	// GetByIds 先查缓存，缓存没有的再查数据库并回写，按照 ids 的顺序返回，没有数据的跳过
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error)
+	DeleteByBiz(ctx context.Context, biz string, ids []int64) error
 }
 
 type CachedInteractiveRepository struct {
@@ -48,12 +67,23 @@ func (c *CachedInteractiveRepository) BatchIncrReadCnt(ctx context.Context, biz
 	if err != nil {
 		return err
 	}
+	type readKey struct {
+		biz   string
+		bizId int64
+	}
+	deltas := make(map[readKey]int64, len(biz))
+	for i := 0; i < len(biz); i++ {
+		deltas[readKey{biz: biz[i], bizId: bizId[i]}]++
+	}
 	go func() {
-		for i := 0; i < len(biz); i++ {
-			er := c.cache.IncrReadCntIfPresent(ctx, biz[i], bizId[i])
+		// 调用方返回之后 ctx 就取消了，不能再用
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		for key, delta := range deltas {
+			er := c.cache.AddReadCntIfPresent(ctx, key.biz, key.bizId, delta)
 			if er != nil {
 				// 记录日志
-				c.l.Error("批量更新阅读数缓存失败", logger.Error(er), logger.String("biz", biz[i]), logger.Int64("bizId", bizId[i]))
+				c.l.Error("批量更新阅读数缓存失败", logger.Error(er), logger.String("biz", key.biz), logger.Int64("bizId", key.bizId))
 			}
 		}
 	}()
@@ -78,17 +108,71 @@ func (c *CachedInteractiveRepository) DecrLike(ctx context.Context, biz string,
 }
 
 func (c *CachedInteractiveRepository) AddCollectionItem(ctx context.Context,
-	biz string, id int64, cid int64, uid int64) error {
-	err := c.dao.InsertCollectionBiz(ctx, dao.UserCollectionBiz{
+	biz string, id int64, cid int64, uid int64) (bool, error) {
+	created, err := c.dao.InsertCollectionBiz(ctx, dao.UserCollectionBiz{
 		Biz:   biz,
 		BizId: id,
 		Cid:   cid,
 		Uid:   uid,
 	})
+	if err != nil || !created {
+		return created, err
+	}
+	return true, c.cache.IncrCollectCntIfPresent(ctx, biz, id)
+}
+
+func (c *CachedInteractiveRepository) RemoveCollectionItem(ctx context.Context,
+	biz string, id int64, uid int64) (bool, error) {
+	deleted, err := c.dao.DeleteCollectionBiz(ctx, biz, id, uid)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+	return true, c.cache.DecrCollectCntIfPresent(ctx, biz, id)
+}
+
+func (c *CachedInteractiveRepository) React(ctx context.Context,
+	biz string, id int64, uid int64, typ domain.ReactionType) error {
+	old, err := c.dao.InsertReaction(ctx, biz, id, uid, typ.ToUint8())
+	if err != nil {
+		return err
+	}
+	oldTyp := domain.ReactionType(old)
+	if oldTyp == typ {
+		return nil
+	}
+	if oldTyp.Valid() {
+		err = c.cache.IncrReactionCntIfPresent(ctx, biz, id, oldTyp, -1)
+		if err != nil {
+			return err
+		}
+	}
+	return c.cache.IncrReactionCntIfPresent(ctx, biz, id, typ, 1)
+}
+
+func (c *CachedInteractiveRepository) Unreact(ctx context.Context,
+	biz string, id int64, uid int64) error {
+	old, err := c.dao.DeleteReaction(ctx, biz, id, uid)
 	if err != nil {
 		return err
 	}
-	return c.cache.IncrCollectCntIfPresent(ctx, biz, id)
+	oldTyp := domain.ReactionType(old)
+	if !oldTyp.Valid() {
+		return nil
+	}
+	return c.cache.IncrReactionCntIfPresent(ctx, biz, id, oldTyp, -1)
+}
+
+func (c *CachedInteractiveRepository) Reaction(ctx context.Context,
+	biz string, id int64, uid int64) (domain.ReactionType, error) {
+	r, err := c.dao.GetReaction(ctx, biz, id, uid)
+	switch err {
+	case nil:
+		return domain.ReactionType(r.Type), nil
+	case dao.ErrRecordNotFound:
+		return domain.ReactionTypeUnknown, nil
+	default:
+		return domain.ReactionTypeUnknown, err
+	}
 }
 
 func (c *CachedInteractiveRepository) Get(ctx context.Context, biz string, id int64) (domain.Interactive, error) {
@@ -113,10 +197,16 @@ func (c *CachedInteractiveRepository) Get(ctx context.Context, biz string, id in
 
 func (c *CachedInteractiveRepository) toDomain(ie dao.Interactive) domain.Interactive {
 	return domain.Interactive{
+		Biz:        ie.Biz,
 		BizId:      ie.BizId,
 		ReadCnt:    ie.ReadCnt,
 		LikeCnt:    ie.LikeCnt,
 		CollectCnt: ie.CollectCnt,
+		Reactions: map[domain.ReactionType]int64{
+			domain.ReactionTypeInsightful: ie.InsightfulCnt,
+			domain.ReactionTypeFunny:      ie.FunnyCnt,
+			domain.ReactionTypeCelebrate:  ie.CelebrateCnt,
+		},
 	}
 }
 
@@ -146,12 +236,117 @@ func (c *CachedInteractiveRepository) Collected(ctx context.Context,
 	}
 }
 
-func (c *CachedInteractiveRepository) GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error) {
-	intrs, err := c.dao.GetByIds(ctx, biz, ids)
+func (c *CachedInteractiveRepository) LikedByIds(ctx context.Context,
+	biz string, ids []int64, uid int64) (map[int64]bool, error) {
+	likes, err := c.dao.GetLikeInfos(ctx, biz, ids, uid)
 	if err != nil {
 		return nil, err
 	}
-	return slice.Map(intrs, func(idx int, src dao.Interactive) domain.Interactive {
-		return c.toDomain(src)
+	res := make(map[int64]bool, len(likes))
+	for _, l := range likes {
+		res[l.BizId] = true
+	}
+	return res, nil
+}
+
+func (c *CachedInteractiveRepository) CollectedByIds(ctx context.Context,
+	biz string, ids []int64, uid int64) (map[int64]bool, error) {
+	items, err := c.dao.GetCollectInfos(ctx, biz, ids, uid)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]bool, len(items))
+	for _, item := range items {
+		res[item.BizId] = true
+	}
+	return res, nil
+}
+
+func (c *CachedInteractiveRepository) ListLiked(ctx context.Context,
+	uid int64, biz string, cursor domain.Cursor, limit int) ([]domain.HistoryItem, error) {
+	likes, err := c.dao.ListLikes(ctx, uid, biz, cursor.Time, cursor.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(likes, func(idx int, src dao.UserLikeBiz) domain.HistoryItem {
+		return domain.HistoryItem{
+			Id:    src.Id,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Time:  src.Utime,
+		}
 	}), nil
 }
+
+func (c *CachedInteractiveRepository) ListCollected(ctx context.Context,
+	uid int64, biz string, cursor domain.Cursor, limit int) ([]domain.HistoryItem, error) {
+	items, err := c.dao.ListCollects(ctx, uid, biz, cursor.Time, cursor.Id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, func(idx int, src dao.UserCollectionBiz) domain.HistoryItem {
+		return domain.HistoryItem{
+			Id:    src.Id,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Time:  src.Ctime,
+		}
+	}), nil
+}
+
+func (c *CachedInteractiveRepository) GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error) {
+	if len(ids) == 0 {
+		return []domain.Interactive{}, nil
+	}
+	cached, err := c.cache.GetByIds(ctx, biz, ids)
+	if err != nil {
+		// 缓存出问题了就全部查数据库
+		c.l.Error("批量查询互动缓存失败",
+			logger.String("biz", biz), logger.Error(err))
+		cached = map[int64]domain.Interactive{}
+	}
+	missing := make([]int64, 0, len(ids)-len(cached))
+	for _, id := range ids {
+		if _, ok := cached[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		intrs, err := c.dao.GetByIds(ctx, biz, missing)
+		if err != nil {
+			return nil, err
+		}
+		loaded := slice.Map(intrs, func(idx int, src dao.Interactive) domain.Interactive {
+			return c.toDomain(src)
+		})
+		for _, intr := range loaded {
+			cached[intr.BizId] = intr
+		}
+		err = c.cache.SetByIds(ctx, biz, loaded)
+		if err != nil {
+			c.l.Error("批量回写互动缓存失败",
+				logger.String("biz", biz), logger.Error(err))
+		}
+	}
+	res := make([]domain.Interactive, 0, len(cached))
+	for _, id := range ids {
+		if intr, ok := cached[id]; ok {
+			res = append(res, intr)
+		}
+	}
+	return res, nil
+}
+
+func (c *CachedInteractiveRepository) DeleteByBiz(ctx context.Context, biz string, ids []int64) error {
+	err := c.dao.DeleteByBiz(ctx, biz, ids)
+	if err != nil {
+		return err
+	}
+	// 数据库已经删掉了，缓存删除失败最多是在过期之前还能查到旧的计数
+	er := c.cache.Del(ctx, biz, ids...)
+	if er != nil {
+		c.l.Error("删除互动缓存失败",
+			logger.String("biz", biz), logger.Error(er))
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/interactive/domain"
+	"github.com/pluckhuang/goweb/aweb/interactive/repository"
+)
+
+var (
+	ErrInvalidGranularity = domain.ErrInvalidGranularity
+	ErrInvalidRange       = errors.New("开始时间必须早于结束时间")
+	ErrRangeTooLarge      = errors.New("时间范围太大了")
+)
+
+// authorPageSize 查作者文章列表的时候每页多少篇
+const authorPageSize = 100
+
+// AnalyticsService 给作者看的趋势数据
+type AnalyticsService interface {
+	// Series 单个资源在 [start, end) 之间的趋势
+	Series(ctx context.Context, biz string, bizId int64, granularity domain.Granularity,
+		start, end time.Time) ([]domain.SeriesPoint, error)
+	// AuthorSeries 作者所有文章加在一起的趋势
+	AuthorSeries(ctx context.Context, authorId int64, granularity domain.Granularity,
+		start, end time.Time) ([]domain.SeriesPoint, error)
+}
+
+type AnalyticsConfig struct {
+	// MaxPoints 一次最多返回多少个点
+	MaxPoints int
+}
+
+type analyticsService struct {
+	repo repository.AnalyticsRepository
+	// 互动这边不知道文章的作者，按作者汇总的时候要去文章服务查
+	articleClient articlev1.ArticleServiceClient
+	cfg           AnalyticsConfig
+}
+
+func NewAnalyticsService(repo repository.AnalyticsRepository,
+	articleClient articlev1.ArticleServiceClient, cfg AnalyticsConfig) AnalyticsService {
+	return &analyticsService{
+		repo:          repo,
+		articleClient: articleClient,
+		cfg:           cfg,
+	}
+}
+
+func (s *analyticsService) Series(ctx context.Context, biz string, bizId int64,
+	granularity domain.Granularity, start, end time.Time) ([]domain.SeriesPoint, error) {
+	if err := s.checkRange(granularity, start, end); err != nil {
+		return nil, err
+	}
+	return s.repo.Series(ctx, biz, []int64{bizId}, granularity, start, end)
+}
+
+func (s *analyticsService) AuthorSeries(ctx context.Context, authorId int64,
+	granularity domain.Granularity, start, end time.Time) ([]domain.SeriesPoint, error) {
+	if err := s.checkRange(granularity, start, end); err != nil {
+		return nil, err
+	}
+	ids, err := s.authorArticleIds(ctx, authorId)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Series(ctx, "article", ids, granularity, start, end)
+}
+
+func (s *analyticsService) checkRange(granularity domain.Granularity, start, end time.Time) error {
+	if !granularity.Valid() {
+		return ErrInvalidGranularity
+	}
+	if !start.Before(end) {
+		return ErrInvalidRange
+	}
+	step := time.Hour
+	if granularity == domain.GranularityDay {
+		step = 24 * time.Hour
+	}
+	if end.Sub(start) > step*time.Duration(s.cfg.MaxPoints) {
+		return ErrRangeTooLarge
+	}
+	return nil
+}
+
+func (s *analyticsService) authorArticleIds(ctx context.Context, authorId int64) ([]int64, error) {
+	var (
+		ids    []int64
+		cursor string
+	)
+	for {
+		resp, err := s.articleClient.GetByAuthorByCursor(ctx, &articlev1.GetByAuthorByCursorRequest{
+			Uid:    authorId,
+			Cursor: cursor,
+			Limit:  authorPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, art := range resp.GetArticles() {
+			ids = append(ids, art.GetId())
+		}
+		cursor = resp.GetNextCursor()
+		if cursor == "" {
+			return ids, nil
+		}
+	}
+}
@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
 	"github.com/pluckhuang/goweb/aweb/interactive/domain"
 	"github.com/pluckhuang/goweb/aweb/interactive/events"
 	"github.com/pluckhuang/goweb/aweb/interactive/repository"
@@ -15,22 +19,68 @@ type InteractiveService interface {
 	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
 	Like(c context.Context, biz string, id int64, uid int64) error
 	CancelLike(c context.Context, biz string, id int64, uid int64) error
+	// Collect 收藏到 cid 这个收藏夹，0 是默认收藏夹，已经收藏过的会移过去
 	Collect(ctx context.Context, biz string, bizId, cid, uid int64) error
+	CancelCollect(ctx context.Context, biz string, bizId, uid int64) error
+	CreateCollection(ctx context.Context, c domain.Collection) (int64, error)
+	// UpdateCollection 修改收藏夹的名字和是否私密
+	UpdateCollection(ctx context.Context, c domain.Collection) error
+	// DeleteCollection 里面的收藏也会一起取消
+	DeleteCollection(ctx context.Context, uid int64, id int64) error
+	// ListCollections viewer 查看 owner 的收藏夹，不是自己的看不到私密的
+	ListCollections(ctx context.Context, viewer int64, owner int64, offset int, limit int) ([]domain.Collection, error)
+	// ListCollectionItems viewer 查看收藏夹里面的收藏，cid 为 0 的是 viewer 自己的默认收藏夹
+	ListCollectionItems(ctx context.Context, viewer int64, cid int64, offset int, limit int) ([]domain.CollectionItem, error)
+	// React 表态，每个人对一个资源只有一种表态，换一种会替换掉之前的
+	React(ctx context.Context, biz string, id int64, uid int64, typ domain.ReactionType) error
+	Unreact(ctx context.Context, biz string, id int64, uid int64) error
+	// GetReactions 各种表态的计数，以及 uid 自己的表态
+	GetReactions(ctx context.Context, biz string, id int64, uid int64) (domain.Interactive, error)
+	// ListLiked uid 点赞过的 biz，按照点赞的时间倒序，cursor 为空的时候返回第一页，
+	// 返回的 next 传给下一次调用，next 为空说明没有更多了
+	ListLiked(ctx context.Context, uid int64, biz string, cursor string, limit int) (items []domain.HistoryItem, next string, err error)
+	// ListCollected 和 ListLiked 一样，按照收藏的时间倒序
+	ListCollected(ctx context.Context, uid int64, biz string, cursor string, limit int) (items []domain.HistoryItem, next string, err error)
 	Get(ctx context.Context, biz string, id int64, uid int64) (domain.Interactive, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) (map[int64]domain.Interactive, error)
+	// GetByIdsWithViewer 列表页用，计数加上 uid 有没有点赞、收藏，
+	// 还没有任何互动的资源也会返回，计数都是 0
+	GetByIdsWithViewer(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]domain.Interactive, error)
+	// DeleteByBiz 资源被彻底删除之后，清理它的互动数据
+	DeleteByBiz(ctx context.Context, biz string, ids []int64) error
 }
 
+var (
+	ErrCollectionNotFound    = repository.ErrCollectionNotFound
+	ErrInvalidCollectionName = errors.New("收藏夹的名字不能为空，也不能太长")
+	ErrInvalidReaction       = errors.New("不支持的表态")
+	ErrInvalidCursor         = domain.ErrInvalidCursor
+)
+
+const (
+	maxCollectionNameLen  = 64
+	maxCollectionPageSize = 100
+)
+
 type interactiveService struct {
-	repo     repository.InteractiveRepository
-	producer events.InteractiveProducer
-	l        logger.LoggerV1
+	repo           repository.InteractiveRepository
+	collectionRepo repository.CollectionRepository
+	producer       events.InteractiveProducer
+	// 我的点赞、收藏里面的文章要去文章服务查标题
+	articleClient articlev1.ArticleServiceClient
+	l             logger.LoggerV1
 }
 
-func NewInteractiveService(repo repository.InteractiveRepository, l logger.LoggerV1, producer events.InteractiveProducer) InteractiveService {
+func NewInteractiveService(repo repository.InteractiveRepository,
+	collectionRepo repository.CollectionRepository,
+	l logger.LoggerV1, producer events.InteractiveProducer,
+	articleClient articlev1.ArticleServiceClient) InteractiveService {
 	return &interactiveService{
-		repo:     repo,
-		l:        l,
-		producer: producer,
+		repo:           repo,
+		collectionRepo: collectionRepo,
+		l:              l,
+		producer:       producer,
+		articleClient:  articleClient,
 	}
 }
 
@@ -73,12 +123,13 @@ func (i *interactiveService) CancelLike(c context.Context, biz string, id int64,
 }
 
 func (i *interactiveService) Collect(ctx context.Context, biz string, bizId, cid, uid int64) error {
-	err := i.repo.AddCollectionItem(ctx, biz, bizId, cid, uid)
-	if err != nil {
+	created, err := i.repo.AddCollectionItem(ctx, biz, bizId, cid, uid)
+	if err != nil || !created {
+		// 换收藏夹不影响收藏数，不用同步
 		return err
 	}
 	go func() {
-		err = i.CollectSync(uid, biz, bizId)
+		err = i.collectSync(uid, biz, bizId, cid)
 		if err != nil {
 			i.l.Error("同步收藏数失败",
 				logger.String("biz", biz),
@@ -89,11 +140,166 @@ func (i *interactiveService) Collect(ctx context.Context, biz string, bizId, cid
 	return nil
 }
 
+func (i *interactiveService) CancelCollect(ctx context.Context, biz string, bizId, uid int64) error {
+	deleted, err := i.repo.RemoveCollectionItem(ctx, biz, bizId, uid)
+	if err != nil || !deleted {
+		return err
+	}
+	go i.cancelCollectSync(uid, []domain.CollectionItem{{Biz: biz, BizId: bizId}})
+	return nil
+}
+
+func (i *interactiveService) CreateCollection(ctx context.Context, c domain.Collection) (int64, error) {
+	name, err := i.collectionName(c.Name)
+	if err != nil {
+		return 0, err
+	}
+	c.Name = name
+	return i.collectionRepo.Create(ctx, c)
+}
+
+func (i *interactiveService) UpdateCollection(ctx context.Context, c domain.Collection) error {
+	name, err := i.collectionName(c.Name)
+	if err != nil {
+		return err
+	}
+	c.Name = name
+	return i.collectionRepo.Update(ctx, c)
+}
+
+func (i *interactiveService) collectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLen {
+		return "", ErrInvalidCollectionName
+	}
+	return name, nil
+}
+
+func (i *interactiveService) DeleteCollection(ctx context.Context, uid int64, id int64) error {
+	items, err := i.collectionRepo.Delete(ctx, uid, id)
+	if err != nil {
+		return err
+	}
+	if len(items) > 0 {
+		go i.cancelCollectSync(uid, items)
+	}
+	return nil
+}
+
+func (i *interactiveService) ListCollections(ctx context.Context, viewer int64, owner int64,
+	offset int, limit int) ([]domain.Collection, error) {
+	return i.collectionRepo.ListByUid(ctx, owner, viewer != owner, offset, pageSize(limit))
+}
+
+func (i *interactiveService) ListCollectionItems(ctx context.Context, viewer int64, cid int64,
+	offset int, limit int) ([]domain.CollectionItem, error) {
+	owner := viewer
+	if cid > 0 {
+		c, err := i.collectionRepo.FindById(ctx, cid)
+		if err != nil {
+			return nil, err
+		}
+		// 别人的私密收藏夹当作不存在
+		if c.Private && c.Uid != viewer {
+			return nil, ErrCollectionNotFound
+		}
+		owner = c.Uid
+	}
+	return i.collectionRepo.ListItems(ctx, owner, cid, offset, pageSize(limit))
+}
+
+func pageSize(limit int) int {
+	if limit <= 0 || limit > maxCollectionPageSize {
+		return maxCollectionPageSize
+	}
+	return limit
+}
+
+func (i *interactiveService) ListLiked(ctx context.Context, uid int64, biz string,
+	cursor string, limit int) ([]domain.HistoryItem, string, error) {
+	c, err := domain.ParseCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	limit = pageSize(limit)
+	items, err := i.repo.ListLiked(ctx, uid, biz, c, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	i.fillMeta(ctx, biz, items)
+	return items, domain.NextCursor(items, limit), nil
+}
+
+func (i *interactiveService) ListCollected(ctx context.Context, uid int64, biz string,
+	cursor string, limit int) ([]domain.HistoryItem, string, error) {
+	c, err := domain.ParseCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	limit = pageSize(limit)
+	items, err := i.repo.ListCollected(ctx, uid, biz, c, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	i.fillMeta(ctx, biz, items)
+	return items, domain.NextCursor(items, limit), nil
+}
+
+// fillMeta 目前只有文章能查到标题，查不到的时候只记日志，列表照样返回
+func (i *interactiveService) fillMeta(ctx context.Context, biz string, items []domain.HistoryItem) {
+	if biz != "article" || len(items) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.BizId)
+	}
+	resp, err := i.articleClient.GetPubByIds(ctx, &articlev1.GetPubByIdsRequest{Ids: ids})
+	if err != nil {
+		i.l.Error("查询文章信息失败",
+			logger.String("biz", biz),
+			logger.Error(err))
+		return
+	}
+	metas := make(map[int64]*domain.ItemMeta, len(resp.GetArticles()))
+	for _, art := range resp.GetArticles() {
+		metas[art.GetId()] = &domain.ItemMeta{
+			Title:    art.GetTitle(),
+			AuthorId: art.GetAuthorId(),
+			Abstract: art.GetAbstract(),
+		}
+	}
+	for idx := range items {
+		items[idx].Meta = metas[items[idx].BizId]
+	}
+}
+
 func (i *interactiveService) likeSync(uid int64, biz string, bizId int64) error {
 	return i.sync(events.LikeEventType, uid, biz, bizId)
 }
-func (i *interactiveService) CollectSync(uid int64, biz string, bizId int64) error {
-	return i.sync(events.CollectEventType, uid, biz, bizId)
+func (i *interactiveService) collectSync(uid int64, biz string, bizId int64, cid int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return i.producer.ProduceInteractiveEvent(ctx, events.InteractiveEvent{
+		Type:  events.CollectEventType,
+		Biz:   biz,
+		BizId: bizId,
+		Uid:   uid,
+		Cid:   cid,
+	})
+}
+
+// cancelCollectSync 每一条取消的收藏发一个事件，趋势里面的收藏数要减掉
+func (i *interactiveService) cancelCollectSync(uid int64, items []domain.CollectionItem) {
+	for _, item := range items {
+		err := i.sync(events.CancelCollectEventType, uid, item.Biz, item.BizId)
+		if err != nil {
+			i.l.Error("同步取消收藏失败",
+				logger.String("biz", item.Biz),
+				logger.Int64("bizId", item.BizId),
+				logger.Error(err))
+		}
+	}
 }
 func (i *interactiveService) cancelLikeSync(uid int64, biz string, bizId int64) error {
 	return i.sync(events.CancelLikeEventType, uid, biz, bizId)
@@ -110,6 +316,40 @@ func (i *interactiveService) sync(typ events.InteractiveEventType, uid int64, bi
 	})
 }
 
+func (i *interactiveService) React(ctx context.Context, biz string, id int64, uid int64,
+	typ domain.ReactionType) error {
+	if !typ.Valid() {
+		return ErrInvalidReaction
+	}
+	return i.repo.React(ctx, biz, id, uid, typ)
+}
+
+func (i *interactiveService) Unreact(ctx context.Context, biz string, id int64, uid int64) error {
+	return i.repo.Unreact(ctx, biz, id, uid)
+}
+
+func (i *interactiveService) GetReactions(ctx context.Context,
+	biz string, id int64, uid int64) (domain.Interactive, error) {
+	var (
+		eg       errgroup.Group
+		intr     domain.Interactive
+		reaction domain.ReactionType
+	)
+	eg.Go(func() error {
+		var er error
+		intr, er = i.repo.Get(ctx, biz, id)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		reaction, er = i.repo.Reaction(ctx, biz, id, uid)
+		return er
+	})
+	err := eg.Wait()
+	intr.Reaction = reaction
+	return intr, err
+}
+
 func (i *interactiveService) Get(ctx context.Context, biz string, id int64, uid int64) (domain.Interactive, error) {
 	intr, err := i.repo.Get(ctx, biz, id)
 	if err != nil {
@@ -142,3 +382,54 @@ func (i *interactiveService) GetByIds(ctx context.Context,
 	}
 	return res, nil
 }
+
+func (i *interactiveService) GetByIdsWithViewer(ctx context.Context,
+	biz string, ids []int64, uid int64) (map[int64]domain.Interactive, error) {
+	if len(ids) == 0 {
+		return map[int64]domain.Interactive{}, nil
+	}
+	var (
+		eg        errgroup.Group
+		intrs     []domain.Interactive
+		liked     map[int64]bool
+		collected map[int64]bool
+	)
+	eg.Go(func() error {
+		var er error
+		intrs, er = i.repo.GetByIds(ctx, biz, ids)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		liked, er = i.repo.LikedByIds(ctx, biz, ids, uid)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		collected, er = i.repo.CollectedByIds(ctx, biz, ids, uid)
+		return er
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	res := make(map[int64]domain.Interactive, len(ids))
+	for _, id := range ids {
+		res[id] = domain.Interactive{Biz: biz, BizId: id}
+	}
+	for _, intr := range intrs {
+		res[intr.BizId] = intr
+	}
+	for id, intr := range res {
+		intr.Liked = liked[id]
+		intr.Collected = collected[id]
+		res[id] = intr
+	}
+	return res, nil
+}
+
+func (i *interactiveService) DeleteByBiz(ctx context.Context, biz string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return i.repo.DeleteByBiz(ctx, biz, ids)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/pluckhuang/goweb/aweb/interactive/grpc"
 	ioc "github.com/pluckhuang/goweb/aweb/interactive/ioc"
 	repository2 "github.com/pluckhuang/goweb/aweb/interactive/repository"
-	cache2 "github.com/pluckhuang/goweb/aweb/interactive/repository/cache"
 	dao2 "github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
 	service2 "github.com/pluckhuang/goweb/aweb/interactive/service"
 )
@@ -19,20 +18,28 @@ var thirdPartySet = wire.NewSet(
 	ioc.InitEtcdClient,
 	ioc.InitSaramaClient,
 	ioc.InitSaramaSyncProducer,
-	ioc.InitRedis)
+	ioc.InitRedisClient,
+	ioc.InitRedis,
+	ioc.InitInvalidationBus,
+	ioc.InitInteractiveCache,
+	ioc.InitArticleClient)
 
 var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO,
-	cache2.NewInteractiveRedisCache,
 	repository2.NewCachedInteractiveRepository,
+	dao2.NewGORMCollectionDAO,
+	repository2.NewCollectionRepository,
 	events.NewInteractiveProducer,
 	service2.NewInteractiveService,
+	dao2.NewGORMBucketDAO,
+	ioc.InitAnalyticsRepository,
+	ioc.InitAnalyticsService,
 )
 
 func InitApp() *App {
 	wire.Build(thirdPartySet,
 		interactiveSvcSet,
 		grpc.NewInteractiveServiceServer,
-		events.NewInteractiveReadEventConsumer,
+		ioc.InitReadEventConsumer,
 		events.NewInteractiveSyncEventConsumer,
 		ioc.InitConsumers,
 		ioc.InitGRPCxServer,
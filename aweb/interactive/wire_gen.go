@@ -12,7 +12,6 @@ import (
 	"github.com/pluckhuang/goweb/aweb/interactive/grpc"
 	"github.com/pluckhuang/goweb/aweb/interactive/ioc"
 	"github.com/pluckhuang/goweb/aweb/interactive/repository"
-	"github.com/pluckhuang/goweb/aweb/interactive/repository/cache"
 	"github.com/pluckhuang/goweb/aweb/interactive/repository/dao"
 	"github.com/pluckhuang/goweb/aweb/interactive/service"
 )
@@ -24,17 +23,25 @@ func InitApp() *App {
 	loggerV1 := ioc.InitLogger()
 	db := ioc.InitDB(loggerV1)
 	interactiveDAO := dao.NewGORMInteractiveDAO(db)
-	cmdable := ioc.InitRedis()
-	interactiveCache := cache.NewInteractiveRedisCache(cmdable)
+	redisClient := ioc.InitRedisClient()
+	cmdable := ioc.InitRedis(redisClient)
+	invalidationBus := ioc.InitInvalidationBus(redisClient, loggerV1)
+	interactiveCache := ioc.InitInteractiveCache(cmdable, invalidationBus)
 	interactiveRepository := repository.NewCachedInteractiveRepository(interactiveDAO, loggerV1, interactiveCache)
-	interactiveReadEventConsumer := events.NewInteractiveReadEventConsumer(client, loggerV1, interactiveRepository)
-	interactiveSyncEventConsumer := events.NewInteractiveSyncEventConsumer(client, loggerV1, interactiveRepository)
+	bucketDAO := dao.NewGORMBucketDAO(db)
+	analyticsRepository := ioc.InitAnalyticsRepository(bucketDAO)
+	interactiveReadEventConsumer := ioc.InitReadEventConsumer(client, loggerV1, interactiveRepository, analyticsRepository)
+	interactiveSyncEventConsumer := events.NewInteractiveSyncEventConsumer(client, loggerV1, interactiveRepository, analyticsRepository)
 	v := ioc.InitConsumers(interactiveReadEventConsumer, interactiveSyncEventConsumer)
 	clientv3Client := ioc.InitEtcdClient()
+	collectionDAO := dao.NewGORMCollectionDAO(db)
+	collectionRepository := repository.NewCollectionRepository(collectionDAO, interactiveCache, loggerV1)
 	syncProducer := ioc.InitSaramaSyncProducer(client)
 	interactiveProducer := events.NewInteractiveProducer(syncProducer)
-	interactiveService := service.NewInteractiveService(interactiveRepository, loggerV1, interactiveProducer)
-	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService)
+	articleServiceClient := ioc.InitArticleClient(clientv3Client)
+	interactiveService := service.NewInteractiveService(interactiveRepository, collectionRepository, loggerV1, interactiveProducer, articleServiceClient)
+	analyticsService := ioc.InitAnalyticsService(analyticsRepository, articleServiceClient)
+	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService, analyticsService)
 	server := ioc.InitGRPCxServer(loggerV1, clientv3Client, interactiveServiceServer)
 	app := &App{
 		consumers: v,
@@ -45,6 +52,6 @@ func InitApp() *App {
 
 // wire.go:
 
-var thirdPartySet = wire.NewSet(ioc.InitLogger, ioc.InitDB, ioc.InitEtcdClient, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer, ioc.InitRedis)
+var thirdPartySet = wire.NewSet(ioc.InitLogger, ioc.InitDB, ioc.InitEtcdClient, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer, ioc.InitRedisClient, ioc.InitRedis, ioc.InitInvalidationBus, ioc.InitInteractiveCache, ioc.InitArticleClient)
 
-var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, cache.NewInteractiveRedisCache, repository.NewCachedInteractiveRepository, events.NewInteractiveProducer, service.NewInteractiveService)
+var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, repository.NewCachedInteractiveRepository, dao.NewGORMCollectionDAO, repository.NewCollectionRepository, events.NewInteractiveProducer, service.NewInteractiveService, dao.NewGORMBucketDAO, ioc.InitAnalyticsRepository, ioc.InitAnalyticsService)
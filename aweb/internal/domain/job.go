@@ -13,6 +13,8 @@ type Job struct {
 	Expression string
 	Executor   string
 	Cfg        string
+	// 抢占之后的版本号，用来判断任务在执行期间有没有被重新调度
+	Version    int
 	CancelFunc func()
 }
 
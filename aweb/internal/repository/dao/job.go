@@ -4,14 +4,27 @@ import (
 	"context"
 	"time"
 
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var ErrNoMoreJob = gorm.ErrRecordNotFound
+
 type JobDAO interface {
 	Preempt(ctx context.Context) (Job, error)
+	// PreemptByExecutor 只抢占指定执行器的任务
+	PreemptByExecutor(ctx context.Context, executor string) (Job, error)
 	Release(ctx context.Context, jid int64) error
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, t time.Time) error
+	// Upsert 按照 name 创建或者重新调度任务，任务会回到等待状态
+	Upsert(ctx context.Context, j Job) error
+	// Stop 任务不再需要调度，只有 version 没有变过才会生效
+	Stop(ctx context.Context, id int64, version int) error
+	StopByName(ctx context.Context, name string) error
+	// ActiveNames 找出 names 里面还在调度的任务，也就是没有停掉的
+	ActiveNames(ctx context.Context, names []string) ([]string, error)
 }
 
 type GORMJobDAO struct {
@@ -23,13 +36,25 @@ func NewGORMJobDAO(db *gorm.DB) JobDAO {
 }
 
 func (dao *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
+	return dao.preempt(ctx, dao.db.WithContext(ctx))
+}
+
+func (dao *GORMJobDAO) PreemptByExecutor(ctx context.Context, executor string) (Job, error) {
+	return dao.preempt(ctx, dao.db.WithContext(ctx).Where("executor = ?", executor))
+}
+
+func (dao *GORMJobDAO) preempt(ctx context.Context, query *gorm.DB) (Job, error) {
+	// 后台的循环没有请求的会话，查询默认会去从库。
+	// 从库延迟的时候查到的 version 是旧的，主库上的 CAS 永远失败，就会一直空转
+	ctx = gormx.ForcePrimary(ctx)
 	db := dao.db.WithContext(ctx)
 	for {
 		var j Job
 		now := time.Now().UnixMilli()
 		ddl := now - time.Minute.Milliseconds()
-		err := db.Where("(status = ? AND next_time <?) OR (status = ? AND utime < ?)",
-			jobStatusWaiting, now, jobStatusRunning, ddl).
+		err := query.Session(&gorm.Session{Context: ctx}).
+			Where("(status = ? AND next_time <?) OR (status = ? AND utime < ?)",
+				jobStatusWaiting, now, jobStatusRunning, ddl).
 			First(&j).Error
 		if err != nil {
 			return j, err
@@ -48,6 +73,7 @@ func (dao *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
 			// 没抢到
 			continue
 		}
+		j.Version = j.Version + 1
 		return j, err
 	}
 }
@@ -78,6 +104,53 @@ func (dao *GORMJobDAO) UpdateNextTime(ctx context.Context, jid int64, t time.Tim
 	}).Error
 }
 
+func (dao *GORMJobDAO) Upsert(ctx context.Context, j Job) error {
+	now := time.Now().UnixMilli()
+	j.Status = jobStatusWaiting
+	j.Ctime = now
+	j.Utime = now
+	return dao.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"executor":   j.Executor,
+			"expression": j.Expression,
+			"cfg":        j.Cfg,
+			"next_time":  j.NextTime,
+			"status":     jobStatusWaiting,
+			// 正在运行的节点拿着旧的 version，它就没办法把任务停掉
+			"version": gorm.Expr("`version` + 1"),
+			"utime":   now,
+		}),
+	}).Create(&j).Error
+}
+
+func (dao *GORMJobDAO) Stop(ctx context.Context, id int64, version int) error {
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND version = ?", id, version).Updates(map[string]any{
+		"status": jobStatusPaused,
+		"utime":  now,
+	}).Error
+}
+
+func (dao *GORMJobDAO) StopByName(ctx context.Context, name string) error {
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Model(&Job{}).
+		Where("name = ?", name).Updates(map[string]any{
+		"status":  jobStatusPaused,
+		"version": gorm.Expr("`version` + 1"),
+		"utime":   now,
+	}).Error
+}
+
+func (dao *GORMJobDAO) ActiveNames(ctx context.Context, names []string) ([]string, error) {
+	var res []string
+	err := dao.db.WithContext(ctx).Model(&Job{}).
+		Where("name IN ? AND status != ?", names, jobStatusPaused).
+		Pluck("name", &res).Error
+	return res, err
+}
+
 type Job struct {
 	Id         int64  `gorm:"primaryKey,autoIncrement"`
 	Name       string `gorm:"type:varchar(128);unique"`
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestJobDAO(t *testing.T) (JobDAO, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "job.db")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&Job{}))
+	return NewGORMJobDAO(db), db
+}
+
+func jobByName(t *testing.T, db *gorm.DB, name string) Job {
+	var j Job
+	require.NoError(t, db.Where("name = ?", name).First(&j).Error)
+	return j
+}
+
+func TestGORMJobDAO_PreemptByExecutor(t *testing.T) {
+	d, _ := newTestJobDAO(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Second)
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", Executor: "local", NextTime: past.UnixMilli()}))
+	require.NoError(t, d.Upsert(ctx, Job{Name: "b", Executor: "remote", NextTime: past.UnixMilli()}))
+	// 还没有到时间的不能抢
+	require.NoError(t, d.Upsert(ctx, Job{Name: "c", Executor: "remote",
+		NextTime: time.Now().Add(time.Hour).UnixMilli()}))
+
+	j, err := d.PreemptByExecutor(ctx, "remote")
+	require.NoError(t, err)
+	assert.Equal(t, "b", j.Name)
+	_, err = d.PreemptByExecutor(ctx, "remote")
+	assert.Equal(t, ErrNoMoreJob, err)
+
+	j, err = d.PreemptByExecutor(ctx, "local")
+	require.NoError(t, err)
+	assert.Equal(t, "a", j.Name)
+}
+
+func TestGORMJobDAO_Upsert(t *testing.T) {
+	d, db := newTestJobDAO(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Second).UnixMilli()
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", Executor: "local", Cfg: "1", NextTime: past}))
+	j, err := d.Preempt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, j.Version)
+
+	// 运行的时候被重新调度，回到等待状态，版本号变了
+	next := time.Now().Add(time.Hour).UnixMilli()
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", Executor: "local", Cfg: "2", NextTime: next}))
+	res := jobByName(t, db, "a")
+	assert.Equal(t, j.Id, res.Id)
+	assert.Equal(t, 2, res.Version)
+	assert.Equal(t, jobStatusWaiting, res.Status)
+	assert.Equal(t, "2", res.Cfg)
+	assert.Equal(t, next, res.NextTime)
+}
+
+func TestGORMJobDAO_Stop(t *testing.T) {
+	d, db := newTestJobDAO(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Second).UnixMilli()
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", Executor: "local", NextTime: past}))
+	j, err := d.Preempt(ctx)
+	require.NoError(t, err)
+
+	// 执行期间被重新调度了，拿着旧的版本号停不掉新的调度
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", Executor: "local", NextTime: past}))
+	require.NoError(t, d.Stop(ctx, j.Id, j.Version))
+	assert.Equal(t, jobStatusWaiting, jobByName(t, db, "a").Status)
+
+	j, err = d.Preempt(ctx)
+	require.NoError(t, err)
+	require.NoError(t, d.Stop(ctx, j.Id, j.Version))
+	assert.Equal(t, jobStatusPaused, jobByName(t, db, "a").Status)
+	_, err = d.Preempt(ctx)
+	assert.Equal(t, ErrNoMoreJob, err)
+}
+
+func TestGORMJobDAO_ActiveNames(t *testing.T) {
+	d, _ := newTestJobDAO(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	require.NoError(t, d.Upsert(ctx, Job{Name: "a", NextTime: now}))
+	require.NoError(t, d.Upsert(ctx, Job{Name: "b", NextTime: now}))
+	require.NoError(t, d.StopByName(ctx, "b"))
+
+	names, err := d.ActiveNames(ctx, []string{"a", "b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, names)
+}
+
+// newLaggingDB 主库和从库是两个独立的文件，从库的数据不会自己同步，相当于一直延迟
+func newLaggingDB(t *testing.T) (db *gorm.DB, primary *gorm.DB, replica *gorm.DB) {
+	dir := t.TempDir()
+	primaryFile := filepath.Join(dir, "primary.db")
+	replicaFile := filepath.Join(dir, "replica.db")
+	open := func(file string) *gorm.DB {
+		res, err := gorm.Open(sqlite.Open(file), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, res.AutoMigrate(&Job{}))
+		return res
+	}
+	primary, replica = open(primaryFile), open(replicaFile)
+	db, err := gorm.Open(sqlite.Open(primaryFile), &gorm.Config{})
+	require.NoError(t, err)
+	r := gormx.NewResolver([]gorm.Dialector{sqlite.Open(replicaFile)}, gormx.ResolverConfig{})
+	require.NoError(t, db.Use(r))
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+	return db, primary, replica
+}
+
+func TestGORMJobDAO_PreemptWithLaggingReplica(t *testing.T) {
+	db, primary, replica := newLaggingDB(t)
+	d := NewGORMJobDAO(db)
+	past := time.Now().Add(-time.Minute).UnixMilli()
+	job := Job{Id: 1, Name: "job", Executor: "local", Status: jobStatusWaiting, NextTime: past, Version: 1}
+	require.NoError(t, primary.Create(&job).Error)
+	// 从库还停在重新调度之前
+	job.Version = 0
+	require.NoError(t, replica.Create(&job).Error)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	j, err := d.Preempt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), j.Id)
+	assert.Equal(t, 2, j.Version)
+
+	// 主库上已经被抢走了，从库上还是等待状态，不能拿从库的数据空转
+	_, err = d.Preempt(ctx)
+	assert.Equal(t, ErrNoMoreJob, err)
+}
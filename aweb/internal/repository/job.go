@@ -8,11 +8,20 @@ import (
 	"github.com/pluckhuang/goweb/aweb/internal/repository/dao"
 )
 
+var ErrNoMoreJob = dao.ErrNoMoreJob
+
 type CronJobRepository interface {
 	Preempt(ctx context.Context) (domain.Job, error)
+	PreemptByExecutor(ctx context.Context, executor string) (domain.Job, error)
 	Release(ctx context.Context, jid int64) error
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, time time.Time) error
+	// Schedule 创建任务，或者把同名的任务重新调度到 next
+	Schedule(ctx context.Context, j domain.Job, next time.Time) error
+	Stop(ctx context.Context, id int64, version int) error
+	StopByName(ctx context.Context, name string) error
+	// ActiveNames 找出 names 里面还在调度的任务
+	ActiveNames(ctx context.Context, names []string) ([]string, error)
 }
 
 type PreemptJobRepository struct {
@@ -25,12 +34,23 @@ func NewPreemptJobRepository(dao dao.JobDAO) CronJobRepository {
 
 func (p *PreemptJobRepository) Preempt(ctx context.Context) (domain.Job, error) {
 	j, err := p.dao.Preempt(ctx)
+	return p.toDomain(j), err
+}
+
+func (p *PreemptJobRepository) PreemptByExecutor(ctx context.Context, executor string) (domain.Job, error) {
+	j, err := p.dao.PreemptByExecutor(ctx, executor)
+	return p.toDomain(j), err
+}
+
+func (p *PreemptJobRepository) toDomain(j dao.Job) domain.Job {
 	return domain.Job{
 		Id:         j.Id,
 		Expression: j.Expression,
 		Executor:   j.Executor,
 		Name:       j.Name,
-	}, err
+		Cfg:        j.Cfg,
+		Version:    j.Version,
+	}
 }
 
 func (p *PreemptJobRepository) Release(ctx context.Context, jid int64) error {
@@ -44,3 +64,25 @@ func (p *PreemptJobRepository) UpdateUtime(ctx context.Context, id int64) error
 func (p *PreemptJobRepository) UpdateNextTime(ctx context.Context, id int64, time time.Time) error {
 	return p.dao.UpdateNextTime(ctx, id, time)
 }
+
+func (p *PreemptJobRepository) Schedule(ctx context.Context, j domain.Job, next time.Time) error {
+	return p.dao.Upsert(ctx, dao.Job{
+		Name:       j.Name,
+		Executor:   j.Executor,
+		Expression: j.Expression,
+		Cfg:        j.Cfg,
+		NextTime:   next.UnixMilli(),
+	})
+}
+
+func (p *PreemptJobRepository) Stop(ctx context.Context, id int64, version int) error {
+	return p.dao.Stop(ctx, id, version)
+}
+
+func (p *PreemptJobRepository) StopByName(ctx context.Context, name string) error {
+	return p.dao.StopByName(ctx, name)
+}
+
+func (p *PreemptJobRepository) ActiveNames(ctx context.Context, names []string) ([]string, error) {
+	return p.dao.ActiveNames(ctx, names)
+}
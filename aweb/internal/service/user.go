@@ -6,6 +6,7 @@ import (
 
 	"github.com/pluckhuang/goweb/aweb/internal/domain"
 	"github.com/pluckhuang/goweb/aweb/internal/repository"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -91,8 +92,8 @@ func (svc *userService) FindOrCreate(ctx context.Context, phone string) (domain.
 		return domain.User{}, err
 	}
 	// 要么 err ==nil，要么ErrDuplicateUser，也代表用户存在
-	// 主从延迟，理论上来讲，强制走主库
-	return svc.repo.FindByPhone(ctx, phone)
+	// 主从延迟，刚插入的或者并发插入的从库可能还查不到，强制走主库
+	return svc.repo.FindByPhone(gormx.ForcePrimary(ctx), phone)
 }
 
 func (svc *userService) FindOrCreateByWechat(ctx context.Context, wechatInfo domain.WechatInfo) (domain.User, error) {
@@ -110,5 +111,5 @@ func (svc *userService) FindOrCreateByWechat(ctx context.Context, wechatInfo dom
 	if err != nil && err != repository.ErrDuplicateUser {
 		return domain.User{}, err
 	}
-	return svc.repo.FindByWechat(ctx, wechatInfo.OpenId)
+	return svc.repo.FindByWechat(gormx.ForcePrimary(ctx), wechatInfo.OpenId)
 }
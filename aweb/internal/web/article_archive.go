@@ -0,0 +1,169 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	articlev1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/article/v1"
+	"github.com/pluckhuang/goweb/aweb/internal/errs"
+	ijwt "github.com/pluckhuang/goweb/aweb/internal/web/jwt"
+	"github.com/pluckhuang/goweb/aweb/pkg/ginx"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// importChunkSize 上传压缩包的时候每个分片的大小
+const importChunkSize = 32 << 10
+
+// ArticleArchiveHandler 文章的批量导入导出，格式是 Markdown 文件组成的 zip 包
+type ArticleArchiveHandler struct {
+	svc articlev1.ArticleServiceClient
+	l   logger.LoggerV1
+}
+
+func NewArticleArchiveHandler(svc articlev1.ArticleServiceClient, l logger.LoggerV1) *ArticleArchiveHandler {
+	return &ArticleArchiveHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ArticleArchiveHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/articles")
+	// GET /articles/export
+	g.GET("/export", h.Export)
+	// POST /articles/import，表单字段 file
+	g.POST("/import", ginx.WrapClaims(h.Import))
+}
+
+func (h *ArticleArchiveHandler) Export(ctx *gin.Context) {
+	val, ok := ctx.Get("user")
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	uc, ok := val.(ijwt.UserClaims)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	stream, err := h.svc.ExportArticles(ctx, &articlev1.ExportArticlesRequest{Uid: uc.Uid})
+	if err != nil {
+		h.l.Error("导出文章失败", logger.Int64("uid", uc.Uid), logger.Error(err))
+		ctx.JSON(http.StatusOK, ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"})
+		return
+	}
+	// 第一个分片到了再写响应头，这样前面出错还能返回 JSON
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		h.l.Error("导出文章失败", logger.Int64("uid", uc.Uid), logger.Error(err))
+		ctx.JSON(http.StatusOK, ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"})
+		return
+	}
+	filename := fmt.Sprintf("articles-%d-%s.zip", uc.Uid, time.Now().Format("20060102"))
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Header("Content-Type", "application/zip")
+	ctx.Status(http.StatusOK)
+	for resp := first; resp != nil; {
+		if _, err = ctx.Writer.Write(resp.GetChunk()); err != nil {
+			// 客户端断开了
+			return
+		}
+		resp, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// 响应头已经发出去了，只能中断，客户端拿到的是一个不完整的压缩包
+			h.l.Error("导出文章中断", logger.Int64("uid", uc.Uid), logger.Error(err))
+			ctx.Abort()
+			return
+		}
+	}
+}
+
+type ImportArticleResult struct {
+	Filename string `json:"filename"`
+	Id       int64  `json:"id"`
+	Status   int32  `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (h *ArticleArchiveHandler) Import(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ginx.Result{Code: errs.ArticleInvalidInput, Msg: "请上传 zip 文件"}, err
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"}, err
+	}
+	defer file.Close()
+
+	stream, err := h.svc.ImportArticles(ctx)
+	if err != nil {
+		return ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"}, err
+	}
+	err = stream.Send(&articlev1.ImportArticlesRequest{
+		Data: &articlev1.ImportArticlesRequest_Meta{
+			Meta: &articlev1.ImportArticlesMeta{Uid: uc.Uid},
+		},
+	})
+	// 服务端提前结束的时候 Send 返回 io.EOF，真正的错误要从 Recv 拿
+	if err == nil {
+		err = h.sendChunks(stream, file)
+	}
+	if err != nil && err != io.EOF {
+		return ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"}, err
+	}
+	if err = stream.CloseSend(); err != nil {
+		return ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"}, err
+	}
+
+	res := make([]ImportArticleResult, 0, 16)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			return ginx.Result{Code: errs.ArticleInvalidInput, Msg: status.Convert(err).Message()}, nil
+		}
+		if err != nil {
+			return ginx.Result{Code: errs.ArticleInternalServerError, Msg: "系统错误"}, err
+		}
+		res = append(res, ImportArticleResult{
+			Filename: resp.GetFilename(),
+			Id:       resp.GetId(),
+			Status:   resp.GetStatus(),
+			Error:    resp.GetError(),
+		})
+	}
+	return ginx.Result{Data: res}, nil
+}
+
+func (h *ArticleArchiveHandler) sendChunks(stream articlev1.ArticleService_ImportArticlesClient, r io.Reader) error {
+	for {
+		// Send 返回之后 grpc 还可能用到消息，所以不能复用 buf
+		buf := make([]byte, importChunkSize)
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&articlev1.ImportArticlesRequest{
+				Data: &articlev1.ImportArticlesRequest_Chunk{Chunk: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package ioc
 
 import (
+	"time"
+
 	"github.com/pluckhuang/goweb/aweb/internal/repository/dao"
 	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
@@ -17,6 +19,9 @@ import (
 func InitDB(l logger.LoggerV1) *gorm.DB {
 	type Config struct {
 		DSN string `yaml:"dsn"`
+		// Replicas 从库的 DSN
+		Replicas            []string      `yaml:"replicas"`
+		HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
 	}
 	var cfg Config = Config{
 		DSN: "root:root@tcp(localhost:3316)/aweb",
@@ -78,6 +83,14 @@ func InitDB(l logger.LoggerV1) *gorm.DB {
 		panic(err)
 	}
 
+	err = gormx.UseReplicas(db, gormx.ReplicaConfig{
+		Replicas:            cfg.Replicas,
+		HealthCheckInterval: cfg.HealthCheckInterval,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	err = dao.InitTables(db)
 	if err != nil {
 		panic(err)
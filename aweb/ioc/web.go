@@ -15,6 +15,7 @@ import (
 	"github.com/pluckhuang/goweb/aweb/pkg/ginx"
 	"github.com/pluckhuang/goweb/aweb/pkg/ginx/middleware/prometheus"
 	"github.com/pluckhuang/goweb/aweb/pkg/ginx/middleware/ratelimit"
+	"github.com/pluckhuang/goweb/aweb/pkg/gormx"
 	"github.com/pluckhuang/goweb/aweb/pkg/limiter"
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 	prometheus2 "github.com/prometheus/client_golang/prometheus"
@@ -39,10 +40,14 @@ import (
 // }
 
 func InitWebServer(mdls []gin.HandlerFunc,
-	userHdl *web.UserHandler) *gin.Engine {
+	userHdl *web.UserHandler,
+	archiveHdl *web.ArticleArchiveHandler) *gin.Engine {
 	server := gin.Default()
+	// 处理器把 *gin.Context 当作 context.Context 往下传，要能取到请求 ctx 里面的值
+	server.ContextWithFallback = true
 	server.Use(mdls...)
 	userHdl.RegisterRoutes(server)
+	archiveHdl.RegisterRoutes(server)
 	return server
 }
 
@@ -117,6 +122,10 @@ func InitGinMiddlewares(redisClient redis.Cmdable, hdl ijwt.Handler, l logger.Lo
 		func(ctx *gin.Context) {
 			println("这是我的 Middleware")
 		},
+		// 每个请求一个读写分离的会话，写过之后的查询都走主库
+		func(ctx *gin.Context) {
+			ctx.Request = ctx.Request.WithContext(gormx.WithSession(ctx.Request.Context()))
+		},
 		pb.BuildResponseTime(),
 		pb.BuildActiveRequest(),
 		otelgin.Middleware("aweb"),
@@ -0,0 +1,79 @@
+package cachex
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// InvalidationBus 跨实例的本地缓存失效通知
+// 本地缓存只在一个进程里面，数据变了之后，修改的实例通过总线通知所有的实例（包括自己）
+// 把对应的 key 删掉。通知是尽力而为的，本地缓存依旧要设置比较短的过期时间兜底
+type InvalidationBus interface {
+	// Publish 通知所有实例 keys 对应的本地缓存失效了
+	Publish(ctx context.Context, keys ...string) error
+	// Subscribe 收到以 prefix 开头的 key 的时候交给 l 处理
+	Subscribe(prefix string, l InvalidationListener)
+}
+
+type InvalidationListener interface {
+	// Evict 删除 key 对应的本地缓存
+	Evict(key string)
+	// EvictAll 可能错过了一些通知，删除全部的本地缓存
+	EvictAll()
+}
+
+// listeners 按照前缀分发通知，各种 InvalidationBus 的实现共用
+type listeners struct {
+	mu   sync.RWMutex
+	subs []subscription
+}
+
+type subscription struct {
+	prefix string
+	l      InvalidationListener
+}
+
+func (ls *listeners) add(prefix string, l InvalidationListener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.subs = append(ls.subs, subscription{prefix: prefix, l: l})
+}
+
+func (ls *listeners) evict(key string) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, sub := range ls.subs {
+		if strings.HasPrefix(key, sub.prefix) {
+			sub.l.Evict(key)
+		}
+	}
+}
+
+func (ls *listeners) evictAll() {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for _, sub := range ls.subs {
+		sub.l.EvictAll()
+	}
+}
+
+// LocalInvalidationBus 只在进程内部分发，只部署一个实例或者测试的时候用
+type LocalInvalidationBus struct {
+	listeners
+}
+
+func NewLocalInvalidationBus() *LocalInvalidationBus {
+	return &LocalInvalidationBus{}
+}
+
+func (b *LocalInvalidationBus) Publish(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		b.evict(key)
+	}
+	return nil
+}
+
+func (b *LocalInvalidationBus) Subscribe(prefix string, l InvalidationListener) {
+	b.add(prefix, l)
+}
@@ -0,0 +1,39 @@
+package cachex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordListener struct {
+	keys []string
+	all  int
+}
+
+func (r *recordListener) Evict(key string) {
+	r.keys = append(r.keys, key)
+}
+
+func (r *recordListener) EvictAll() {
+	r.all++
+}
+
+func TestLocalInvalidationBus(t *testing.T) {
+	bus := NewLocalInvalidationBus()
+	article := &recordListener{}
+	ranking := &recordListener{}
+	bus.Subscribe("article:pub:", article)
+	bus.Subscribe("ranking:", ranking)
+
+	require.NoError(t, bus.Publish(context.Background(),
+		"article:pub:1", "ranking:top_n", "article:detail:2", "article:pub:3"))
+	assert.Equal(t, []string{"article:pub:1", "article:pub:3"}, article.keys)
+	assert.Equal(t, []string{"ranking:top_n"}, ranking.keys)
+
+	bus.evictAll()
+	assert.Equal(t, 1, article.all)
+	assert.Equal(t, 1, ranking.all)
+}
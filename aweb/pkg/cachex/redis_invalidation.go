@@ -0,0 +1,104 @@
+package cachex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisInvalidationBus 用 Redis 的 pub/sub 广播失效通知
+// pub/sub 不保存消息，和 Redis 的连接断开期间的通知都会丢失，
+// 所以重新订阅成功之后会让所有的本地缓存全部失效
+type RedisInvalidationBus struct {
+	listeners
+	client  redis.UniversalClient
+	channel string
+	l       logger.LoggerV1
+	pubsub  *redis.PubSub
+}
+
+type invalidationMsg struct {
+	Keys []string `json:"keys"`
+}
+
+func NewRedisInvalidationBus(client redis.UniversalClient, channel string, l logger.LoggerV1) *RedisInvalidationBus {
+	return &RedisInvalidationBus{
+		client:  client,
+		channel: channel,
+		l:       l,
+	}
+}
+
+func (b *RedisInvalidationBus) Publish(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	val, err := json.Marshal(invalidationMsg{Keys: keys})
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, b.channel, val).Err()
+}
+
+func (b *RedisInvalidationBus) Subscribe(prefix string, l InvalidationListener) {
+	b.add(prefix, l)
+}
+
+// Start 订阅成功之后才返回，之后在后台接收通知，直到调用 Close
+func (b *RedisInvalidationBus) Start(ctx context.Context) error {
+	b.pubsub = b.client.Subscribe(ctx, b.channel)
+	// 第一条消息是订阅的确认
+	_, err := b.pubsub.Receive(ctx)
+	if err != nil {
+		_ = b.pubsub.Close()
+		return err
+	}
+	go b.loop()
+	return nil
+}
+
+func (b *RedisInvalidationBus) loop() {
+	ctx := context.Background()
+	for {
+		msg, err := b.pubsub.Receive(ctx)
+		if errors.Is(err, redis.ErrClosed) {
+			return
+		}
+		if err != nil {
+			// 下一次 Receive 的时候会自动重连并且重新订阅
+			b.l.Error("接收缓存失效通知失败", logger.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+		switch m := msg.(type) {
+		case *redis.Subscription:
+			// 重新订阅成功，断开期间的通知都丢了
+			b.l.Warn("重新订阅缓存失效通知，清空本地缓存",
+				logger.String("channel", b.channel))
+			b.evictAll()
+		case *redis.Message:
+			var im invalidationMsg
+			err = json.Unmarshal([]byte(m.Payload), &im)
+			if err != nil {
+				b.l.Error("缓存失效通知格式错误",
+					logger.String("payload", m.Payload),
+					logger.Error(err))
+				continue
+			}
+			for _, key := range im.Keys {
+				b.evict(key)
+			}
+		}
+	}
+}
+
+func (b *RedisInvalidationBus) Close() error {
+	if b.pubsub == nil {
+		return nil
+	}
+	return b.pubsub.Close()
+}
@@ -0,0 +1,112 @@
+package diffx
+
+import "strings"
+
+type Op uint8
+
+const (
+	// OpEqual 两边都有
+	OpEqual Op = iota
+	// OpInsert 只有新的版本有
+	OpInsert
+	// OpDelete 只有旧的版本有
+	OpDelete
+)
+
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxLCSCells 动态规划表格的上限，大概 32MB
+// 超过了就退化成整块删除再整块插入，结果粗糙一点，但是不会把内存打爆
+const maxLCSCells = 1 << 22
+
+// Lines 按行比较 a 和 b，基于最长公共子序列
+// 先去掉相同的前缀和后缀，一般修改只涉及少量的行，动态规划的规模会小很多
+// 中间剩下的部分太大的时候不再逐行对齐，见 maxLCSCells
+func Lines(a, b string) []Line {
+	as, bs := split(a), split(b)
+	prefix := 0
+	for prefix < len(as) && prefix < len(bs) && as[prefix] == bs[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(as)-prefix && suffix < len(bs)-prefix &&
+		as[len(as)-1-suffix] == bs[len(bs)-1-suffix] {
+		suffix++
+	}
+
+	res := make([]Line, 0, len(as)+len(bs))
+	for _, l := range as[:prefix] {
+		res = append(res, Line{Op: OpEqual, Text: l})
+	}
+	res = append(res, lcs(as[prefix:len(as)-suffix], bs[prefix:len(bs)-suffix])...)
+	for _, l := range as[len(as)-suffix:] {
+		res = append(res, Line{Op: OpEqual, Text: l})
+	}
+	return res
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+func lcs(as, bs []string) []Line {
+	n, m := len(as), len(bs)
+	if n > 0 && m > maxLCSCells/n {
+		return coarse(as, bs)
+	}
+	// dp[i][j] 是 as[i:] 和 bs[j:] 的最长公共子序列长度
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if as[i] == bs[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	res := make([]Line, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case as[i] == bs[j]:
+			res = append(res, Line{Op: OpEqual, Text: as[i]})
+			i++
+			j++
+		case dp[i+1][j] >= dp[i][j+1]:
+			res = append(res, Line{Op: OpDelete, Text: as[i]})
+			i++
+		default:
+			res = append(res, Line{Op: OpInsert, Text: bs[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		res = append(res, Line{Op: OpDelete, Text: as[i]})
+	}
+	for ; j < m; j++ {
+		res = append(res, Line{Op: OpInsert, Text: bs[j]})
+	}
+	return res
+}
+
+// coarse 不对齐，旧的全部删除，新的全部插入
+func coarse(as, bs []string) []Line {
+	res := make([]Line, 0, len(as)+len(bs))
+	for _, l := range as {
+		res = append(res, Line{Op: OpDelete, Text: l})
+	}
+	for _, l := range bs {
+		res = append(res, Line{Op: OpInsert, Text: l})
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package diffx
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want []Line
+	}{
+		{
+			name: "完全相同",
+			a:    "a\nb",
+			b:    "a\nb",
+			want: []Line{{Op: OpEqual, Text: "a"}, {Op: OpEqual, Text: "b"}},
+		},
+		{
+			name: "从空到有",
+			a:    "",
+			b:    "a",
+			want: []Line{{Op: OpInsert, Text: "a"}},
+		},
+		{
+			name: "修改中间一行",
+			a:    "a\nb\nc",
+			b:    "a\nx\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpInsert, Text: "x"},
+				{Op: OpEqual, Text: "c"},
+			},
+		},
+		{
+			name: "插入和删除",
+			a:    "a\nb\nc\nd",
+			b:    "b\nc\ne\nd",
+			want: []Line{
+				{Op: OpDelete, Text: "a"},
+				{Op: OpEqual, Text: "b"},
+				{Op: OpEqual, Text: "c"},
+				{Op: OpInsert, Text: "e"},
+				{Op: OpEqual, Text: "d"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Lines(tc.a, tc.b))
+		})
+	}
+}
+
+func TestLines_Large(t *testing.T) {
+	// 5 万行对 5 万行，逐行对齐的话表格要 20GB
+	const n = 50000
+	as := make([]string, 0, n)
+	bs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		as = append(as, fmt.Sprintf("a%d", i))
+		bs = append(bs, fmt.Sprintf("b%d", i))
+	}
+	a := "head\n" + strings.Join(as, "\n") + "\ntail"
+	b := "head\n" + strings.Join(bs, "\n") + "\ntail"
+
+	res := Lines(a, b)
+	assert.Len(t, res, 2*n+2)
+	assert.Equal(t, Line{Op: OpEqual, Text: "head"}, res[0])
+	assert.Equal(t, Line{Op: OpDelete, Text: "a0"}, res[1])
+	assert.Equal(t, Line{Op: OpInsert, Text: "b0"}, res[n+1])
+	assert.Equal(t, Line{Op: OpEqual, Text: "tail"}, res[2*n+1])
+}
@@ -0,0 +1,25 @@
+package gormx
+
+import (
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ReplicaConfig MySQL 从库的配置
+type ReplicaConfig struct {
+	// Replicas 从库的 DSN
+	Replicas            []string
+	HealthCheckInterval time.Duration
+}
+
+// UseReplicas 读写分离，没有配置从库的时候读写都走主库
+func UseReplicas(db *gorm.DB, cfg ReplicaConfig) error {
+	return db.Use(NewResolver(slice.Map(cfg.Replicas, func(idx int, src string) gorm.Dialector {
+		return mysql.Open(src)
+	}), ResolverConfig{
+		HealthCheckInterval: cfg.HealthCheckInterval,
+	}))
+}
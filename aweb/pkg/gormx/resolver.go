@@ -0,0 +1,230 @@
+package gormx
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Resolver 读写分离的插件，查询走从库，其它的都走主库
+// 以下情况查询也走主库：
+//   - 在事务里面，或者加了锁
+//   - ctx 用 ForcePrimary 标记过
+//   - 同一个请求里面已经写过了，见 WithSession
+//   - 没有配置从库，或者从库都不健康
+type Resolver struct {
+	replicas []gorm.Dialector
+	cfg      ResolverConfig
+
+	pools []*replica
+	next  atomic.Uint64
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+type ResolverConfig struct {
+	// HealthCheckInterval 多久检查一次从库
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout 一次检查的超时时间
+	HealthCheckTimeout time.Duration
+	// Check 检查从库是否可用，默认是 Ping
+	// 想要检查主从延迟的话，可以在这里查询复制状态
+	Check func(ctx context.Context, db *sql.DB) error
+}
+
+type replica struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+func NewResolver(replicas []gorm.Dialector, cfg ResolverConfig) *Resolver {
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = time.Second * 5
+	}
+	if cfg.HealthCheckTimeout <= 0 {
+		cfg.HealthCheckTimeout = time.Second
+	}
+	if cfg.Check == nil {
+		cfg.Check = func(ctx context.Context, db *sql.DB) error {
+			return db.PingContext(ctx)
+		}
+	}
+	return &Resolver{
+		replicas: replicas,
+		cfg:      cfg,
+		closed:   make(chan struct{}),
+	}
+}
+
+func (r *Resolver) Name() string {
+	return "gormx:resolver"
+}
+
+func (r *Resolver) Initialize(db *gorm.DB) error {
+	for _, dialector := range r.replicas {
+		rdb, err := gorm.Open(dialector, &gorm.Config{})
+		if err != nil {
+			return err
+		}
+		sqlDB, err := rdb.DB()
+		if err != nil {
+			return err
+		}
+		r.pools = append(r.pools, &replica{db: sqlDB})
+	}
+	r.checkAll()
+	if len(r.pools) > 0 {
+		go r.healthCheckLoop()
+	}
+
+	err := db.Callback().Query().Before("gorm:query").
+		Register("gormx:resolver_query", r.route)
+	if err != nil {
+		return err
+	}
+	err = db.Callback().Row().Before("gorm:row").
+		Register("gormx:resolver_row", r.route)
+	if err != nil {
+		return err
+	}
+	err = db.Callback().Create().After("*").
+		Register("gormx:resolver_create", r.markWritten)
+	if err != nil {
+		return err
+	}
+	err = db.Callback().Update().After("*").
+		Register("gormx:resolver_update", r.markWritten)
+	if err != nil {
+		return err
+	}
+	err = db.Callback().Delete().After("*").
+		Register("gormx:resolver_delete", r.markWritten)
+	if err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("*").
+		Register("gormx:resolver_raw", r.markWritten)
+}
+
+// Close 停止健康检查并关闭从库的连接
+func (r *Resolver) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+	var err error
+	for _, p := range r.pools {
+		if e := p.db.Close(); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (r *Resolver) route(db *gorm.DB) {
+	if db.Error != nil || !r.readable(db) {
+		return
+	}
+	ctx := db.Statement.Context
+	if isForcePrimary(ctx) || written(ctx) {
+		return
+	}
+	if pool := r.pick(); pool != nil {
+		db.Statement.ConnPool = pool
+	}
+}
+
+// readable 只有事务外面的普通查询才能去从库
+func (r *Resolver) readable(db *gorm.DB) bool {
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return false
+	}
+	if _, ok := db.Statement.Clauses["FOR"]; ok {
+		return false
+	}
+	if db.Statement.SQL.Len() > 0 {
+		// Raw 执行的 SQL，不是 SELECT 的不敢去从库
+		sql := strings.TrimSpace(db.Statement.SQL.String())
+		return len(sql) >= 6 && strings.EqualFold(sql[:6], "SELECT")
+	}
+	return true
+}
+
+// pick 轮询健康的从库，都不健康返回 nil
+func (r *Resolver) pick() *sql.DB {
+	n := uint64(len(r.pools))
+	start := r.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		p := r.pools[(start+i)%n]
+		if p.healthy.Load() {
+			return p.db
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) markWritten(db *gorm.DB) {
+	if s, ok := db.Statement.Context.Value(sessionKey{}).(*session); ok {
+		s.written.Store(true)
+	}
+}
+
+func (r *Resolver) healthCheckLoop() {
+	ticker := time.NewTicker(r.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.checkAll()
+		case <-r.closed:
+			return
+		}
+	}
+}
+
+func (r *Resolver) checkAll() {
+	for _, p := range r.pools {
+		ctx, cancel := context.WithTimeout(context.Background(), r.cfg.HealthCheckTimeout)
+		p.healthy.Store(r.cfg.Check(ctx, p.db) == nil)
+		cancel()
+	}
+}
+
+type forcePrimaryKey struct{}
+
+// ForcePrimary 之后用 ctx 的查询都走主库，用在不能接受主从延迟的地方
+func ForcePrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forcePrimaryKey{}, true)
+}
+
+func isForcePrimary(ctx context.Context) bool {
+	val, _ := ctx.Value(forcePrimaryKey{}).(bool)
+	return val
+}
+
+type sessionKey struct{}
+
+type session struct {
+	written atomic.Bool
+}
+
+// WithSession 开始一个请求，请求里面写过之后，后面的查询都走主库
+// 一般不用直接调用，由 SessionUnaryServerInterceptor 这些在请求入口处理
+func WithSession(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(sessionKey{}).(*session); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, sessionKey{}, &session{})
+}
+
+func written(ctx context.Context) bool {
+	s, ok := ctx.Value(sessionKey{}).(*session)
+	return ok && s.written.Load()
+}
+
+var _ gorm.Plugin = (*Resolver)(nil)
@@ -0,0 +1,93 @@
+package gormx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type node struct {
+	Id   int64 `gorm:"primaryKey,autoIncrement"`
+	Name string
+}
+
+// newTestResolver 主库和从库是两个独立的文件，查出来的 Name 说明走的是哪个库
+func newTestResolver(t *testing.T, healthy *atomic.Bool) *gorm.DB {
+	dir := t.TempDir()
+	primary := filepath.Join(dir, "primary.db")
+	replicaFile := filepath.Join(dir, "replica.db")
+	for name, file := range map[string]string{"primary": primary, "replica": replicaFile} {
+		db, err := gorm.Open(sqlite.Open(file), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(&node{}))
+		require.NoError(t, db.Create(&node{Id: 1, Name: name}).Error)
+	}
+	db, err := gorm.Open(sqlite.Open(primary), &gorm.Config{})
+	require.NoError(t, err)
+	r := NewResolver([]gorm.Dialector{sqlite.Open(replicaFile)}, ResolverConfig{
+		Check: func(ctx context.Context, db *sql.DB) error {
+			if healthy != nil && !healthy.Load() {
+				return errors.New("从库挂了")
+			}
+			return db.PingContext(ctx)
+		},
+	})
+	require.NoError(t, db.Use(r))
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+	return db
+}
+
+func nameOf(t *testing.T, db *gorm.DB) string {
+	var n node
+	require.NoError(t, db.Where("id = ?", 1).First(&n).Error)
+	return n.Name
+}
+
+func TestResolver_Route(t *testing.T) {
+	db := newTestResolver(t, nil)
+	ctx := context.Background()
+
+	assert.Equal(t, "replica", nameOf(t, db.WithContext(ctx)))
+	assert.Equal(t, "primary", nameOf(t, db.WithContext(ForcePrimary(ctx))))
+	assert.Equal(t, "primary", nameOf(t, db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"})))
+
+	var name string
+	require.NoError(t, db.WithContext(ctx).Raw("SELECT name FROM nodes WHERE id = 1").Scan(&name).Error)
+	assert.Equal(t, "replica", name)
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		assert.Equal(t, "primary", nameOf(t, tx))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestResolver_ReadYourWrites(t *testing.T) {
+	db := newTestResolver(t, nil)
+	ctx := WithSession(context.Background())
+	assert.Equal(t, "replica", nameOf(t, db.WithContext(ctx)))
+
+	require.NoError(t, db.WithContext(ctx).Model(&node{}).
+		Where("id = ?", 1).Update("name", "primary_updated").Error)
+	assert.Equal(t, "primary_updated", nameOf(t, db.WithContext(ctx)))
+
+	// 别的请求不受影响
+	assert.Equal(t, "replica", nameOf(t, db.WithContext(WithSession(context.Background()))))
+}
+
+func TestResolver_Unhealthy(t *testing.T) {
+	var healthy atomic.Bool
+	db := newTestResolver(t, &healthy)
+	assert.Equal(t, "primary", nameOf(t, db))
+}
@@ -0,0 +1,32 @@
+package gormx
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// SessionUnaryServerInterceptor 每个 gRPC 请求一个读写分离的会话
+func SessionUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+		return handler(WithSession(ctx), req)
+	}
+}
+
+// SessionStreamServerInterceptor 流式的请求整个流是一个会话
+func SessionStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		return handler(srv, &sessionStream{ServerStream: ss, ctx: WithSession(ss.Context())})
+	}
+}
+
+type sessionStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *sessionStream) Context() context.Context {
+	return s.ctx
+}
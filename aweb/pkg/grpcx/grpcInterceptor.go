@@ -107,7 +107,8 @@ func NewUnaryClientInterceptor(cfg MiddlewareConfig) grpc.UnaryClientInterceptor
 				case codes.InvalidArgument, // 无效参数
 					codes.NotFound,         // 资源未找到
 					codes.PermissionDenied, // 权限拒绝
-					codes.Unauthenticated:  // 未认证
+					codes.Unauthenticated,  // 未认证
+					codes.Aborted:          // 并发修改冲突
 					// 这些是客户端错误，不计入熔断器失败统计
 					// 但仍然返回错误给调用方
 					return nil, finalErr
@@ -0,0 +1,108 @@
+// Package imagex 图片处理，只依赖标准库，支持 PNG、JPEG 和 GIF
+package imagex
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	// 注册 GIF 的解码器，image.Decode 才能识别
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+var ErrUnsupportedFormat = errors.New("不支持的图片格式")
+
+// Thumb 编码好的缩略图
+type Thumb struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Size 只解析图片头部，拿到宽高
+func Size(data []byte) (int, int, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, ErrUnsupportedFormat
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// Thumbnail 等比例缩小到宽和高都不超过 maxSize
+// 本来就不超过的，也会重新编码一份，调用方可以不用区分
+// JPEG 输出 JPEG，其余的输出 PNG 保留透明度，GIF 只取第一帧
+func Thumbnail(data []byte, maxSize int) (Thumb, error) {
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Thumb{}, ErrUnsupportedFormat
+	}
+	w, h := fit(src.Bounds().Dx(), src.Bounds().Dy(), maxSize)
+	dst := Resize(src, w, h)
+	var buf bytes.Buffer
+	res := Thumb{Width: w, Height: h}
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+		res.ContentType = "image/jpeg"
+	} else {
+		err = png.Encode(&buf, dst)
+		res.ContentType = "image/png"
+	}
+	if err != nil {
+		return Thumb{}, err
+	}
+	res.Data = buf.Bytes()
+	return res, nil
+}
+
+// fit 等比例缩放之后的宽高，至少是 1
+func fit(w, h, maxSize int) (int, int) {
+	if w <= maxSize && h <= maxSize {
+		return w, h
+	}
+	if w >= h {
+		return maxSize, max(1, h*maxSize/w)
+	}
+	return max(1, w*maxSize/h), maxSize
+}
+
+// Resize 用区域平均的方式缩放，缩小的时候比最近邻清楚
+func Resize(src image.Image, w, h int) *image.NRGBA {
+	b := src.Bounds()
+	// 先统一转成 NRGBA，后面按下标取像素会快很多
+	in := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(in, in.Bounds(), src, b.Min, draw.Src)
+	sw, sh := b.Dx(), b.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := in.NRGBAAt(sx, sy)
+					// 按照透明度加权，透明的像素不会把颜色拉黑
+					r += uint64(c.R) * uint64(c.A)
+					g += uint64(c.G) * uint64(c.A)
+					bl += uint64(c.B) * uint64(c.A)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			if a == 0 {
+				continue
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{
+				R: uint8(r / a),
+				G: uint8(g / a),
+				B: uint8(bl / a),
+				A: uint8(a / n),
+			})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,104 @@
+package imagex
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, w, h int, c color.Color) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestThumbnail(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      func(t *testing.T) []byte
+		maxSize   int
+		wantW     int
+		wantH     int
+		wantType  string
+		wantColor color.NRGBA
+	}{
+		{
+			name: "横图",
+			data: func(t *testing.T) []byte {
+				return encodePNG(t, 400, 100, color.NRGBA{R: 255, A: 255})
+			},
+			maxSize:   200,
+			wantW:     200,
+			wantH:     50,
+			wantType:  "image/png",
+			wantColor: color.NRGBA{R: 255, A: 255},
+		},
+		{
+			name: "竖图",
+			data: func(t *testing.T) []byte {
+				return encodePNG(t, 30, 300, color.NRGBA{B: 255, A: 128})
+			},
+			maxSize:   100,
+			wantW:     10,
+			wantH:     100,
+			wantType:  "image/png",
+			wantColor: color.NRGBA{B: 255, A: 128},
+		},
+		{
+			name: "小图不放大",
+			data: func(t *testing.T) []byte {
+				return encodePNG(t, 20, 10, color.NRGBA{G: 255, A: 255})
+			},
+			maxSize:   100,
+			wantW:     20,
+			wantH:     10,
+			wantType:  "image/png",
+			wantColor: color.NRGBA{G: 255, A: 255},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			thumb, err := Thumbnail(tc.data(t), tc.maxSize)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantW, thumb.Width)
+			assert.Equal(t, tc.wantH, thumb.Height)
+			assert.Equal(t, tc.wantType, thumb.ContentType)
+			img, err := png.Decode(bytes.NewReader(thumb.Data))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantW, img.Bounds().Dx())
+			assert.Equal(t, tc.wantColor, color.NRGBAModel.Convert(img.At(0, 0)))
+		})
+	}
+}
+
+func TestThumbnail_JPEG(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 300, 300))
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	thumb, err := Thumbnail(buf.Bytes(), 64)
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", thumb.ContentType)
+	w, h, err := Size(thumb.Data)
+	require.NoError(t, err)
+	assert.Equal(t, 64, w)
+	assert.Equal(t, 64, h)
+}
+
+func TestThumbnail_Unsupported(t *testing.T) {
+	_, err := Thumbnail([]byte("not an image"), 64)
+	assert.Equal(t, ErrUnsupportedFormat, err)
+	_, _, err = Size([]byte("not an image"))
+	assert.Equal(t, ErrUnsupportedFormat, err)
+}
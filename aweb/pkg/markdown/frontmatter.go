@@ -0,0 +1,42 @@
+package markdown
+
+import "strings"
+
+const frontMatterDelim = "---"
+
+// SplitFrontMatter 把文件开头用 --- 包起来的 front matter 和正文分开
+// 没有 front matter，或者只有开头没有结尾的，整个文件都是正文
+// 兼容 Windows 的换行和 UTF-8 的 BOM
+func SplitFrontMatter(src string) (meta string, body string) {
+	src = strings.TrimPrefix(src, "\ufeff")
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	first, rest, ok := strings.Cut(src, "\n")
+	if !ok || strings.TrimRight(first, " \t") != frontMatterDelim {
+		return "", src
+	}
+	// 空的 front matter，结尾紧跟着开头
+	if strings.HasPrefix(rest, frontMatterDelim+"\n") || rest == frontMatterDelim {
+		return "", strings.TrimPrefix(strings.TrimPrefix(rest, frontMatterDelim), "\n")
+	}
+	end := strings.Index(rest, "\n"+frontMatterDelim+"\n")
+	if end < 0 {
+		if !strings.HasSuffix(rest, "\n"+frontMatterDelim) {
+			return "", src
+		}
+		end = len(rest) - len(frontMatterDelim) - 1
+	}
+	meta = rest[:end+1]
+	body = rest[min(end+len(frontMatterDelim)+2, len(rest)):]
+	return meta, body
+}
+
+// JoinFrontMatter SplitFrontMatter 的反操作，meta 为空的时候不加 front matter
+func JoinFrontMatter(meta string, body string) string {
+	if meta == "" {
+		return body
+	}
+	if !strings.HasSuffix(meta, "\n") {
+		meta += "\n"
+	}
+	return frontMatterDelim + "\n" + meta + frontMatterDelim + "\n" + body
+}
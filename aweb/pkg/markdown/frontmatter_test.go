@@ -0,0 +1,71 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      string
+		wantMeta string
+		wantBody string
+	}{
+		{
+			name:     "正常的 front matter",
+			src:      "---\ntitle: a\n---\n# 正文\n",
+			wantMeta: "title: a\n",
+			wantBody: "# 正文\n",
+		},
+		{
+			name:     "没有 front matter",
+			src:      "# 正文\n---\n",
+			wantBody: "# 正文\n---\n",
+		},
+		{
+			name:     "只有开头没有结尾",
+			src:      "---\ntitle: a\n",
+			wantBody: "---\ntitle: a\n",
+		},
+		{
+			name:     "没有正文",
+			src:      "---\ntitle: a\n---",
+			wantMeta: "title: a\n",
+		},
+		{
+			name:     "空的 front matter",
+			src:      "---\n---\n正文",
+			wantBody: "正文",
+		},
+		{
+			name:     "Windows 换行和 BOM",
+			src:      "\ufeff---\r\ntitle: a\r\n---\r\n正文\r\n",
+			wantMeta: "title: a\n",
+			wantBody: "正文\n",
+		},
+		{
+			name:     "正文里面的分割线不影响",
+			src:      "---\ntitle: a\n---\n上面\n---\n下面",
+			wantMeta: "title: a\n",
+			wantBody: "上面\n---\n下面",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta, body := SplitFrontMatter(tc.src)
+			assert.Equal(t, tc.wantMeta, meta)
+			assert.Equal(t, tc.wantBody, body)
+		})
+	}
+}
+
+func TestJoinFrontMatter(t *testing.T) {
+	src := JoinFrontMatter("title: a", "# 正文\n")
+	assert.Equal(t, "---\ntitle: a\n---\n# 正文\n", src)
+	meta, body := SplitFrontMatter(src)
+	assert.Equal(t, "title: a\n", meta)
+	assert.Equal(t, "# 正文\n", body)
+	assert.Equal(t, "正文", JoinFrontMatter("", "正文"))
+}
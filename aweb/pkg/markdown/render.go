@@ -0,0 +1,769 @@
+// Package markdown 把 Markdown 渲染成可以直接嵌到页面里面的 HTML
+//
+// 只支持常用的语法：标题、段落、强调、删除线、行内代码、代码块、
+// 链接、图片、列表、引用和分割线。
+// 安全性不依赖事后过滤：原始的 HTML 一律转义，
+// 链接和图片只允许 http、https、mailto 以及相对路径，
+// 所以输出里面不会出现作者自己写的标签和属性。
+package markdown
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Heading 目录里面的一项
+type Heading struct {
+	Level int
+	// ID 对应 HTML 里面标题的 id，可以用来做锚点
+	ID   string
+	Text string
+}
+
+type Document struct {
+	HTML string
+	// TOC 按照出现的顺序排列的标题
+	TOC []Heading
+	// Text 去掉了所有标记的纯文本，不包含代码块
+	Text string
+}
+
+// Abstract 取纯文本的前 n 个字符作为摘要，连续的空白会被合并成一个空格
+func (d Document) Abstract(n int) string {
+	runes := []rune(strings.Join(strings.Fields(d.Text), " "))
+	if len(runes) > n {
+		runes = runes[:n]
+	}
+	return string(runes)
+}
+
+// Options 渲染时候的扩展点
+type Options struct {
+	// ResolveURL 在检查协议之前改写链接和图片的地址，比如把站内的附件引用换成真实的地址
+	// 返回 false 表示不改写，改写之后的地址也一样要经过协议的白名单
+	ResolveURL func(dest string) (string, bool)
+}
+
+func Render(src string) Document {
+	return RenderWith(src, Options{})
+}
+
+func RenderWith(src string, opts Options) Document {
+	r := &renderer{slugs: make(map[string]int), opts: opts}
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		lines[i] = expandIndent(line)
+	}
+	r.blocks(lines)
+	return Document{
+		HTML: r.html.String(),
+		TOC:  r.toc,
+		Text: strings.TrimSpace(r.text.String()),
+	}
+}
+
+type renderer struct {
+	html strings.Builder
+	text strings.Builder
+	toc  []Heading
+	// 已经用过的 id，重复的标题加上序号
+	slugs map[string]int
+	// 紧凑列表里面的段落不输出 <p>
+	tight bool
+	opts  Options
+	// depth 当前在几层引用或者列表里面
+	depth int
+	// noCloser 当前这一次 inline 里面，从哪个位置开始往后已经找不到某种分隔符的结尾了
+	// 结尾合不合法只和结尾自己的位置有关，和开头在哪里无关，
+	// 所以找过一次失败之后，后面的开头就不用再找了，避免 "*a *a *a ..." 这种输入变成平方复杂度
+	noCloser map[string]int
+}
+
+// maxBlockDepth 引用和列表最多嵌套几层，再深的当作普通段落，
+// 每一层都要把剩下的内容再扫一遍，不限制的话一串 > 就是平方复杂度
+const maxBlockDepth = 32
+
+func (r *renderer) blocks(lines []string) {
+	var para []string
+	flush := func() {
+		if len(para) == 0 {
+			return
+		}
+		if !r.tight {
+			r.html.WriteString("<p>")
+		}
+		r.text.WriteString(r.inline(&r.html, strings.Join(para, "\n")))
+		r.text.WriteString("\n")
+		if !r.tight {
+			r.html.WriteString("</p>\n")
+		}
+		para = nil
+	}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			flush()
+			i++
+		case isFence(trimmed):
+			flush()
+			i = r.codeBlock(lines, i)
+		case headingLevel(trimmed) > 0:
+			flush()
+			r.heading(trimmed)
+			i++
+		case isRule(trimmed):
+			flush()
+			r.html.WriteString("<hr>\n")
+			i++
+		case trimmed[0] == '>' && r.depth < maxBlockDepth:
+			flush()
+			i = r.quote(lines, i)
+		default:
+			if _, ok := parseMarker(line); ok && r.depth < maxBlockDepth {
+				flush()
+				i = r.list(lines, i)
+				continue
+			}
+			para = append(para, trimmed)
+			i++
+		}
+	}
+	flush()
+}
+
+func (r *renderer) codeBlock(lines []string, start int) int {
+	open := strings.TrimSpace(lines[start])
+	fence := open[:fenceLen(open)]
+	lang := sanitizeLang(strings.TrimSpace(open[len(fence):]))
+	if lang != "" {
+		r.html.WriteString(`<pre><code class="language-` + lang + `">`)
+	} else {
+		r.html.WriteString("<pre><code>")
+	}
+	i := start + 1
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			i++
+			break
+		}
+		r.html.WriteString(html.EscapeString(lines[i]))
+		r.html.WriteString("\n")
+	}
+	r.html.WriteString("</code></pre>\n")
+	return i
+}
+
+func (r *renderer) heading(line string) {
+	level := headingLevel(line)
+	content := strings.TrimSpace(line[level:])
+	// 去掉结尾可选的 #
+	if end := strings.TrimRight(content, "#"); end != content &&
+		(end == "" || end[len(end)-1] == ' ') {
+		content = strings.TrimSpace(end)
+	}
+	tag := "h" + strconv.Itoa(level)
+	var body strings.Builder
+	text := r.inline(&body, content)
+	id := r.slug(text)
+	r.html.WriteString("<" + tag + ` id="` + html.EscapeString(id) + `">`)
+	r.html.WriteString(body.String())
+	r.html.WriteString("</" + tag + ">\n")
+	r.text.WriteString(text)
+	r.text.WriteString("\n")
+	r.toc = append(r.toc, Heading{Level: level, ID: id, Text: text})
+}
+
+func (r *renderer) quote(lines []string, start int) int {
+	var inner []string
+	i := start
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || trimmed[0] != '>' {
+			break
+		}
+		trimmed = trimmed[1:]
+		if strings.HasPrefix(trimmed, " ") {
+			trimmed = trimmed[1:]
+		}
+		inner = append(inner, trimmed)
+	}
+	r.html.WriteString("<blockquote>\n")
+	tight := r.tight
+	r.tight = false
+	r.depth++
+	r.blocks(inner)
+	r.depth--
+	r.tight = tight
+	r.html.WriteString("</blockquote>\n")
+	return i
+}
+
+func (r *renderer) list(lines []string, start int) int {
+	first, _ := parseMarker(lines[start])
+	var items [][]string
+	loose := false
+	i := start
+	for i < len(lines) {
+		m, ok := parseMarker(lines[i])
+		if !ok || m.ordered != first.ordered || m.indent >= first.offset {
+			break
+		}
+		item := []string{lines[i][m.offset:]}
+		i++
+		blank := false
+		for i < len(lines) {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				blank = true
+				i++
+				continue
+			}
+			if indentOf(line) >= m.offset {
+				if blank {
+					item = append(item, "")
+					loose = true
+				}
+				item = append(item, line[m.offset:])
+				blank = false
+				i++
+				continue
+			}
+			if _, isMarker := parseMarker(line); isMarker || blank || startsBlock(line) {
+				break
+			}
+			// 懒惰的续行，算在当前段落里面
+			item = append(item, strings.TrimSpace(line))
+			i++
+		}
+		items = append(items, item)
+		if blank {
+			// 后面如果还有同一个列表的项，中间隔着空行，就是松散列表
+			if next, ok := parseMarker(lineAt(lines, i)); ok &&
+				next.ordered == first.ordered && next.indent < first.offset {
+				loose = true
+			} else {
+				// 回退到空行，让外层处理
+				for i > start && strings.TrimSpace(lines[i-1]) == "" {
+					i--
+				}
+				break
+			}
+		}
+	}
+
+	tag := "ul"
+	if first.ordered {
+		tag = "ol"
+	}
+	r.html.WriteString("<" + tag)
+	if first.ordered && first.start != 1 {
+		r.html.WriteString(` start="` + strconv.Itoa(first.start) + `"`)
+	}
+	r.html.WriteString(">\n")
+	tight := r.tight
+	r.depth++
+	for _, item := range items {
+		r.html.WriteString("<li>")
+		if loose {
+			r.html.WriteString("\n")
+		}
+		r.tight = !loose
+		r.blocks(item)
+		r.html.WriteString("</li>\n")
+	}
+	r.depth--
+	r.tight = tight
+	r.html.WriteString("</" + tag + ">\n")
+	return i
+}
+
+func (r *renderer) slug(text string) string {
+	var sb strings.Builder
+	dash := false
+	for _, c := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(c) || unicode.IsDigit(c):
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			dash = false
+			sb.WriteRune(c)
+		case unicode.IsSpace(c) || c == '-' || c == '_':
+			dash = true
+		}
+	}
+	id := sb.String()
+	if id == "" {
+		id = "section"
+	}
+	n := r.slugs[id]
+	r.slugs[id] = n + 1
+	if n > 0 {
+		id = id + "-" + strconv.Itoa(n)
+	}
+	return id
+}
+
+// inline 把行内元素渲染到 w，返回对应的纯文本
+func (r *renderer) inline(w *strings.Builder, s string) string {
+	// 嵌套的 inline 处理的是另外一个字符串，位置不能混用
+	outer := r.noCloser
+	r.noCloser = make(map[string]int)
+	defer func() {
+		r.noCloser = outer
+	}()
+	var text strings.Builder
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && strings.IndexByte("\\`![<*_~\n", s[j]) < 0 {
+			j++
+		}
+		if j > i {
+			w.WriteString(html.EscapeString(s[i:j]))
+			text.WriteString(s[i:j])
+			i = j
+			continue
+		}
+		if n := r.special(w, &text, s, i); n > 0 {
+			i += n
+			continue
+		}
+		// 不构成任何语法，按照普通字符处理
+		w.WriteString(html.EscapeString(s[i : i+1]))
+		text.WriteByte(s[i])
+		i++
+	}
+	return text.String()
+}
+
+// special 尝试从 s[i] 开始解析一个行内元素，返回消耗的字节数，0 表示不是
+func (r *renderer) special(w, text *strings.Builder, s string, i int) int {
+	c := s[i]
+	switch c {
+	case '\\':
+		if i+1 < len(s) && s[i+1] == '\n' {
+			w.WriteString("<br>\n")
+			text.WriteByte('\n')
+			return 2
+		}
+		if i+1 < len(s) && isPunct(s[i+1]) {
+			w.WriteString(html.EscapeString(s[i+1 : i+2]))
+			text.WriteByte(s[i+1])
+			return 2
+		}
+	case '\n':
+		w.WriteString("\n")
+		text.WriteByte('\n')
+		return 1
+	case '`':
+		return r.codeSpan(w, text, s, i)
+	case '!':
+		if i+1 < len(s) && s[i+1] == '[' {
+			if n := r.link(w, text, s, i+1, true); n > 0 {
+				return n + 1
+			}
+		}
+	case '[':
+		return r.link(w, text, s, i, false)
+	case '<':
+		return r.autolink(w, text, s, i)
+	case '*', '_':
+		if strings.HasPrefix(s[i:], string([]byte{c, c})) {
+			if n := r.emphasis(w, text, s, i, s[i:i+2], "strong"); n > 0 {
+				return n
+			}
+		}
+		return r.emphasis(w, text, s, i, s[i:i+1], "em")
+	case '~':
+		if strings.HasPrefix(s[i:], "~~") {
+			return r.emphasis(w, text, s, i, "~~", "del")
+		}
+	}
+	return 0
+}
+
+func (r *renderer) codeSpan(w, text *strings.Builder, s string, i int) int {
+	n := runLen(s, i, '`')
+	fence := s[i : i+n]
+	for j := i + n; j < len(s) && !r.closerMissing(fence, j); {
+		k := strings.Index(s[j:], fence)
+		if k < 0 {
+			r.markNoCloser(fence, i+n)
+			break
+		}
+		k += j
+		if runLen(s, k, '`') != n {
+			j = k + runLen(s, k, '`')
+			continue
+		}
+		code := strings.ReplaceAll(s[i+n:k], "\n", " ")
+		if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' {
+			code = code[1 : len(code)-1]
+		}
+		w.WriteString("<code>" + html.EscapeString(code) + "</code>")
+		text.WriteString(code)
+		return k + n - i
+	}
+	// 没有闭合，反引号原样输出
+	w.WriteString(fence)
+	text.WriteString(fence)
+	return n
+}
+
+// link 解析 [label](dest "title")，i 指向 [
+func (r *renderer) link(w, text *strings.Builder, s string, i int, image bool) int {
+	end := matchBracket(s, i, '[', ']')
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return 0
+	}
+	closing := matchBracket(s, end+1, '(', ')')
+	if closing < 0 {
+		return 0
+	}
+	label := s[i+1 : end]
+	dest, title := splitDest(s[end+2 : closing])
+	u, ok := safeURL(r.resolve(dest))
+	titleAttr := ""
+	if title != "" {
+		titleAttr = ` title="` + html.EscapeString(title) + `"`
+	}
+	if image {
+		var discard strings.Builder
+		alt := r.inline(&discard, label)
+		if ok {
+			w.WriteString(`<img src="` + html.EscapeString(u) + `" alt="` +
+				html.EscapeString(alt) + `"` + titleAttr + `>`)
+		} else {
+			w.WriteString(html.EscapeString(alt))
+		}
+		// 图片不算到纯文本里面
+		return closing + 1 - i
+	}
+	if !ok {
+		// 不安全的链接只保留文字
+		text.WriteString(r.inline(w, label))
+		return closing + 1 - i
+	}
+	w.WriteString(`<a href="` + html.EscapeString(u) + `"` + titleAttr + ` rel="nofollow noopener">`)
+	text.WriteString(r.inline(w, label))
+	w.WriteString("</a>")
+	return closing + 1 - i
+}
+
+func (r *renderer) resolve(dest string) string {
+	if r.opts.ResolveURL == nil {
+		return dest
+	}
+	if u, ok := r.opts.ResolveURL(strings.TrimSpace(dest)); ok {
+		return u
+	}
+	return dest
+}
+
+// autolink 解析 <https://example.com>
+func (r *renderer) autolink(w, text *strings.Builder, s string, i int) int {
+	// 地址里面不能有空白和 <，碰到了就不用再往后找 >，不然一串 < 会变成平方复杂度
+	end := strings.IndexAny(s[i+1:], " \t\n<>")
+	if end < 0 || s[i+1+end] != '>' {
+		return 0
+	}
+	end++
+	raw := s[i+1 : i+end]
+	if raw == "" || !strings.Contains(raw, ":") {
+		return 0
+	}
+	u, ok := safeURL(raw)
+	if !ok {
+		return 0
+	}
+	w.WriteString(`<a href="` + html.EscapeString(u) + `" rel="nofollow noopener">` +
+		html.EscapeString(raw) + "</a>")
+	text.WriteString(raw)
+	return end + 1
+}
+
+func (r *renderer) emphasis(w, text *strings.Builder, s string, i int, delim string, tag string) int {
+	start := i + len(delim)
+	if start >= len(s) || unicode.IsSpace(rune(s[start])) {
+		return 0
+	}
+	// snake_case 这种单词中间的下划线不算强调
+	if delim[0] == '_' && i > 0 && isWordByte(s[i-1]) {
+		return 0
+	}
+	if r.closerMissing(delim, start+1) {
+		return 0
+	}
+	for j := start + 1; j+len(delim) <= len(s); j++ {
+		if !strings.HasPrefix(s[j:], delim) || unicode.IsSpace(rune(s[j-1])) {
+			continue
+		}
+		end := j + len(delim)
+		if delim[0] == '_' && end < len(s) && isWordByte(s[end]) {
+			continue
+		}
+		// 单个的 * 不能和 ** 的一半配对
+		if len(delim) == 1 && end < len(s) && s[end] == delim[0] {
+			j++
+			continue
+		}
+		w.WriteString("<" + tag + ">")
+		text.WriteString(r.inline(w, s[start:j]))
+		w.WriteString("</" + tag + ">")
+		return end - i
+	}
+	r.markNoCloser(delim, start+1)
+	return 0
+}
+
+// closerMissing 从 from 开始往后是不是已经确定找不到 delim 的结尾了
+func (r *renderer) closerMissing(delim string, from int) bool {
+	failed, ok := r.noCloser[delim]
+	return ok && from >= failed
+}
+
+func (r *renderer) markNoCloser(delim string, from int) {
+	if failed, ok := r.noCloser[delim]; !ok || from < failed {
+		r.noCloser[delim] = from
+	}
+}
+
+type marker struct {
+	ordered bool
+	start   int
+	// indent 标记前面的空格数
+	indent int
+	// offset 内容开始的位置，后续行缩进到这里才算是同一项
+	offset int
+}
+
+func parseMarker(line string) (marker, bool) {
+	indent := indentOf(line)
+	if indent > 3 || indent >= len(line) {
+		return marker{}, false
+	}
+	rest := line[indent:]
+	if isRule(strings.TrimSpace(rest)) {
+		return marker{}, false
+	}
+	m := marker{indent: indent}
+	switch rest[0] {
+	case '-', '*', '+':
+		m.offset = indent + 1
+	default:
+		n := 0
+		for n < len(rest) && n < 9 && rest[n] >= '0' && rest[n] <= '9' {
+			n++
+		}
+		if n == 0 || n >= len(rest) || (rest[n] != '.' && rest[n] != ')') {
+			return marker{}, false
+		}
+		m.ordered = true
+		m.start, _ = strconv.Atoi(rest[:n])
+		m.offset = indent + n + 1
+	}
+	if m.offset == len(line) {
+		return m, true
+	}
+	if line[m.offset] != ' ' {
+		return marker{}, false
+	}
+	m.offset++
+	return m, true
+}
+
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return isFence(trimmed) || headingLevel(trimmed) > 0 ||
+		isRule(trimmed) || trimmed[0] == '>'
+}
+
+func headingLevel(line string) int {
+	n := runLen(line, 0, '#')
+	if n == 0 || n > 6 {
+		return 0
+	}
+	if n < len(line) && line[n] != ' ' {
+		return 0
+	}
+	return n
+}
+
+func isFence(line string) bool {
+	return fenceLen(line) > 0
+}
+
+// fenceLen 代码块开头的 ``` 或者 ~~~ 的长度
+func fenceLen(line string) int {
+	if line == "" || (line[0] != '`' && line[0] != '~') {
+		return 0
+	}
+	n := runLen(line, 0, line[0])
+	if n < 3 {
+		return 0
+	}
+	// ``` 后面的语言里面不能再有反引号
+	if line[0] == '`' && strings.Contains(line[n:], "`") {
+		return 0
+	}
+	return n
+}
+
+func isRule(line string) bool {
+	if line == "" {
+		return false
+	}
+	c := line[0]
+	if c != '-' && c != '*' && c != '_' {
+		return false
+	}
+	cnt := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case c:
+			cnt++
+		case ' ':
+		default:
+			return false
+		}
+	}
+	return cnt >= 3
+}
+
+func sanitizeLang(info string) string {
+	if fields := strings.Fields(info); len(fields) > 0 {
+		info = fields[0]
+	}
+	return strings.Map(func(c rune) rune {
+		if c < utf8.RuneSelf && (isWordByte(byte(c)) || c == '-' || c == '+' || c == '#') {
+			return c
+		}
+		return -1
+	}, info)
+}
+
+// safeURL 只允许白名单里面的协议，防止 javascript: 之类的 XSS
+func safeURL(u string) (string, bool) {
+	u = strings.TrimSpace(u)
+	if u == "" {
+		return "", false
+	}
+	for _, c := range u {
+		// 浏览器会忽略协议里面的控制字符，java\tscript: 也能执行
+		if c < 0x20 || c == 0x7f {
+			return "", false
+		}
+	}
+	lower := strings.ToLower(u)
+	if i := strings.IndexAny(lower, ":/?#"); i >= 0 && lower[i] == ':' {
+		switch lower[:i] {
+		case "http", "https", "mailto":
+		default:
+			return "", false
+		}
+	}
+	return u, true
+}
+
+// splitDest 拆分链接的地址和标题
+func splitDest(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "<") {
+		if end := strings.IndexByte(s, '>'); end > 0 {
+			return s[1:end], unquote(strings.TrimSpace(s[end+1:]))
+		}
+	}
+	idx := strings.IndexAny(s, " \t\n")
+	if idx < 0 {
+		return s, ""
+	}
+	return s[:idx], unquote(strings.TrimSpace(s[idx:]))
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 {
+		first, last := s[0], s[len(s)-1]
+		if (first == '"' && last == '"') || (first == '\'' && last == '\'') ||
+			(first == '(' && last == ')') {
+			return s[1 : len(s)-1]
+		}
+	}
+	return ""
+}
+
+const (
+	// maxBracketDepth 括号最多嵌套几层，再深的当作普通字符
+	maxBracketDepth = 16
+	// maxBracketSpan 链接的文字或者地址最长多少字节
+	maxBracketSpan = 4096
+)
+
+// matchBracket 找到和 s[i] 配对的括号，支持嵌套和转义
+// 嵌套太深或者离得太远的当作没有配对，每个 [ 都往后找到底的话，一串 [ 就是平方复杂度
+func matchBracket(s string, i int, open, close byte) int {
+	depth := 0
+	end := min(len(s), i+maxBracketSpan)
+	for j := i; j < end; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case open:
+			depth++
+			if depth > maxBracketDepth {
+				return -1
+			}
+		case close:
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
+
+func runLen(s string, i int, c byte) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == c {
+		n++
+	}
+	return n
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// expandIndent 把行首的 tab 换成 4 个空格，方便计算缩进
+func expandIndent(line string) string {
+	i := 0
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	if !strings.Contains(line[:i], "\t") {
+		return line
+	}
+	return strings.ReplaceAll(line[:i], "\t", "    ") + line[i:]
+}
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("`^|~<>=+$", c) >= 0
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
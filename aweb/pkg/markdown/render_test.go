@@ -0,0 +1,146 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "段落和强调",
+			src:  "a **b** *c* ~~d~~ `e`",
+			want: "<p>a <strong>b</strong> <em>c</em> <del>d</del> <code>e</code></p>\n",
+		},
+		{
+			name: "原始 HTML 会被转义",
+			src:  `<img src=x onerror="alert(1)">`,
+			want: "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>\n",
+		},
+		{
+			name: "javascript 链接只保留文字",
+			src:  "[a](javascript:alert(1)) [b](JavaScript:alert(1))",
+			want: "<p>a b</p>\n",
+		},
+		{
+			name: "控制字符绕过",
+			src:  "[a](java\x01script:alert(1))",
+			want: "<p>a</p>\n",
+		},
+		{
+			name: "安全的链接和图片",
+			src:  `[a](https://a.com "t") ![b](/b.png)`,
+			want: `<p><a href="https://a.com" title="t" rel="nofollow noopener">a</a> <img src="/b.png" alt="b"></p>` + "\n",
+		},
+		{
+			name: "属性里面的引号",
+			src:  `[a](/x"onmouseover="alert(1))`,
+			want: `<p><a href="/x&#34;onmouseover=&#34;alert(1)" rel="nofollow noopener">a</a></p>` + "\n",
+		},
+		{
+			name: "单词中间的下划线",
+			src:  "snake_case_name",
+			want: "<p>snake_case_name</p>\n",
+		},
+		{
+			name: "代码块",
+			src:  "```go\" onclick=\"x\n<b>\n```",
+			want: "<pre><code class=\"language-go\">&lt;b&gt;\n</code></pre>\n",
+		},
+		{
+			name: "紧凑列表",
+			src:  "- a\n- b\n  - c",
+			want: "<ul>\n<li>a</li>\n<li>b<ul>\n<li>c</li>\n</ul>\n</li>\n</ul>\n",
+		},
+		{
+			name: "松散的有序列表",
+			src:  "3. a\n\n4. b",
+			want: "<ol start=\"3\">\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ol>\n",
+		},
+		{
+			name: "引用和分割线",
+			src:  "> a\n> b\n\n---",
+			want: "<blockquote>\n<p>a\nb</p>\n</blockquote>\n<hr>\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Render(tc.src).HTML)
+		})
+	}
+}
+
+func TestRenderTOC(t *testing.T) {
+	doc := Render("# 简介\n\n## Hello *World*\n\n```\n# 不是标题\n```\n\n## Hello World ##\n")
+	assert.Equal(t, []Heading{
+		{Level: 1, ID: "简介", Text: "简介"},
+		{Level: 2, ID: "hello-world", Text: "Hello World"},
+		{Level: 2, ID: "hello-world-1", Text: "Hello World"},
+	}, doc.TOC)
+	assert.Contains(t, doc.HTML, `<h2 id="hello-world">Hello <em>World</em></h2>`)
+}
+
+func TestDocumentAbstract(t *testing.T) {
+	doc := Render("# 标题\n\n**加粗**的   内容 [链接](https://a.com)\n\n```\ncode\n```\n\n![图片](/a.png)")
+	assert.Equal(t, "标题\n加粗的   内容 链接", doc.Text)
+	assert.Equal(t, "标题 加粗的 内容 链接", doc.Abstract(128))
+	assert.Equal(t, "标题 加", doc.Abstract(4))
+}
+
+func TestRenderWithResolveURL(t *testing.T) {
+	opts := Options{
+		ResolveURL: func(dest string) (string, bool) {
+			switch dest {
+			case "attachment:1":
+				return "https://cdn.com/a.png", true
+			case "attachment:2":
+				// 改写之后的地址也要检查
+				return "javascript:alert(1)", true
+			}
+			return "", false
+		},
+	}
+	doc := RenderWith("![a](attachment:1) ![b](attachment:2) ![c](attachment:3) [d](/d)", opts)
+	assert.Equal(t, `<p><img src="https://cdn.com/a.png" alt="a"> b c <a href="/d" rel="nofollow noopener">d</a></p>`+"\n", doc.HTML)
+}
+
+// 这些输入以前每个开头都要往后找到底，渲染的时间是长度的平方
+func TestRenderPathological(t *testing.T) {
+	const n = 200_000
+	testCases := []struct {
+		name string
+		src  string
+	}{
+		{name: "未闭合的方括号", src: strings.Repeat("[", n)},
+		{name: "未闭合的链接地址", src: strings.Repeat("[a](", n/4)},
+		{name: "未闭合的自动链接", src: strings.Repeat("<", n)},
+		{name: "未闭合的强调", src: strings.Repeat("*a ", n/3)},
+		{name: "未闭合的加粗", src: strings.Repeat("**a ", n/4)},
+		{name: "未闭合的行内代码", src: strings.Repeat("`a", n/2)},
+		{name: "嵌套的引用", src: strings.Repeat(">", n)},
+		{name: "嵌套的列表", src: strings.Repeat("> - ", n/4)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := time.Now()
+			Render(tc.src)
+			// 线性的实现只要几毫秒，给足余量避免机器慢的时候误报
+			assert.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+func TestRenderDeepBrackets(t *testing.T) {
+	doc := Render(strings.Repeat("[", 20) + "a" + strings.Repeat("]", 20) + "(/a)")
+	// 嵌套太深的方括号当作普通字符
+	assert.Equal(t, "<p>"+strings.Repeat("[", 20)+"a"+strings.Repeat("]", 20)+"(/a)</p>\n", doc.HTML)
+	doc = Render("[[a]](/a)")
+	assert.Equal(t, `<p><a href="/a" rel="nofollow noopener">[a]</a></p>`+"\n", doc.HTML)
+}
@@ -1,7 +1,6 @@
 package saramax
 
 import (
-	"context"
 	"encoding/json"
 	"time"
 
@@ -9,13 +8,34 @@ import (
 	"github.com/pluckhuang/goweb/aweb/pkg/logger"
 )
 
+const (
+	defaultBatchSize     = 10
+	defaultBatchInterval = time.Second
+)
+
+// BatchConfig 攒够 Size 条或者距离这一批的第一条消息过了 Interval 就处理一次，
+// 零值用默认的 10 条、1 秒
+type BatchConfig struct {
+	Size     int           `yaml:"size"`
+	Interval time.Duration `yaml:"interval"`
+}
+
 type BatchHandler[T any] struct {
-	fn func(msgs []*sarama.ConsumerMessage, ts []T) error
-	l  logger.LoggerV1
+	fn  func(msgs []*sarama.ConsumerMessage, ts []T) error
+	l   logger.LoggerV1
+	cfg BatchConfig
 }
 
-func NewBatchHandler[T any](l logger.LoggerV1, fn func(msgs []*sarama.ConsumerMessage, ts []T) error) *BatchHandler[T] {
-	return &BatchHandler[T]{fn: fn, l: l}
+func NewBatchHandler[T any](l logger.LoggerV1,
+	fn func(msgs []*sarama.ConsumerMessage, ts []T) error,
+	cfg BatchConfig) *BatchHandler[T] {
+	if cfg.Size <= 0 {
+		cfg.Size = defaultBatchSize
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultBatchInterval
+	}
+	return &BatchHandler[T]{fn: fn, l: l, cfg: cfg}
 }
 
 func (b *BatchHandler[T]) Setup(session sarama.ConsumerGroupSession) error {
@@ -26,62 +46,72 @@ func (b *BatchHandler[T]) Cleanup(session sarama.ConsumerGroupSession) error {
 	return nil
 }
 
+// ConsumeClaim 一批处理成功之后才标记这一批的消息，
+// 处理失败或者中途退出的时候没有标记的消息会在重新分配分区之后再消费一遍，
+// 所以 fn 会收到重复的消息，至少一次
 func (b *BatchHandler[T]) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	msgs := claim.Messages()
-	const batchSize = 10
-
 	for {
-		batch := make([]*sarama.ConsumerMessage, 0, batchSize)
-		ts := make([]T, 0, batchSize)
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		var done = false
+		// 先阻塞等第一条消息，没有消息的时候不空转
+		var first *sarama.ConsumerMessage
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-msgs:
+			if !ok {
+				// 消息通道关闭，说明分区重新平衡或消费结束
+				return nil
+			}
+			first = msg
+		}
 
-		// 收集消息，直到达到批量大小或超时
-		for i := 0; i < batchSize && !done; i++ {
+		batch := make([]*sarama.ConsumerMessage, 0, b.cfg.Size)
+		batch = append(batch, first)
+		timer := time.NewTimer(b.cfg.Interval)
+		closed := false
+		for len(batch) < b.cfg.Size && !closed {
 			select {
-			case <-ctx.Done():
-				// 超时退出
-				done = true
+			case <-timer.C:
+				closed = true
 			case msg, ok := <-msgs:
 				if !ok {
-					// 消息通道关闭，说明分区重新平衡或消费结束
-					cancel()
+					// 没处理的这一批不标记，交给下一个消费者
+					timer.Stop()
 					return nil
 				}
 				batch = append(batch, msg)
-
-				// 反序列化消息
-				var t T
-				err := json.Unmarshal(msg.Value, &t)
-				if err != nil {
-					b.l.Error("反序列消息体失败",
-						logger.String("topic", msg.Topic),
-						logger.Int32("partition", msg.Partition),
-						logger.Int64("offset", msg.Offset),
-						logger.Error(err))
-					continue
-				}
-				ts = append(ts, t)
 			}
 		}
-		cancel()
+		timer.Stop()
 
-		// 如果没有收集到任何消息，直接跳过处理
-		if len(batch) == 0 {
-			continue
+		// 反序列化失败的消息不交给 fn，但是和这一批一起标记，不然会一直卡在这里
+		valid := make([]*sarama.ConsumerMessage, 0, len(batch))
+		ts := make([]T, 0, len(batch))
+		for _, msg := range batch {
+			var t T
+			err := json.Unmarshal(msg.Value, &t)
+			if err != nil {
+				b.l.Error("反序列消息体失败",
+					logger.String("topic", msg.Topic),
+					logger.Int32("partition", msg.Partition),
+					logger.Int64("offset", msg.Offset),
+					logger.Error(err))
+				continue
+			}
+			valid = append(valid, msg)
+			ts = append(ts, t)
 		}
 
-		// 批量处理消息
-		err := b.fn(batch, ts)
-		if err != nil {
-			// 记录批量处理错误
-			b.l.Error("处理消息失败",
-				logger.Error(err))
-			// 返回错误，通知 Sarama 消费失败
-			return err
+		if len(valid) > 0 {
+			err := b.fn(valid, ts)
+			if err != nil {
+				b.l.Error("处理消息失败",
+					logger.Error(err))
+				// 返回错误，这一批都不标记
+				return err
+			}
 		}
 
-		// 标记消息为已消费
 		for _, msg := range batch {
 			session.MarkMessage(msg, "")
 		}
@@ -0,0 +1,84 @@
+package saramax
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	msgs chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.msgs
+}
+
+type evt struct {
+	Id int64
+}
+
+func TestBatchHandler_ConsumeClaim(t *testing.T) {
+	newClaim := func(values ...string) *fakeClaim {
+		msgs := make(chan *sarama.ConsumerMessage, len(values))
+		for i, v := range values {
+			msgs <- &sarama.ConsumerMessage{Offset: int64(i), Value: []byte(v)}
+		}
+		return &fakeClaim{msgs: msgs}
+	}
+
+	t.Run("凑够一批或者超时就处理", func(t *testing.T) {
+		var batches [][]int64
+		h := NewBatchHandler[evt](logger.NewNopLogger(),
+			func(msgs []*sarama.ConsumerMessage, ts []evt) error {
+				assert.Equal(t, len(msgs), len(ts))
+				ids := make([]int64, 0, len(ts))
+				for _, e := range ts {
+					ids = append(ids, e.Id)
+				}
+				batches = append(batches, ids)
+				return nil
+			}, BatchConfig{Size: 2, Interval: 20 * time.Millisecond})
+		claim := newClaim(`{"Id":1}`, `{"Id":2}`, `not json`, `{"Id":3}`, `{"Id":4}`)
+		session := &fakeSession{ctx: context.Background()}
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			close(claim.msgs)
+		}()
+		assert.NoError(t, h.ConsumeClaim(session, claim))
+		// 坏掉的消息跳过，但是偏移量照样标记
+		assert.Equal(t, [][]int64{{1, 2}, {3}, {4}}, batches)
+		assert.Equal(t, []int64{0, 1, 2, 3, 4}, session.marked)
+	})
+
+	t.Run("处理失败不标记", func(t *testing.T) {
+		h := NewBatchHandler[evt](logger.NewNopLogger(),
+			func(msgs []*sarama.ConsumerMessage, ts []evt) error {
+				return errors.New("mock db error")
+			}, BatchConfig{Size: 2, Interval: 20 * time.Millisecond})
+		claim := newClaim(`{"Id":1}`, `{"Id":2}`)
+		session := &fakeSession{ctx: context.Background()}
+		assert.Error(t, h.ConsumeClaim(session, claim))
+		assert.Empty(t, session.marked)
+	})
+}
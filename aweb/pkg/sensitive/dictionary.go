@@ -0,0 +1,97 @@
+package sensitive
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+)
+
+// Dictionary 可以热更新的词典
+// 更新的时候整个替换 Matcher，正在匹配的请求用的还是旧的那个
+type Dictionary struct {
+	m atomic.Pointer[Matcher]
+}
+
+func NewDictionary(words []string) *Dictionary {
+	d := &Dictionary{}
+	d.Reload(words)
+	return d
+}
+
+// Find 见 Matcher.Find
+func (d *Dictionary) Find(text string, limit int) []string {
+	return d.m.Load().Find(text, limit)
+}
+
+func (d *Dictionary) Len() int {
+	return d.m.Load().Len()
+}
+
+func (d *Dictionary) Reload(words []string) {
+	d.m.Store(NewMatcher(words))
+}
+
+// LoadFile 从文件加载，文件格式见 ReadWords
+func (d *Dictionary) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	words, err := ReadWords(f)
+	if err != nil {
+		return err
+	}
+	d.Reload(words)
+	return nil
+}
+
+// Watch 定时检查文件的修改时间，变了就重新加载，直到 ctx 结束
+// 第一次检查的时候总会加载一次，这样启动之前的修改也不会漏掉
+// 加载失败的时候保留旧的词典，等文件下次变化再试
+func (d *Dictionary) Watch(ctx context.Context, path string, interval time.Duration, l logger.LoggerV1) {
+	var last time.Time
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			l.Error("读取敏感词文件信息失败", logger.String("path", path), logger.Error(err))
+			continue
+		}
+		if info.ModTime().Equal(last) {
+			continue
+		}
+		last = info.ModTime()
+		if err = d.LoadFile(path); err != nil {
+			l.Error("重新加载敏感词失败", logger.String("path", path), logger.Error(err))
+			continue
+		}
+		l.Info("重新加载敏感词", logger.String("path", path), logger.Int64("words", int64(d.Len())))
+	}
+}
+
+// ReadWords 一行一个词，忽略空行和 # 开头的注释
+func ReadWords(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
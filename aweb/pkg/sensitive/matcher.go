@@ -0,0 +1,146 @@
+// Package sensitive 敏感词匹配，用 Aho-Corasick 自动机一次扫描找出所有的词
+package sensitive
+
+import (
+	"unicode"
+)
+
+// Matcher 构造之后只读，可以并发使用
+type Matcher struct {
+	nodes []node
+	// words 词的原始写法，node.word 是下标
+	words []string
+}
+
+type node struct {
+	next map[rune]int32
+	fail int32
+	// word 以这个节点结尾的词，-1 表示没有
+	word int32
+	// out 沿着 fail 链能找到的最近的一个词的结尾节点，-1 表示没有
+	out int32
+}
+
+// NewMatcher 词会被规范化，大小写、全角半角都不区分，空的词会被忽略
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{nodes: []node{newNode()}}
+	dict := make([]string, 0, len(words))
+	for _, w := range words {
+		runes := normalize(w)
+		if len(runes) == 0 {
+			continue
+		}
+		cur := int32(0)
+		for _, r := range runes {
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = int32(len(m.nodes))
+				m.nodes = append(m.nodes, newNode())
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		if m.nodes[cur].word < 0 {
+			m.nodes[cur].word = int32(len(dict))
+			dict = append(dict, w)
+		}
+	}
+	m.build()
+	m.words = dict
+	return m
+}
+
+func newNode() node {
+	return node{next: map[rune]int32{}, word: -1, out: -1}
+}
+
+// build 按照层次遍历计算 fail 指针
+func (m *Matcher) build() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			fail := m.nodes[child].fail
+			if m.nodes[fail].word >= 0 {
+				m.nodes[child].out = fail
+			} else {
+				m.nodes[child].out = m.nodes[fail].out
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Find 找出 text 里面出现的词，去重之后按照第一次出现的顺序返回
+// 返回的是加入词典时候的原始写法
+// limit 大于 0 的时候找到这么多个就不再继续
+func (m *Matcher) Find(text string, limit int) []string {
+	var res []string
+	seen := map[int32]struct{}{}
+	add := func(n int32) bool {
+		w := m.nodes[n].word
+		if _, ok := seen[w]; !ok {
+			seen[w] = struct{}{}
+			res = append(res, m.words[w])
+		}
+		return limit > 0 && len(res) >= limit
+	}
+	cur := int32(0)
+	for _, r := range text {
+		r = normalizeRune(r)
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for n := cur; n > 0; n = m.nodes[n].out {
+			if m.nodes[n].word >= 0 && add(n) {
+				return res
+			}
+		}
+	}
+	return res
+}
+
+// Len 词典里面有多少个词
+func (m *Matcher) Len() int {
+	return len(m.words)
+}
+
+func normalize(s string) []rune {
+	res := make([]rune, 0, len(s))
+	for _, r := range s {
+		res = append(res, normalizeRune(r))
+	}
+	return res
+}
+
+// normalizeRune 全角转半角，再转小写
+func normalizeRune(r rune) rune {
+	switch {
+	case r == '　':
+		r = ' '
+	case r >= '！' && r <= '～':
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
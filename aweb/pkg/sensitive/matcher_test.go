@@ -0,0 +1,103 @@
+package sensitive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcher_Find(t *testing.T) {
+	testCases := []struct {
+		name  string
+		words []string
+		text  string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "没有命中",
+			words: []string{"赌博", "代开发票"},
+			text:  "今天天气不错",
+		},
+		{
+			name: "空词典",
+			text: "什么都不会命中",
+		},
+		{
+			name:  "按照出现顺序去重",
+			words: []string{"赌博", "代开发票"},
+			text:  "代开发票，网上赌博，再说一次代开发票",
+			want:  []string{"代开发票", "赌博"},
+		},
+		{
+			name:  "重叠的词都要找出来",
+			words: []string{"he", "she", "his", "hers"},
+			text:  "ushers",
+			want:  []string{"she", "he", "hers"},
+		},
+		{
+			name:  "短词是长词的后缀",
+			words: []string{"abcd", "bc"},
+			text:  "abce",
+			want:  []string{"bc"},
+		},
+		{
+			name:  "不区分大小写和全角半角",
+			words: []string{"Spam"},
+			text:  "买 ＳＰＡＭ 吗",
+			want:  []string{"Spam"},
+		},
+		{
+			name:  "限制个数",
+			words: []string{"a", "b", "c"},
+			text:  "cba",
+			limit: 2,
+			want:  []string{"c", "b"},
+		},
+		{
+			name:  "忽略空的词",
+			words: []string{"", "x"},
+			text:  "xyz",
+			want:  []string{"x"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMatcher(tc.words)
+			assert.Equal(t, tc.want, m.Find(tc.text, tc.limit))
+		})
+	}
+}
+
+func TestReadWords(t *testing.T) {
+	words, err := ReadWords(strings.NewReader("# 注释\n赌博\n\n  代开发票  \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"赌博", "代开发票"}, words)
+}
+
+func TestDictionary_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	require.NoError(t, os.WriteFile(path, []byte("赌博\n"), 0o644))
+	d := NewDictionary(nil)
+	require.NoError(t, d.LoadFile(path))
+	assert.Equal(t, []string{"赌博"}, d.Find("网上赌博", 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Watch(ctx, path, 10*time.Millisecond, logger.NewNopLogger())
+
+	require.NoError(t, os.WriteFile(path, []byte("代开发票\n"), 0o644))
+	// 有的文件系统修改时间精度不高，显式改一下
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+	assert.Eventually(t, func() bool {
+		return len(d.Find("网上赌博", 0)) == 0 && d.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"代开发票"}, d.Find("代开发票", 0))
+}
@@ -0,0 +1,97 @@
+// Package snowflake 生成带分片号的全局唯一 id
+//
+// 从高到低是 41 位毫秒时间戳、8 位分片号、6 位实例号、8 位序列号，最高位永远是 0。
+// 分片号编码在 id 里面，只有 id 也能找到数据在哪个分片。
+// 同一个分片可能有多个实例在写，所以每个实例要配置不同的实例号。
+package snowflake
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	seqBits    = 8
+	workerBits = 6
+	shardBits  = 8
+
+	MaxShard  = 1<<shardBits - 1
+	MaxWorker = 1<<workerBits - 1
+	maxSeq    = 1<<seqBits - 1
+
+	workerShift = seqBits
+	shardShift  = seqBits + workerBits
+	timeShift   = seqBits + workerBits + shardBits
+
+	// minId 比它小的不是 snowflake 生成的，是之前数据库的自增主键
+	// 对应纪元之后 4 分钟左右，自增主键不可能涨到这么大
+	minId = 1 << 40
+)
+
+// epoch 2024-01-01 00:00:00 UTC，41 位时间戳可以用到 2093 年
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+var ErrInvalidWorker = errors.New("snowflake 实例号超出范围")
+
+type Generator struct {
+	mu     sync.Mutex
+	worker int64
+	// 每个分片单独维护时间戳和序列号
+	last map[int64]int64
+	seq  map[int64]int64
+	now  func() time.Time
+}
+
+func NewGenerator(worker int64) (*Generator, error) {
+	if worker < 0 || worker > MaxWorker {
+		return nil, ErrInvalidWorker
+	}
+	return &Generator{
+		worker: worker,
+		last:   make(map[int64]int64),
+		seq:    make(map[int64]int64),
+		now:    time.Now,
+	}, nil
+}
+
+// Next 生成分片 shard 的下一个 id，同一个分片的 id 是单调递增的
+// 时钟回拨或者一毫秒内超过 256 个的时候，借用后面的毫秒，不会阻塞
+func (g *Generator) Next(shard int64) int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ms := g.now().UnixMilli() - epoch
+	last := g.last[shard]
+	if ms > last {
+		g.last[shard] = ms
+		g.seq[shard] = 0
+	} else {
+		seq := g.seq[shard] + 1
+		if seq > maxSeq {
+			last++
+			seq = 0
+		}
+		g.last[shard] = last
+		g.seq[shard] = seq
+	}
+	return g.last[shard]<<timeShift |
+		(shard&MaxShard)<<shardShift |
+		g.worker<<workerShift |
+		g.seq[shard]
+}
+
+// ShardOf 返回 id 所在的分片，id 不是 snowflake 生成的时候 ok 是 false
+func ShardOf(id int64) (shard int64, ok bool) {
+	if id < minId {
+		return 0, false
+	}
+	return (id >> shardShift) & MaxShard, true
+}
+
+// Time 返回 id 生成的时间，不是 snowflake 生成的返回零值
+func Time(id int64) time.Time {
+	if id < minId {
+		return time.Time{}
+	}
+	return time.UnixMilli(id>>timeShift + epoch)
+}
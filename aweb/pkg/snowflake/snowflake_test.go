@@ -0,0 +1,77 @@
+package snowflake
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_Next(t *testing.T) {
+	g, err := NewGenerator(3)
+	require.NoError(t, err)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	id := g.Next(5)
+	shard, ok := ShardOf(id)
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), shard)
+	assert.Equal(t, now.UnixMilli(), Time(id).UnixMilli())
+
+	// 同一毫秒里面用完序列号之后借用下一毫秒，仍然递增
+	prev := id
+	for i := 0; i < 1000; i++ {
+		cur := g.Next(5)
+		assert.Greater(t, cur, prev)
+		prev = cur
+	}
+	assert.True(t, Time(prev).After(now))
+
+	// 时钟回拨也不会生成重复的 id
+	now = now.Add(-time.Second)
+	assert.Greater(t, g.Next(5), prev)
+
+	// 分片之间互不影响
+	other := g.Next(MaxShard)
+	shard, _ = ShardOf(other)
+	assert.Equal(t, int64(MaxShard), shard)
+	assert.Greater(t, other, int64(0))
+}
+
+func TestGenerator_Unique(t *testing.T) {
+	g1, err := NewGenerator(1)
+	require.NoError(t, err)
+	g2, err := NewGenerator(2)
+	require.NoError(t, err)
+	var (
+		mu   sync.Mutex
+		seen = make(map[int64]struct{})
+		wg   sync.WaitGroup
+	)
+	for _, g := range []*Generator{g1, g2, g1, g2} {
+		wg.Add(1)
+		go func(g *Generator) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				id := g.Next(int64(i % 4))
+				mu.Lock()
+				seen[id] = struct{}{}
+				mu.Unlock()
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Len(t, seen, 8000)
+}
+
+func TestShardOf_Legacy(t *testing.T) {
+	_, ok := ShardOf(12345)
+	assert.False(t, ok)
+	assert.True(t, Time(12345).IsZero())
+
+	_, err := NewGenerator(MaxWorker + 1)
+	assert.Equal(t, ErrInvalidWorker, err)
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir(), "http://localhost/files/")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	key := "attachments/ab/abc"
+	require.NoError(t, s.Put(ctx, key, strings.NewReader("hello"), "text/plain"))
+	r, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, r.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, "http://localhost/files/attachments/ab/abc", s.URL(key))
+
+	// 覆盖
+	require.NoError(t, s.Put(ctx, key, strings.NewReader("world"), "text/plain"))
+	r, err = s.Get(ctx, key)
+	require.NoError(t, err)
+	data, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "world", string(data))
+
+	require.NoError(t, s.Delete(ctx, key))
+	_, err = s.Get(ctx, key)
+	assert.Equal(t, ErrObjectNotFound, err)
+	// 重复删除不报错
+	assert.NoError(t, s.Delete(ctx, key))
+}
+
+func TestLocalStorage_InvalidKey(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir(), "")
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, key := range []string{"", "/etc/passwd", "../a", "a/../../b", "a//b", ".."} {
+		err = s.Put(ctx, key, strings.NewReader("x"), "")
+		assert.Equal(t, ErrInvalidKey, err, key)
+	}
+}
+
+func TestLocalStorage_PutCanceled(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir(), "")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.Put(ctx, "a", strings.NewReader("x"), "")
+	assert.Equal(t, context.Canceled, err)
+	// 写失败不会留下文件
+	_, err = s.Get(context.Background(), "a")
+	assert.Equal(t, ErrObjectNotFound, err)
+}
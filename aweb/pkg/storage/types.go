@@ -0,0 +1,22 @@
+// Package storage 对象存储的抽象，业务只依赖 Storage 接口
+// 本地开发和测试用 LocalStorage，线上可以换成 OSS、S3 之类的实现
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrObjectNotFound = errors.New("对象不存在")
+
+type Storage interface {
+	// Put 写入对象，key 已经存在的话直接覆盖
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get 读取对象，不存在返回 ErrObjectNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，不存在也不会返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 对象的访问地址
+	URL(key string) string
+}
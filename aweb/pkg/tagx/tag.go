@@ -0,0 +1,26 @@
+// Package tagx 标签的规范化，写入和查询要用同一套规则，不然同一个标签的不同写法互相查不到
+package tagx
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// MaxLength 标签最多多少个字符
+const MaxLength = 32
+
+// Normalize 统一标签的写法：全角转半角，去掉首尾空白和 #，转小写，中间的空白换成 -
+// 规范化之后为空的标签返回空字符串
+func Normalize(tag string) string {
+	tag = width.Fold.String(tag)
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimLeft(tag, "#")
+	tag = strings.ToLower(strings.Join(strings.FieldsFunc(tag, unicode.IsSpace), "-"))
+	runes := []rune(tag)
+	if len(runes) > MaxLength {
+		runes = runes[:MaxLength]
+	}
+	return string(runes)
+}
@@ -0,0 +1,27 @@
+package tagx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{name: "大小写和空白", tag: "  Micro \t Service ", want: "micro-service"},
+		{name: "井号", tag: "##Go", want: "go"},
+		{name: "全角", tag: "＃ＧＯ　语言", want: "go-语言"},
+		{name: "空标签", tag: " # ", want: ""},
+		{name: "超长", tag: strings.Repeat("标", MaxLength+1), want: strings.Repeat("标", MaxLength)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Normalize(tc.tag))
+		})
+	}
+}
@@ -1,8 +1,17 @@
 package domain
 
 type Article struct {
-	Id      int64
-	Title   string
-	Status  int32
-	Content string
+	Id       int64
+	Title    string
+	Status   int32
+	Content  string
+	Tags     []string
+	Category string
+}
+
+// RelatedArticle 相关推荐的文章，没有 Content
+type RelatedArticle struct {
+	Article
+	// Score 越大越相关，只在同一次推荐的结果之间有可比性
+	Score float64
 }
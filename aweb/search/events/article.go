@@ -30,10 +30,12 @@ func NewArticleConsumer(client sarama.Client,
 }
 
 type ArticleEvent struct {
-	Id      int64  `json:"id"`
-	Title   string `json:"title"`
-	Status  int32  `json:"status"`
-	Content string `json:"content"`
+	Id       int64    `json:"id"`
+	Title    string   `json:"title"`
+	Status   int32    `json:"status"`
+	Content  string   `json:"content"`
+	Tags     []string `json:"tags"`
+	Category string   `json:"category"`
 }
 
 func (a *ArticleConsumer) Start() error {
@@ -62,9 +64,11 @@ func (a *ArticleConsumer) Consume(sg *sarama.ConsumerMessage,
 
 func (a *ArticleConsumer) toDomain(article ArticleEvent) domain.Article {
 	return domain.Article{
-		Id:      article.Id,
-		Title:   article.Title,
-		Status:  article.Status,
-		Content: article.Content,
+		Id:       article.Id,
+		Title:    article.Title,
+		Status:   article.Status,
+		Content:  article.Content,
+		Tags:     article.Tags,
+		Category: article.Category,
 	}
 }
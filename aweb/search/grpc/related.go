@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	searchv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/search/v1"
+	"github.com/pluckhuang/goweb/aweb/search/domain"
+	"github.com/pluckhuang/goweb/aweb/search/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *SearchServiceServer) Related(ctx context.Context, request *searchv1.RelatedRequest) (*searchv1.RelatedResponse, error) {
+	arts, err := s.relatedSvc.Related(ctx, request.GetArticleId(), int(request.GetN()), request.GetBlend())
+	if err == service.ErrInvalidArticleId {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &searchv1.RelatedResponse{
+		Articles: slice.Map(arts, func(idx int, src domain.RelatedArticle) *searchv1.Article {
+			return &searchv1.Article{
+				Id:       src.Id,
+				Title:    src.Title,
+				Status:   src.Status,
+				Tags:     src.Tags,
+				Category: src.Category,
+			}
+		}),
+	}, nil
+}
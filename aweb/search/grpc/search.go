@@ -12,11 +12,12 @@ import (
 
 type SearchServiceServer struct {
 	searchv1.UnimplementedSearchServiceServer
-	svc service.SearchService
+	svc        service.SearchService
+	relatedSvc service.RelatedService
 }
 
-func NewSearchService(svc service.SearchService) *SearchServiceServer {
-	return &SearchServiceServer{svc: svc}
+func NewSearchService(svc service.SearchService, relatedSvc service.RelatedService) *SearchServiceServer {
+	return &SearchServiceServer{svc: svc, relatedSvc: relatedSvc}
 }
 
 func (s *SearchServiceServer) Register(server grpc.ServiceRegistrar) {
@@ -32,10 +33,12 @@ func (s *SearchServiceServer) Search(ctx context.Context, request *searchv1.Sear
 		Article: &searchv1.ArticleResult{
 			Articles: slice.Map(resp.Articles, func(idx int, src domain.Article) *searchv1.Article {
 				return &searchv1.Article{
-					Id:      src.Id,
-					Title:   src.Title,
-					Status:  src.Status,
-					Content: src.Content,
+					Id:       src.Id,
+					Title:    src.Title,
+					Status:   src.Status,
+					Content:  src.Content,
+					Tags:     src.Tags,
+					Category: src.Category,
 				}
 			}),
 		},
@@ -36,9 +36,11 @@ func (s *SyncServiceServer) InputAny(ctx context.Context, req *searchv1.InputAny
 
 func (s *SyncServiceServer) toDomainArticle(art *searchv1.Article) domain.Article {
 	return domain.Article{
-		Id:      art.Id,
-		Title:   art.Title,
-		Status:  art.Status,
-		Content: art.Content,
+		Id:       art.Id,
+		Title:    art.Title,
+		Status:   art.Status,
+		Content:  art.Content,
+		Tags:     art.Tags,
+		Category: art.Category,
 	}
 }
@@ -0,0 +1,12 @@
+package ioc
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+func InitRedis() redis.Cmdable {
+	return redis.NewClient(&redis.Options{
+		Addr: viper.GetString("redis.addr"),
+	})
+}
@@ -0,0 +1,36 @@
+package ioc
+
+import (
+	"time"
+
+	intrv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/search/repository"
+	"github.com/pluckhuang/goweb/aweb/search/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/search/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+func InitRelatedCache(client redis.Cmdable) cache.RelatedCache {
+	expiration := viper.GetDuration("related.cacheExpiration")
+	if expiration <= 0 {
+		expiration = time.Minute * 10
+	}
+	return cache.NewRelatedRedisCache(client, expiration)
+}
+
+func InitRelatedService(repo repository.RelatedRepository,
+	intrSvc intrv1.InteractiveServiceClient, l logger.LoggerV1) service.RelatedService {
+	cfg := service.RelatedConfig{
+		DefaultN:          5,
+		MaxN:              20,
+		CandidateFactor:   3,
+		InteractiveWeight: 0.3,
+	}
+	err := viper.UnmarshalKey("related", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewRelatedService(repo, intrSvc, cfg, l)
+}
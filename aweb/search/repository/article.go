@@ -49,19 +49,23 @@ func (a *articleRepository) SearchArticle(ctx context.Context,
 	}
 	return slice.Map(arts, func(idx int, src dao.Article) domain.Article {
 		return domain.Article{
-			Id:      src.Id,
-			Title:   src.Title,
-			Status:  src.Status,
-			Content: src.Content,
+			Id:       src.Id,
+			Title:    src.Title,
+			Status:   src.Status,
+			Content:  src.Content,
+			Tags:     src.Tags,
+			Category: src.Category,
 		}
 	}), nil
 }
 
 func (a *articleRepository) InputArticle(ctx context.Context, msg domain.Article) error {
 	return a.dao.InputArticle(ctx, dao.Article{
-		Id:      msg.Id,
-		Title:   msg.Title,
-		Status:  msg.Status,
-		Content: msg.Content,
+		Id:       msg.Id,
+		Title:    msg.Title,
+		Status:   msg.Status,
+		Content:  msg.Content,
+		Tags:     msg.Tags,
+		Category: msg.Category,
 	})
 }
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pluckhuang/goweb/aweb/search/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrKeyNotExist = redis.Nil
+
+// RelatedCache 缓存每篇文章的相关推荐
+// 被推荐的文章下架之后不会主动删缓存，最多在过期之前还能被推荐出来
+type RelatedCache interface {
+	Get(ctx context.Context, id int64, blend bool) ([]domain.RelatedArticle, error)
+	Set(ctx context.Context, id int64, blend bool, arts []domain.RelatedArticle) error
+}
+
+type RelatedRedisCache struct {
+	client     redis.Cmdable
+	expiration time.Duration
+}
+
+func NewRelatedRedisCache(client redis.Cmdable, expiration time.Duration) RelatedCache {
+	return &RelatedRedisCache{
+		client:     client,
+		expiration: expiration,
+	}
+}
+
+func (c *RelatedRedisCache) Get(ctx context.Context, id int64, blend bool) ([]domain.RelatedArticle, error) {
+	val, err := c.client.Get(ctx, c.key(id, blend)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var res []domain.RelatedArticle
+	err = json.Unmarshal(val, &res)
+	return res, err
+}
+
+func (c *RelatedRedisCache) Set(ctx context.Context, id int64, blend bool, arts []domain.RelatedArticle) error {
+	val, err := json.Marshal(arts)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, c.key(id, blend), val, c.expiration).Err()
+}
+
+// key 混合了互动数据和没有混合的是两份结果
+func (c *RelatedRedisCache) key(id int64, blend bool) string {
+	if blend {
+		return fmt.Sprintf("search:related:%d:blend", id)
+	}
+	return fmt.Sprintf("search:related:%d", id)
+}
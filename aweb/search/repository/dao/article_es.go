@@ -8,13 +8,17 @@ import (
 
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/olivere/elastic/v7"
+	"github.com/pluckhuang/goweb/aweb/pkg/tagx"
 )
 
 type Article struct {
-	Id      int64  `json:"id"`
-	Title   string `json:"title"`
-	Status  int32  `json:"status"`
-	Content string `json:"content"`
+	Id      int64    `json:"id"`
+	Title   string   `json:"title"`
+	Status  int32    `json:"status"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+	// Category 分类，和标签一样是规范化过的
+	Category string `json:"category"`
 }
 
 type ArticleElasticDAO struct {
@@ -32,13 +36,21 @@ func (h *ArticleElasticDAO) Search(ctx context.Context, req SearchReq, keywords
 
 	title := elastic.NewMatchQuery("title", queryString).Boost(4)
 	content := elastic.NewMatchQuery("content", queryString).Boost(4)
+	// 索引里面的标签和分类是规范化过的，关键字也要按照同样的规则处理才能匹配上
+	terms := slice.FilterMap(keywords, func(idx int, src string) (any, bool) {
+		src = tagx.Normalize(src)
+		return src, src != ""
+	})
+	// 标签是精确匹配的，命中标签比命中标题更说明问题
+	tag := elastic.NewTermsQuery("tags", terms...).Boost(5)
+	category := elastic.NewTermsQuery("category", terms...).Boost(3)
 	collect := elastic.NewTermsQuery("id", slice.Map(req.CollectIds, func(idx int, src int64) any {
 		return src
 	})...).Boost(4)
 	like := elastic.NewTermsQuery("id", slice.Map(req.LikeIds, func(idx int, src int64) any {
 		return src
 	})...).Boost(2)
-	or := elastic.NewBoolQuery().Should(title, content, collect, like)
+	or := elastic.NewBoolQuery().Should(title, content, tag, category, collect, like)
 	query := elastic.NewBoolQuery().Must(status, or)
 	sort := elastic.NewFieldSort("id").Desc()
 	scoreSort := elastic.NewFieldSort("_score").Desc()
@@ -61,6 +73,45 @@ func (h *ArticleElasticDAO) Search(ctx context.Context, req SearchReq, keywords
 	return res, nil
 }
 
+func (h *ArticleElasticDAO) MoreLikeThis(ctx context.Context, id int64, size int) ([]ArticleHit, error) {
+	docId := strconv.FormatInt(id, 10)
+	// 源文章不在索引里面的话 ES 会忽略掉这个 like，结果就是空的
+	mlt := elastic.NewMoreLikeThisQuery().
+		Field("title", "content", "tags").
+		LikeItems(elastic.NewMoreLikeThisQueryItem().Index(ArticleIndexName).Id(docId)).
+		// 文章不多的时候词频和文档频率都很低，用默认值基本查不出来东西
+		MinTermFreq(1).
+		MinDocFreq(1).
+		MaxQueryTerms(25)
+	// 2=> published
+	status := elastic.NewTermQuery("status", 2)
+	// more_like_this 默认就不会返回源文章，这里显式排除一下，不依赖默认值
+	self := elastic.NewIdsQuery().Ids(docId)
+	query := elastic.NewBoolQuery().Must(mlt).Filter(status).MustNot(self)
+	resp, err := h.client.Search(ArticleIndexName).
+		Query(query).
+		FetchSourceContext(elastic.NewFetchSourceContext(true).Exclude("content")).
+		Size(size).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ArticleHit, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		var art Article
+		err = json.Unmarshal(hit.Source, &art)
+		if err != nil {
+			return nil, err
+		}
+		var score float64
+		if hit.Score != nil {
+			score = *hit.Score
+		}
+		res = append(res, ArticleHit{Article: art, Score: score})
+	}
+	return res, nil
+}
+
 func (h *ArticleElasticDAO) InputArticle(ctx context.Context, art Article) error {
 	_, err := h.client.Index().Index(ArticleIndexName).
 		Id(strconv.FormatInt(art.Id, 10)).
@@ -12,6 +12,15 @@ type ArticleDAO interface {
 	InputArticle(ctx context.Context, article Article) error
 	// Search artIds 命中了索引的 article id
 	Search(ctx context.Context, req SearchReq, keywords []string) ([]Article, error)
+	// MoreLikeThis 按照标题、内容和标签找和 id 相似的已发表文章，不包含 id 自己
+	// 返回的文章不带 content，按相关度降序
+	MoreLikeThis(ctx context.Context, id int64, size int) ([]ArticleHit, error)
+}
+
+// ArticleHit 带着相关度得分的文章
+type ArticleHit struct {
+	Article
+	Score float64
 }
 
 type LikeDAO interface {
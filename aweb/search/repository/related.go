@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/pluckhuang/goweb/aweb/search/domain"
+	"github.com/pluckhuang/goweb/aweb/search/repository/cache"
+	"github.com/pluckhuang/goweb/aweb/search/repository/dao"
+)
+
+var ErrRelatedNotCached = cache.ErrKeyNotExist
+
+type RelatedRepository interface {
+	// MoreLikeThis 直接查 ES，不走缓存
+	MoreLikeThis(ctx context.Context, id int64, size int) ([]domain.RelatedArticle, error)
+	// GetCached 没有缓存的时候返回 ErrRelatedNotCached
+	GetCached(ctx context.Context, id int64, blend bool) ([]domain.RelatedArticle, error)
+	Cache(ctx context.Context, id int64, blend bool, arts []domain.RelatedArticle) error
+}
+
+type relatedRepository struct {
+	dao   dao.ArticleDAO
+	cache cache.RelatedCache
+}
+
+func NewRelatedRepository(dao dao.ArticleDAO, cache cache.RelatedCache) RelatedRepository {
+	return &relatedRepository{dao: dao, cache: cache}
+}
+
+func (r *relatedRepository) MoreLikeThis(ctx context.Context, id int64, size int) ([]domain.RelatedArticle, error) {
+	hits, err := r.dao.MoreLikeThis(ctx, id, size)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(hits, func(idx int, src dao.ArticleHit) domain.RelatedArticle {
+		return domain.RelatedArticle{
+			Article: domain.Article{
+				Id:       src.Id,
+				Title:    src.Title,
+				Status:   src.Status,
+				Tags:     src.Tags,
+				Category: src.Category,
+			},
+			Score: src.Score,
+		}
+	}), nil
+}
+
+func (r *relatedRepository) GetCached(ctx context.Context, id int64, blend bool) ([]domain.RelatedArticle, error) {
+	return r.cache.Get(ctx, id, blend)
+}
+
+func (r *relatedRepository) Cache(ctx context.Context, id int64, blend bool, arts []domain.RelatedArticle) error {
+	return r.cache.Set(ctx, id, blend, arts)
+}
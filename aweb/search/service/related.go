@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	intrv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/search/domain"
+	"github.com/pluckhuang/goweb/aweb/search/repository"
+)
+
+var ErrInvalidArticleId = errors.New("文章 id 不合法")
+
+// RelatedService 读完一篇文章之后推荐接下来读什么
+type RelatedService interface {
+	// Related 和 id 相似的已发表文章，最多 n 篇
+	// blend 为 true 的时候按照相似度和文章的互动数据加权排序
+	Related(ctx context.Context, id int64, n int, blend bool) ([]domain.RelatedArticle, error)
+}
+
+type RelatedConfig struct {
+	// DefaultN 没有传 n 的时候推荐几篇
+	DefaultN int
+	// MaxN 最多推荐几篇，缓存的也是这么多篇
+	MaxN int
+	// CandidateFactor 混合互动数据的时候，先从 ES 取 MaxN * CandidateFactor 篇再重新排序
+	CandidateFactor int
+	// InteractiveWeight 混合的时候互动数据占的权重，0 到 1 之间
+	InteractiveWeight float64
+}
+
+type relatedService struct {
+	repo    repository.RelatedRepository
+	intrSvc intrv1.InteractiveServiceClient
+	cfg     RelatedConfig
+	l       logger.LoggerV1
+}
+
+func NewRelatedService(repo repository.RelatedRepository,
+	intrSvc intrv1.InteractiveServiceClient,
+	cfg RelatedConfig, l logger.LoggerV1) RelatedService {
+	return &relatedService{
+		repo:    repo,
+		intrSvc: intrSvc,
+		cfg:     cfg,
+		l:       l,
+	}
+}
+
+func (s *relatedService) Related(ctx context.Context, id int64, n int, blend bool) ([]domain.RelatedArticle, error) {
+	if id <= 0 {
+		return nil, ErrInvalidArticleId
+	}
+	if n <= 0 {
+		n = s.cfg.DefaultN
+	}
+	n = min(n, s.cfg.MaxN)
+
+	arts, err := s.repo.GetCached(ctx, id, blend)
+	if err == nil {
+		return arts[:min(n, len(arts))], nil
+	}
+	if err != repository.ErrRelatedNotCached {
+		s.l.Error("查询相关推荐缓存失败", logger.Int64("id", id), logger.Error(err))
+	}
+
+	size := s.cfg.MaxN
+	if blend {
+		size = s.cfg.MaxN * s.cfg.CandidateFactor
+	}
+	arts, err = s.repo.MoreLikeThis(ctx, id, size)
+	if err != nil {
+		return nil, err
+	}
+	cacheable := true
+	if blend && len(arts) > 0 {
+		err = s.blend(ctx, arts)
+		if err != nil {
+			// 互动服务出问题了就只按相似度推荐，这样的结果不缓存
+			s.l.Error("混合互动数据失败", logger.Int64("id", id), logger.Error(err))
+			cacheable = false
+		}
+	}
+	arts = arts[:min(s.cfg.MaxN, len(arts))]
+	if cacheable {
+		err = s.repo.Cache(ctx, id, blend, arts)
+		if err != nil {
+			s.l.Error("缓存相关推荐失败", logger.Int64("id", id), logger.Error(err))
+		}
+	}
+	return arts[:min(n, len(arts))], nil
+}
+
+// blend 相似度和互动数据各自归一化之后加权，然后按照新的得分重新排序
+func (s *relatedService) blend(ctx context.Context, arts []domain.RelatedArticle) error {
+	ids := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		ids = append(ids, art.Id)
+	}
+	resp, err := s.intrSvc.GetByIds(ctx, &intrv1.GetByIdsRequest{Biz: "article", Ids: ids})
+	if err != nil {
+		return err
+	}
+	blendScores(arts, resp.GetIntrs(), s.cfg.InteractiveWeight)
+	return nil
+}
+
+func blendScores(arts []domain.RelatedArticle, intrs map[int64]*intrv1.Interactive, weight float64) {
+	popularity := make([]float64, len(arts))
+	var maxScore, maxPop float64
+	for i, art := range arts {
+		intr := intrs[art.Id]
+		// 点赞和收藏比阅读更能说明文章好，取对数防止爆款文章把相似度完全压下去
+		cnt := intr.GetReadCnt() + 3*intr.GetLikeCnt() + 5*intr.GetCollectCnt()
+		popularity[i] = math.Log1p(float64(max(cnt, 0)))
+		maxScore = max(maxScore, art.Score)
+		maxPop = max(maxPop, popularity[i])
+	}
+	for i := range arts {
+		var score, pop float64
+		if maxScore > 0 {
+			score = arts[i].Score / maxScore
+		}
+		if maxPop > 0 {
+			pop = popularity[i] / maxPop
+		}
+		arts[i].Score = (1-weight)*score + weight*pop
+	}
+	sort.SliceStable(arts, func(i, j int) bool {
+		return arts[i].Score > arts[j].Score
+	})
+}
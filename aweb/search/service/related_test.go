@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	intrv1 "github.com/pluckhuang/goweb/aweb/api/proto/gen/interactive/v1"
+	"github.com/pluckhuang/goweb/aweb/pkg/logger"
+	"github.com/pluckhuang/goweb/aweb/search/domain"
+	"github.com/pluckhuang/goweb/aweb/search/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeRelatedRepo struct {
+	hits    []domain.RelatedArticle
+	size    int
+	queries int
+	cached  map[bool][]domain.RelatedArticle
+}
+
+func (f *fakeRelatedRepo) MoreLikeThis(ctx context.Context, id int64, size int) ([]domain.RelatedArticle, error) {
+	f.queries++
+	f.size = size
+	res := make([]domain.RelatedArticle, min(size, len(f.hits)))
+	copy(res, f.hits)
+	return res, nil
+}
+
+func (f *fakeRelatedRepo) GetCached(ctx context.Context, id int64, blend bool) ([]domain.RelatedArticle, error) {
+	arts, ok := f.cached[blend]
+	if !ok {
+		return nil, repository.ErrRelatedNotCached
+	}
+	return arts, nil
+}
+
+func (f *fakeRelatedRepo) Cache(ctx context.Context, id int64, blend bool, arts []domain.RelatedArticle) error {
+	f.cached[blend] = arts
+	return nil
+}
+
+type fakeIntrClient struct {
+	intrv1.InteractiveServiceClient
+	intrs map[int64]*intrv1.Interactive
+	err   error
+}
+
+func (f *fakeIntrClient) GetByIds(ctx context.Context, in *intrv1.GetByIdsRequest, opts ...grpc.CallOption) (*intrv1.GetByIdsResponse, error) {
+	return &intrv1.GetByIdsResponse{Intrs: f.intrs}, f.err
+}
+
+func relatedIds(arts []domain.RelatedArticle) []int64 {
+	res := make([]int64, 0, len(arts))
+	for _, art := range arts {
+		res = append(res, art.Id)
+	}
+	return res
+}
+
+func TestRelatedService_Related(t *testing.T) {
+	hits := []domain.RelatedArticle{
+		{Article: domain.Article{Id: 1}, Score: 10},
+		{Article: domain.Article{Id: 2}, Score: 9},
+		{Article: domain.Article{Id: 3}, Score: 8},
+		{Article: domain.Article{Id: 4}, Score: 1},
+	}
+	cfg := RelatedConfig{DefaultN: 2, MaxN: 3, CandidateFactor: 2, InteractiveWeight: 0.5}
+	intrs := map[int64]*intrv1.Interactive{
+		3: {ReadCnt: 1000, LikeCnt: 100, CollectCnt: 50},
+		4: {ReadCnt: 10000, LikeCnt: 1000, CollectCnt: 500},
+	}
+
+	testCases := []struct {
+		name      string
+		n         int
+		blend     bool
+		intrErr   error
+		wantIds   []int64
+		wantSize  int
+		wantCache bool
+	}{
+		{
+			name:      "只按相似度",
+			n:         0,
+			wantIds:   []int64{1, 2},
+			wantSize:  3,
+			wantCache: true,
+		},
+		{
+			name:      "超过上限",
+			n:         10,
+			wantIds:   []int64{1, 2, 3},
+			wantSize:  3,
+			wantCache: true,
+		},
+		{
+			// 4 的相似度很低，但是互动数最高，加权之后能排上来
+			name:      "混合互动数据",
+			n:         3,
+			blend:     true,
+			wantIds:   []int64{3, 4, 1},
+			wantSize:  6,
+			wantCache: true,
+		},
+		{
+			name:     "互动服务出错降级",
+			n:        3,
+			blend:    true,
+			intrErr:  errors.New("mock error"),
+			wantIds:  []int64{1, 2, 3},
+			wantSize: 6,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeRelatedRepo{hits: hits, cached: map[bool][]domain.RelatedArticle{}}
+			svc := NewRelatedService(repo, &fakeIntrClient{intrs: intrs, err: tc.intrErr},
+				cfg, logger.NewNopLogger())
+			arts, err := svc.Related(context.Background(), 100, tc.n, tc.blend)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIds, relatedIds(arts))
+			assert.Equal(t, tc.wantSize, repo.size)
+			_, cached := repo.cached[tc.blend]
+			assert.Equal(t, tc.wantCache, cached)
+		})
+	}
+}
+
+func TestRelatedService_Cached(t *testing.T) {
+	repo := &fakeRelatedRepo{
+		hits:   []domain.RelatedArticle{{Article: domain.Article{Id: 1}, Score: 1}},
+		cached: map[bool][]domain.RelatedArticle{},
+	}
+	svc := NewRelatedService(repo, &fakeIntrClient{},
+		RelatedConfig{DefaultN: 5, MaxN: 20, CandidateFactor: 3}, logger.NewNopLogger())
+	ctx := context.Background()
+
+	_, err := svc.Related(ctx, 0, 5, false)
+	assert.Equal(t, ErrInvalidArticleId, err)
+
+	for i := 0; i < 3; i++ {
+		arts, err := svc.Related(ctx, 100, 5, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, relatedIds(arts))
+	}
+	assert.Equal(t, 1, repo.queries)
+}
@@ -19,6 +19,7 @@ var serviceProviderSet = wire.NewSet(
 	dao.NewLikeDAO,
 	repository.NewArticleRepository,
 	repository.NewAnyRepository,
+	repository.NewRelatedRepository,
 	service.NewSyncService,
 	service.NewSearchService,
 )
@@ -27,7 +28,11 @@ var thirdProvider = wire.NewSet(
 	ioc.InitESClient,
 	ioc.InitEtcdClient,
 	ioc.InitLogger,
-	ioc.InitKafka)
+	ioc.InitKafka,
+	ioc.InitRedis,
+	ioc.InitInteractiveClient,
+	ioc.InitRelatedCache,
+	ioc.InitRelatedService)
 
 func Init() *App {
 	wire.Build(
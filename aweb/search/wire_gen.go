@@ -29,9 +29,14 @@ func Init() *App {
 	syncService := service.NewSyncService(anyRepository, articleRepository)
 	syncServiceServer := grpc.NewSyncServiceServer(syncService)
 	searchService := service.NewSearchService(articleRepository)
-	searchServiceServer := grpc.NewSearchService(searchService)
+	cmdable := ioc.InitRedis()
+	relatedCache := ioc.InitRelatedCache(cmdable)
+	relatedRepository := repository.NewRelatedRepository(articleDAO, relatedCache)
 	clientv3Client := ioc.InitEtcdClient()
+	interactiveServiceClient := ioc.InitInteractiveClient(clientv3Client)
 	loggerV1 := ioc.InitLogger()
+	relatedService := ioc.InitRelatedService(relatedRepository, interactiveServiceClient, loggerV1)
+	searchServiceServer := grpc.NewSearchService(searchService, relatedService)
 	server := ioc.InitGRPCxServer(syncServiceServer, searchServiceServer, clientv3Client, loggerV1)
 	saramaClient := ioc.InitKafka()
 	articleConsumer := events.NewArticleConsumer(saramaClient, loggerV1, syncService)
@@ -46,6 +51,6 @@ func Init() *App {
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewArticleElasticDAO, dao.NewAnyESDAO, dao.NewCollectDAO, dao.NewLikeDAO, repository.NewArticleRepository, repository.NewAnyRepository, service.NewSyncService, service.NewSearchService)
+var serviceProviderSet = wire.NewSet(dao.NewArticleElasticDAO, dao.NewAnyESDAO, dao.NewCollectDAO, dao.NewLikeDAO, repository.NewArticleRepository, repository.NewAnyRepository, repository.NewRelatedRepository, service.NewSyncService, service.NewSearchService)
 
-var thirdProvider = wire.NewSet(ioc.InitESClient, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka)
+var thirdProvider = wire.NewSet(ioc.InitESClient, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka, ioc.InitRedis, ioc.InitInteractiveClient, ioc.InitRelatedCache, ioc.InitRelatedService)
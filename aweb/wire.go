@@ -18,6 +18,8 @@ func InitWebServer() *App {
 		// 第三方依赖
 		ioc.InitRedis, ioc.InitDB,
 		ioc.InitLogger,
+		ioc.InitEtcd,
+		ioc.InitArticleClient,
 
 		// DAO 部分
 		dao.NewUserDAO,
@@ -36,6 +38,7 @@ func InitWebServer() *App {
 
 		// handler 部分
 		web.NewUserHandler,
+		web.NewArticleArchiveHandler,
 		ijwt.NewRedisJWTHandler,
 
 		ioc.InitGinMiddlewares,
@@ -33,7 +33,10 @@ func InitWebServer() *App {
 	smsService := ioc.InitSMSService()
 	codeService := service.NewCodeService(codeRepository, smsService)
 	userHandler := web.NewUserHandler(userService, handler, codeService)
-	engine := ioc.InitWebServer(v, userHandler)
+	client := ioc.InitEtcd()
+	articleServiceClient := ioc.InitArticleClient(client)
+	articleArchiveHandler := web.NewArticleArchiveHandler(articleServiceClient, loggerV1)
+	engine := ioc.InitWebServer(v, userHandler, articleArchiveHandler)
 	app := &App{
 		server: engine,
 	}